@@ -3,13 +3,20 @@ package api
 import (
 	"github.com/spf13/cobra"
 	"github.com/yeyudekuangxiang/goctl/api/apigen"
+	"github.com/yeyudekuangxiang/goctl/api/collection"
 	"github.com/yeyudekuangxiang/goctl/api/dartgen"
+	"github.com/yeyudekuangxiang/goctl/api/diff"
 	"github.com/yeyudekuangxiang/goctl/api/docgen"
 	"github.com/yeyudekuangxiang/goctl/api/format"
 	"github.com/yeyudekuangxiang/goctl/api/gogen"
 	"github.com/yeyudekuangxiang/goctl/api/javagen"
 	"github.com/yeyudekuangxiang/goctl/api/ktgen"
+	"github.com/yeyudekuangxiang/goctl/api/lint"
+	"github.com/yeyudekuangxiang/goctl/api/mock"
 	"github.com/yeyudekuangxiang/goctl/api/new"
+	"github.com/yeyudekuangxiang/goctl/api/pygen"
+	"github.com/yeyudekuangxiang/goctl/api/rustgen"
+	"github.com/yeyudekuangxiang/goctl/api/swiftgen"
 	"github.com/yeyudekuangxiang/goctl/api/tsgen"
 	"github.com/yeyudekuangxiang/goctl/api/validate"
 	"github.com/yeyudekuangxiang/goctl/plugin"
@@ -23,12 +30,26 @@ var (
 		RunE:  apigen.CreateApiTemplate,
 	}
 
+	collectionCmd = &cobra.Command{
+		Use:     "collection",
+		Short:   "Generate postman, insomnia or http request collections for provided api in api file",
+		Example: "goctl api collection --api user.api --dir ./collection --format postman",
+		RunE:    collection.CollectionCommand,
+	}
+
 	dartCmd = &cobra.Command{
 		Use:   "dart",
 		Short: "Generate dart files for provided api in api file",
 		RunE:  dartgen.DartCommand,
 	}
 
+	diffCmd = &cobra.Command{
+		Use:     "diff",
+		Short:   "Report the breaking changes between two versions of an api file",
+		Example: "goctl api diff --old main:user.api --new user.api",
+		RunE:    diff.DiffCommand,
+	}
+
 	docCmd = &cobra.Command{
 		Use:   "doc",
 		Short: "Generate doc files",
@@ -47,6 +68,19 @@ var (
 		RunE:  gogen.GoCommand,
 	}
 
+	lintCmd = &cobra.Command{
+		Use:   "lint",
+		Short: "Lint api file with the style rules",
+		RunE:  lint.LintCommand,
+	}
+
+	mockCmd = &cobra.Command{
+		Use:     "mock",
+		Short:   "Start a mock server with fake responses for provided api in api file",
+		Example: "goctl api mock --api user.api --port 8888",
+		RunE:    mock.MockCommand,
+	}
+
 	newCmd = &cobra.Command{
 		Use:     "new",
 		Short:   "Fast create api service",
@@ -81,6 +115,25 @@ var (
 		RunE:  plugin.PluginCommand,
 	}
 
+	pythonCmd = &cobra.Command{
+		Use:   "python",
+		Short: "Generate python client files for provided api in api file",
+		RunE:  pygen.PythonCommand,
+	}
+
+	rustCmd = &cobra.Command{
+		Use:     "rust",
+		Short:   "Generate rust types and client for provided api in api file",
+		Example: "goctl api rust --api user.api --dir ./src/api",
+		RunE:    rustgen.RustCommand,
+	}
+
+	swiftCmd = &cobra.Command{
+		Use:   "swift",
+		Short: "Generate swift client files for provided api in api file",
+		RunE:  swiftgen.SwiftCommand,
+	}
+
 	tsCmd = &cobra.Command{
 		Use:   "ts",
 		Short: "Generate ts files for provided api in api file",
@@ -105,6 +158,20 @@ func init() {
 	dartCmd.Flags().BoolVar(&dartgen.VarStringLegacy, "legacy", false, "Legacy generator for flutter v1")
 	dartCmd.Flags().StringVar(&dartgen.VarStringHostname, "hostname", "", "hostname of the server")
 
+	diffCmd.Flags().StringVar(&diff.VarStringOld, "old", "", "The old api file, or <git ref>:<path> "+
+		"to read it from a git revision")
+	diffCmd.Flags().StringVar(&diff.VarStringNew, "new", "", "The new api file, or <git ref>:<path> "+
+		"to read it from a git revision")
+	diffCmd.Flags().StringVar(&diff.VarStringFormat, "format", "text", "The output format of "+
+		"the changes, text or json")
+
+	collectionCmd.Flags().StringVar(&collection.VarStringDir, "dir", "", "The target dir")
+	collectionCmd.Flags().StringVar(&collection.VarStringAPI, "api", "", "The api file")
+	collectionCmd.Flags().StringVar(&collection.VarStringFormat, "format", "postman", "The format of "+
+		"the collection, postman, insomnia or http")
+	collectionCmd.Flags().StringVar(&collection.VarStringURL, "url", "http://localhost:8888", "The base "+
+		"url in the environment")
+
 	docCmd.Flags().StringVar(&docgen.VarStringDir, "dir", "", "The target dir")
 	docCmd.Flags().StringVar(&docgen.VarStringOutput, "o", "", "The output markdown directory")
 
@@ -114,10 +181,15 @@ func init() {
 		" doc content, press \"ctrl + d\" to send EOF")
 	formatCmd.Flags().BoolVar(&format.VarBoolSkipCheckDeclare, "declare", false, "Use to skip check "+
 		"api types already declare")
+	formatCmd.Flags().BoolVar(&format.VarBoolCheck, "check", false, "List the unformatted api files "+
+		"and exit with non-zero code instead of formatting them")
+	formatCmd.Flags().BoolVar(&format.VarBoolDiff, "diff", false, "Print the diffs of the unformatted "+
+		"api files instead of formatting them")
 
 	goCmd.Flags().StringVar(&gogen.VarStringDir, "dir", "", "The target dir")
 	goCmd.Flags().StringVar(&gogen.VarStringAPI, "api", "", "The api file")
-	goCmd.Flags().StringVar(&gogen.VarStringMiddleware, "jwtMiddleware", "", "The global middleware file")
+	goCmd.Flags().StringVar(&gogen.VarStringMiddleware, "jwtMiddleware", "", "The package of the jwt "+
+		"middlewares, which provides <Name>Middleware(secret, prevSecret string) for each jwt: <Name>:<Auth>")
 	goCmd.Flags().StringVar(&gogen.VarStringHome, "home", "", "The goctl home path of "+
 		"the template, --home and --remote cannot be set at the same time, if they are, --remote "+
 		"has higher priority")
@@ -129,6 +201,8 @@ func init() {
 		"the remote repo, it does work with --remote")
 	goCmd.Flags().StringVar(&gogen.VarStringStyle, "style", "gozero", "The file naming format,"+
 		" see [https://github.com/zeromicro/go-zero/blob/master/tools/goctl/config/readme.md]")
+	goCmd.Flags().BoolVar(&gogen.VarBoolTests, "tests", false, "Generate the contract tests of "+
+		"the handlers with the logic stubbed")
 
 	javaCmd.Flags().StringVar(&javagen.VarStringDir, "dir", "", "The target dir")
 	javaCmd.Flags().StringVar(&javagen.VarStringAPI, "api", "", "The api file")
@@ -136,6 +210,25 @@ func init() {
 	ktCmd.Flags().StringVar(&ktgen.VarStringDir, "dir", "", "The target dir")
 	ktCmd.Flags().StringVar(&ktgen.VarStringAPI, "api", "", "The api file")
 	ktCmd.Flags().StringVar(&ktgen.VarStringPKG, "pkg", "", "Define package name for kotlin file")
+	ktCmd.Flags().BoolVar(&ktgen.VarBoolCoroutines, "coroutines", false, "Generate suspend functions "+
+		"and kotlinx.serialization data classes")
+
+	lintCmd.Flags().StringVar(&lint.VarStringAPI, "api", "", "The api file")
+	lintCmd.Flags().StringVar(&lint.VarStringConfig, "config", "", "The lint config file, default "+
+		"is "+lint.DefaultConfigFile+" in the working directory or the directory of the api file")
+	lintCmd.Flags().StringVar(&lint.VarStringFormat, "format", "text", "The output format of "+
+		"issues, text, json or sarif")
+	lintCmd.Flags().BoolVar(&lint.VarBoolFix, "fix", false, "Fix the mechanical issues and format the api file")
+	lintCmd.Flags().BoolVar(&lint.VarBoolList, "list", false, "List all the rules")
+
+	mockCmd.Flags().StringVar(&mock.VarStringAPI, "api", "", "The api file")
+	mockCmd.Flags().StringVar(&mock.VarStringHost, "host", "0.0.0.0", "The host of the mock server")
+	mockCmd.Flags().IntVar(&mock.VarIntPort, "port", 8888, "The port of the mock server")
+	mockCmd.Flags().Int64Var(&mock.VarInt64Seed, "seed", 1, "The seed of the fake data, the same "+
+		"seed generates the same responses")
+	mockCmd.Flags().StringVar(&mock.VarStringExamples, "examples", "", "The directory of the "+
+		"example files, the <group>/<handler>.json in it is responded instead of the fake data, the "+
+		"handlers out of the groups are <handler>.json")
 
 	newCmd.Flags().StringVar(&new.VarStringHome, "home", "", "The goctl home path of "+
 		"the template, --home and --remote cannot be set at the same time, if they are, --remote "+
@@ -156,24 +249,53 @@ func init() {
 	pluginCmd.Flags().StringVar(&plugin.VarStringAPI, "api", "", "The api file")
 	pluginCmd.Flags().StringVar(&plugin.VarStringStyle, "style", "",
 		"The file naming format, see [https://github.com/zeromicro/go-zero/tree/master/tools/goctl/config/readme.md]")
+	pluginCmd.Flags().StringVar(&plugin.VarStringLock, "lock", "goctl-plugin.lock", "The lock file "+
+		"which pins the remote plugins by sha256, each line is like <url> sha256:<hex>")
+	pluginCmd.Flags().BoolVar(&plugin.VarBoolUnpinned, "unpinned", false, "Run the remote plugins "+
+		"which are not pinned by sha256")
+	pluginCmd.Flags().BoolVar(&plugin.VarBoolSchema, "schema", false, "Print the json schema of the plugin protocol")
+
+	pythonCmd.Flags().StringVar(&pygen.VarStringDir, "dir", "", "The target dir of the python package")
+	pythonCmd.Flags().StringVar(&pygen.VarStringAPI, "api", "", "The api file")
+
+	rustCmd.Flags().StringVar(&rustgen.VarStringDir, "dir", "", "The target dir of the rust module")
+	rustCmd.Flags().StringVar(&rustgen.VarStringAPI, "api", "", "The api file")
+	rustCmd.Flags().BoolVar(&rustgen.VarBoolAxum, "axum", false, "Generate the axum route stubs, "+
+		"which are never overwritten")
+
+	swiftCmd.Flags().StringVar(&swiftgen.VarStringDir, "dir", "", "The target dir of the swift sources")
+	swiftCmd.Flags().StringVar(&swiftgen.VarStringAPI, "api", "", "The api file")
 
 	tsCmd.Flags().StringVar(&tsgen.VarStringDir, "dir", "", "The target dir")
 	tsCmd.Flags().StringVar(&tsgen.VarStringAPI, "api", "", "The api file")
 	tsCmd.Flags().StringVar(&tsgen.VarStringWebAPI, "webapi", "", "The web api file path")
 	tsCmd.Flags().StringVar(&tsgen.VarStringCaller, "caller", "", "The web api caller")
 	tsCmd.Flags().BoolVar(&tsgen.VarBoolUnWrap, "unwrap", false, "Unwrap the webapi caller for import")
+	tsCmd.Flags().StringVar(&tsgen.VarStringAdapter, "adapter", "", "Generate a self-contained client "+
+		"with one module per group, fetch or axios")
+	tsCmd.Flags().BoolVar(&tsgen.VarBoolZod, "zod", false, "Generate zod schemas to validate the "+
+		"responses, it does work with --adapter")
 
 	validateCmd.Flags().StringVar(&validate.VarStringAPI, "api", "", "Validate target api file")
+	validateCmd.Flags().StringVar(&validate.VarStringFormat, "format", "text", "The output format of "+
+		"diagnostics, text, json or sarif")
 
 	// Add sub-commands
+	Cmd.AddCommand(collectionCmd)
 	Cmd.AddCommand(dartCmd)
+	Cmd.AddCommand(diffCmd)
 	Cmd.AddCommand(docCmd)
 	Cmd.AddCommand(formatCmd)
 	Cmd.AddCommand(goCmd)
 	Cmd.AddCommand(javaCmd)
 	Cmd.AddCommand(ktCmd)
+	Cmd.AddCommand(lintCmd)
+	Cmd.AddCommand(mockCmd)
 	Cmd.AddCommand(newCmd)
 	Cmd.AddCommand(pluginCmd)
+	Cmd.AddCommand(pythonCmd)
+	Cmd.AddCommand(rustCmd)
+	Cmd.AddCommand(swiftCmd)
 	Cmd.AddCommand(tsCmd)
 	Cmd.AddCommand(validateCmd)
 }
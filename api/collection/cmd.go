@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var (
+	// VarStringDir describes the output directory of the collection.
+	VarStringDir string
+	// VarStringAPI describes the api file.
+	VarStringAPI string
+	// VarStringFormat describes the format of the collection, postman, insomnia or http.
+	VarStringFormat string
+	// VarStringURL describes the base url in the environment.
+	VarStringURL string
+)
+
+// CollectionCommand generates the request collection of the api file
+func CollectionCommand(_ *cobra.Command, _ []string) error {
+	apiFile := VarStringAPI
+	if apiFile == "" {
+		return errors.New("missing -api")
+	}
+	dir := VarStringDir
+	if dir == "" {
+		return errors.New("missing -dir")
+	}
+
+	api, err := parser.Parse(apiFile)
+	if err != nil {
+		return err
+	}
+
+	if err := api.Validate(); err != nil {
+		return err
+	}
+
+	if err := Generate(dir, VarStringFormat, VarStringURL, api); err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
@@ -0,0 +1,88 @@
+package collection
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+const (
+	formatPostman  = "postman"
+	formatInsomnia = "insomnia"
+	formatHTTP     = "http"
+
+	baseURLVar      = "base_url"
+	tokenVar        = "token"
+	jsonContentType = "application/json"
+)
+
+// Generate generates the collection of the api into dir in the format, which is one
+// of postman, insomnia and http, the base url is the value of the environment.
+func Generate(dir, format, baseURL string, api *spec.ApiSpec) error {
+	root, err := buildFolder(api)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	switch format {
+	case formatPostman:
+		collection, env := genPostman(root, baseURL)
+		if err := writeJSON(filepath.Join(dir, root.Name+".postman_collection.json"), collection); err != nil {
+			return err
+		}
+		return writeJSON(filepath.Join(dir, root.Name+".postman_environment.json"), env)
+	case formatInsomnia:
+		return writeJSON(filepath.Join(dir, root.Name+".insomnia.json"), genInsomnia(root, baseURL))
+	case formatHTTP:
+		files, err := genHTTP(root)
+		if err != nil {
+			return err
+		}
+
+		for name, content := range files {
+			filename := filepath.Join(dir, filepath.FromSlash(name))
+			if err := pathx.MkdirIfNotExist(filepath.Dir(filename)); err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(filename, []byte(content), 0o644); err != nil {
+				return err
+			}
+		}
+
+		env := map[string]map[string]string{"dev": {baseURLVar: baseURL}}
+		if err := writeJSON(filepath.Join(dir, "http-client.env.json"), env); err != nil {
+			return err
+		}
+		if !root.HasAuth() {
+			return nil
+		}
+
+		// the token is kept in the private environment, which is edited by the users
+		private := filepath.Join(dir, "http-client.private.env.json")
+		if pathx.FileExists(private) {
+			fmt.Printf("%s exists, ignored generation\n", private)
+			return nil
+		}
+		return writeJSON(private, map[string]map[string]string{"dev": {tokenVar: ""}})
+	default:
+		return fmt.Errorf("unsupported format %q, expected postman, insomnia or http", format)
+	}
+}
+
+func writeJSON(filename string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,74 @@
+package collection
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	api, err := parser.Parse("testdata/example.api")
+	assert.Nil(t, err)
+
+	for _, format := range []string{formatPostman, formatInsomnia, formatHTTP} {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			assert.Nil(t, Generate(dir, format, "http://localhost:8888", api))
+
+			files := listFiles(t, dir)
+			for _, file := range files {
+				actual, err := ioutil.ReadFile(filepath.Join(dir, file))
+				assert.Nil(t, err)
+
+				golden := filepath.Join("testdata", "golden", format+"."+strings.ReplaceAll(file, "/", "_")+".golden")
+				if *update {
+					assert.Nil(t, ioutil.WriteFile(golden, actual, 0o644))
+				}
+
+				expected, err := ioutil.ReadFile(golden)
+				assert.Nil(t, err)
+				assert.Equal(t, string(expected), string(actual), file)
+			}
+		})
+	}
+}
+
+func TestGenerateHTTPKeepsPrivateEnv(t *testing.T) {
+	api, err := parser.Parse("testdata/example.api")
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	private := filepath.Join(dir, "http-client.private.env.json")
+	assert.Nil(t, ioutil.WriteFile(private, []byte(`{"dev": {"token": "secret"}}`), 0o644))
+	assert.Nil(t, Generate(dir, formatHTTP, "http://localhost:8888", api))
+
+	content, err := ioutil.ReadFile(private)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"dev": {"token": "secret"}}`, string(content))
+	assert.NotNil(t, Generate(dir, "har", "http://localhost:8888", api))
+}
+
+func listFiles(t *testing.T, dir string) []string {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		files = append(files, filepath.ToSlash(rel))
+		return err
+	})
+	assert.Nil(t, err)
+	sort.Strings(files)
+	return files
+}
@@ -0,0 +1,101 @@
+package collection
+
+import (
+	"bytes"
+	_ "embed"
+	"path"
+	"strings"
+	"text/template"
+)
+
+//go:embed http.tpl
+var httpTemplate string
+
+type httpRequest struct {
+	Name    string
+	Doc     string
+	Method  string
+	URL     string
+	Headers []string
+	Body    string
+}
+
+// genHTTP returns the contents of the http request files by path, a file per folder,
+// the requests without group are in the file named after the service.
+func genHTTP(root *folder) (map[string]string, error) {
+	t, err := template.New("http").Parse(httpTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	var walk func(dir string, f *folder, name string) error
+	walk = func(dir string, f *folder, name string) error {
+		if len(f.Requests) > 0 {
+			var buf bytes.Buffer
+			if err := t.Execute(&buf, httpRequests(f.Requests)); err != nil {
+				return err
+			}
+			files[path.Join(dir, name+".http")] = strings.TrimRight(buf.String(), "\n") + "\n"
+		}
+
+		for _, each := range f.Folders {
+			if err := walk(path.Join(dir, name), each, each.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, each := range root.Folders {
+		if err := walk("", each, each.Name); err != nil {
+			return nil, err
+		}
+	}
+	if err := walk("", &folder{Requests: root.Requests}, root.Name); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// httpRequests converts the requests, the optional params are omitted since they
+// can't be disabled in the http files.
+func httpRequests(requests []request) []httpRequest {
+	var ret []httpRequest
+	for _, each := range requests {
+		req := httpRequest{
+			Name:   each.Name,
+			Doc:    each.Doc,
+			Method: each.Method,
+			URL:    "{{" + baseURLVar + "}}" + inlinePath(each),
+			Body:   each.Body,
+		}
+
+		var query []string
+		for _, q := range each.Query {
+			if !q.Optional {
+				query = append(query, q.Key+"="+q.Value)
+			}
+		}
+		if len(query) > 0 {
+			req.URL += "?" + strings.Join(query, "&")
+		}
+
+		for _, h := range each.Headers {
+			if !h.Optional {
+				req.Headers = append(req.Headers, strings.TrimSpace(h.Key+": "+h.Value))
+			}
+		}
+		if each.Auth {
+			req.Headers = append(req.Headers, "Authorization: Bearer {{"+tokenVar+"}}")
+		}
+		if len(each.Body) > 0 {
+			req.Headers = append(req.Headers, "Content-Type: "+jsonContentType)
+		}
+
+		ret = append(ret, req)
+	}
+
+	return ret
+}
@@ -0,0 +1,8 @@
+{{range $i, $req := .}}{{if $i}}
+{{end}}### {{$req.Name}}
+{{with $req.Doc}}# {{.}}
+{{end}}{{$req.Method}} {{$req.URL}}
+{{range $req.Headers}}{{.}}
+{{end}}{{with $req.Body}}
+{{.}}
+{{end}}{{end}}
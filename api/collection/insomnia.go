@@ -0,0 +1,150 @@
+package collection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+type (
+	insomniaExport struct {
+		Type      string             `json:"_type"`
+		Format    int                `json:"__export_format"`
+		Source    string             `json:"__export_source"`
+		Resources []insomniaResource `json:"resources"`
+	}
+
+	// insomniaResource is a workspace, an environment, a request group or a request
+	insomniaResource struct {
+		ID             string                 `json:"_id"`
+		Type           string                 `json:"_type"`
+		ParentID       *string                `json:"parentId"`
+		Name           string                 `json:"name"`
+		Description    string                 `json:"description,omitempty"`
+		Method         string                 `json:"method,omitempty"`
+		URL            string                 `json:"url,omitempty"`
+		Headers        []insomniaParam        `json:"headers,omitempty"`
+		Parameters     []insomniaParam        `json:"parameters,omitempty"`
+		Body           *insomniaBody          `json:"body,omitempty"`
+		Authentication map[string]string      `json:"authentication,omitempty"`
+		Data           map[string]interface{} `json:"data,omitempty"`
+	}
+
+	insomniaParam struct {
+		Name     string `json:"name"`
+		Value    string `json:"value"`
+		Disabled bool   `json:"disabled,omitempty"`
+	}
+
+	insomniaBody struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+
+	insomniaBuilder struct {
+		resources []insomniaResource
+		folders   int
+		requests  int
+	}
+)
+
+// genInsomnia returns the insomnia export v4 with the workspace, the environment, the
+// request groups and the requests, the ids are stable to keep the imports idempotent.
+func genInsomnia(root *folder, baseURL string) insomniaExport {
+	workspace := "wrk_" + resourceID(root.Name)
+	data := map[string]interface{}{baseURLVar: baseURL}
+	if root.HasAuth() {
+		data[tokenVar] = ""
+	}
+
+	b := &insomniaBuilder{
+		resources: []insomniaResource{
+			{ID: workspace, Type: "workspace", Name: root.Name},
+			{
+				ID:       "env_" + resourceID(root.Name),
+				Type:     "environment",
+				ParentID: &workspace,
+				Name:     "Base Environment",
+				Data:     data,
+			},
+		},
+	}
+	b.add(workspace, root)
+
+	return insomniaExport{
+		Type:      "export",
+		Format:    4,
+		Source:    "goctl",
+		Resources: b.resources,
+	}
+}
+
+func (b *insomniaBuilder) add(parent string, f *folder) {
+	for _, each := range f.Folders {
+		b.folders++
+		id := fmt.Sprintf("fld_%d", b.folders)
+		b.resources = append(b.resources, insomniaResource{
+			ID:       id,
+			Type:     "request_group",
+			ParentID: stringPtr(parent),
+			Name:     each.Name,
+		})
+		b.add(id, each)
+	}
+
+	for _, each := range f.Requests {
+		b.requests++
+		res := insomniaResource{
+			ID:          fmt.Sprintf("req_%d", b.requests),
+			Type:        "request",
+			ParentID:    stringPtr(parent),
+			Name:        each.Name,
+			Description: each.Doc,
+			Method:      each.Method,
+			URL:         "{{ _." + baseURLVar + " }}" + inlinePath(each),
+			Headers:     insomniaParams(each.Headers),
+			Parameters:  insomniaParams(each.Query),
+		}
+		if len(each.Body) > 0 {
+			res.Headers = append(res.Headers, insomniaParam{Name: "Content-Type", Value: jsonContentType})
+			res.Body = &insomniaBody{MimeType: jsonContentType, Text: each.Body}
+		}
+		if each.Auth {
+			res.Authentication = map[string]string{
+				"type":  "bearer",
+				"token": "{{ _." + tokenVar + " }}",
+			}
+		}
+		b.resources = append(b.resources, res)
+	}
+}
+
+func insomniaParams(params []param) []insomniaParam {
+	var ret []insomniaParam
+	for _, each := range params {
+		ret = append(ret, insomniaParam{Name: each.Key, Value: each.Value, Disabled: each.Optional})
+	}
+	return ret
+}
+
+// inlinePath replaces the path parameters with the examples
+func inlinePath(req request) string {
+	values := make(map[string]string)
+	for _, each := range req.Params {
+		values[each.Key] = each.Value
+	}
+
+	path, _ := util.ReplacePathParams(req.Path, func(name string) (string, bool) {
+		return values[name], true
+	})
+	return path
+}
+
+func resourceID(name string) string {
+	return strings.NewReplacer("-", "_", "/", "_", " ", "_").Replace(name)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,227 @@
+package collection
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+const (
+	authKey  = "jwt"
+	groupKey = "group"
+)
+
+type (
+	// folder is the folder of a group, the groups like user/admin are nested folders
+	folder struct {
+		Name     string
+		Folders  []*folder
+		Requests []request
+	}
+
+	request struct {
+		Name   string
+		Doc    string
+		Method string
+		// Path is the path with the parameters like /users/:id
+		Path    string
+		Params  []param
+		Query   []param
+		Headers []param
+		// Body is the indented json example of the body, empty if there is no json field
+		Body string
+		Auth bool
+	}
+
+	param struct {
+		Key   string
+		Value string
+		// Optional params are disabled in the collections
+		Optional bool
+	}
+)
+
+func buildFolder(api *spec.ApiSpec) (*folder, error) {
+	root := &folder{Name: api.Service.Name}
+	types := util.DefinedStructs(api)
+	service := api.Service.JoinPrefix()
+	for _, g := range service.Groups {
+		f := root
+		for _, name := range strings.Split(g.GetAnnotation(groupKey), "/") {
+			if len(name) > 0 {
+				f = f.child(name)
+			}
+		}
+
+		for _, r := range g.Routes {
+			req, err := buildRequest(g, r, types)
+			if err != nil {
+				return nil, err
+			}
+
+			f.Requests = append(f.Requests, req)
+		}
+	}
+
+	return root, nil
+}
+
+func (f *folder) child(name string) *folder {
+	for _, each := range f.Folders {
+		if each.Name == name {
+			return each
+		}
+	}
+
+	child := &folder{Name: name}
+	f.Folders = append(f.Folders, child)
+	return child
+}
+
+// HasAuth returns true if any request in the folder requires the bearer token
+func (f *folder) HasAuth() bool {
+	for _, each := range f.Requests {
+		if each.Auth {
+			return true
+		}
+	}
+	for _, each := range f.Folders {
+		if each.HasAuth() {
+			return true
+		}
+	}
+	return false
+}
+
+func buildRequest(g spec.Group, r spec.Route, types map[string]spec.DefineStruct) (request, error) {
+	req := request{
+		Name:   strings.TrimSuffix(r.Handler, "Handler"),
+		Doc:    strings.Trim(r.JoinedDoc(), `"`),
+		Method: strings.ToUpper(r.Method),
+		Path:   r.Path,
+		Auth:   len(g.GetAnnotation(authKey)) > 0,
+	}
+	if len(req.Name) == 0 {
+		req.Name = req.Method + " " + req.Path
+	}
+
+	if r.RequestType == nil {
+		if util.HasPathParams(r.Path) {
+			return req, fmt.Errorf("route %s %s: path parameters require a request type", r.Method, r.Path)
+		}
+		return req, nil
+	}
+
+	ds, ok := r.RequestType.(spec.DefineStruct)
+	if !ok {
+		return req, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+			r.RequestType.Name())
+	}
+	if declared, ok := types[ds.RawName]; ok {
+		ds = declared
+	}
+
+	paths := make(map[string]bool)
+	for _, field := range util.Fields(ds, types) {
+		if field.Location == util.JSONLocation {
+			continue
+		}
+
+		// the params with default values are sent to show the defaults
+		_, hasDefault := tagValue(field)
+		p := param{
+			Key:      field.WireName,
+			Value:    paramValue(field),
+			Optional: field.Optional && !hasDefault,
+		}
+		switch field.Location {
+		case util.PathLocation:
+			if len(p.Value) == 0 {
+				p.Value = field.WireName
+			}
+			paths[field.WireName] = true
+			req.Params = append(req.Params, p)
+		case util.FormLocation:
+			req.Query = append(req.Query, p)
+		case util.HeaderLocation:
+			req.Headers = append(req.Headers, p)
+		}
+	}
+
+	if _, err := util.ReplacePathParams(r.Path, func(name string) (string, bool) {
+		return name, paths[name]
+	}); err != nil {
+		return req, err
+	}
+
+	if body := newExample(types).Value(ds, ""); len(body.(util.Object)) > 0 {
+		data, err := json.MarshalIndent(body, "", "  ")
+		if err != nil {
+			return req, err
+		}
+		req.Body = string(data)
+	}
+
+	return req, nil
+}
+
+// newExample returns the examples of the json bodies, the default value or the first option
+// of the tag is preferred.
+func newExample(types map[string]spec.DefineStruct) *util.Example {
+	return &util.Example{
+		Types: types,
+		Rule: func(field util.Field, tp string) (interface{}, bool) {
+			value, ok := tagValue(field)
+			return util.PrimitiveValue(tp, value), ok
+		},
+		Primitive: func(tp, _ string) interface{} {
+			return util.PrimitiveValue(tp, "")
+		},
+		Items: func() int {
+			return 1
+		},
+		Ordered: true,
+	}
+}
+
+// paramValue returns the example of the path, query or header param
+func paramValue(field util.Field) string {
+	if value, ok := tagValue(field); ok {
+		return value
+	}
+
+	tp, ok := util.UnwrapPointer(field.Type).(spec.PrimitiveType)
+	if !ok {
+		return ""
+	}
+	value := util.PrimitiveValue(tp.RawName, "")
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// tagValue returns the default value or the first option of the tag
+func tagValue(field util.Field) (string, bool) {
+	for _, tag := range field.Tags() {
+		if tag.Key != field.Location {
+			continue
+		}
+
+		for _, option := range tag.Options {
+			if strings.HasPrefix(option, "default=") {
+				return strings.TrimPrefix(option, "default="), true
+			}
+		}
+		for _, option := range tag.Options {
+			if strings.HasPrefix(option, "options=") {
+				return strings.Split(strings.TrimPrefix(option, "options="), "|")[0], true
+			}
+		}
+	}
+
+	return "", false
+}
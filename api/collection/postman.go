@@ -0,0 +1,158 @@
+package collection
+
+import (
+	"strings"
+)
+
+const postmanSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+type (
+	postmanCollection struct {
+		Info postmanInfo   `json:"info"`
+		Item []postmanItem `json:"item"`
+	}
+
+	postmanInfo struct {
+		Name   string `json:"name"`
+		Schema string `json:"schema"`
+	}
+
+	// postmanItem is a folder if Item is not empty, otherwise it's a request
+	postmanItem struct {
+		Name    string          `json:"name"`
+		Item    []postmanItem   `json:"item,omitempty"`
+		Request *postmanRequest `json:"request,omitempty"`
+	}
+
+	postmanRequest struct {
+		Method      string         `json:"method"`
+		Description string         `json:"description,omitempty"`
+		Header      []postmanParam `json:"header"`
+		URL         postmanURL     `json:"url"`
+		Body        *postmanBody   `json:"body,omitempty"`
+		Auth        *postmanAuth   `json:"auth,omitempty"`
+	}
+
+	postmanURL struct {
+		Raw      string         `json:"raw"`
+		Host     []string       `json:"host"`
+		Path     []string       `json:"path"`
+		Query    []postmanParam `json:"query,omitempty"`
+		Variable []postmanParam `json:"variable,omitempty"`
+	}
+
+	postmanParam struct {
+		Key      string `json:"key"`
+		Value    string `json:"value"`
+		Disabled bool   `json:"disabled,omitempty"`
+	}
+
+	postmanBody struct {
+		Mode    string                 `json:"mode"`
+		Raw     string                 `json:"raw"`
+		Options map[string]interface{} `json:"options"`
+	}
+
+	postmanAuth struct {
+		Type   string         `json:"type"`
+		Bearer []postmanParam `json:"bearer"`
+	}
+
+	postmanEnvironment struct {
+		Name   string            `json:"name"`
+		Values []postmanVariable `json:"values"`
+		Scope  string            `json:"_postman_variable_scope"`
+	}
+
+	postmanVariable struct {
+		Key     string `json:"key"`
+		Value   string `json:"value"`
+		Type    string `json:"type"`
+		Enabled bool   `json:"enabled"`
+	}
+)
+
+// genPostman returns the postman collection v2.1 and its environment
+func genPostman(root *folder, baseURL string) (postmanCollection, postmanEnvironment) {
+	collection := postmanCollection{
+		Info: postmanInfo{Name: root.Name, Schema: postmanSchema},
+		Item: postmanItems(root),
+	}
+
+	env := postmanEnvironment{
+		Name: root.Name,
+		Values: []postmanVariable{
+			{Key: baseURLVar, Value: baseURL, Type: "default", Enabled: true},
+		},
+		Scope: "environment",
+	}
+	if root.HasAuth() {
+		env.Values = append(env.Values, postmanVariable{Key: tokenVar, Type: "secret", Enabled: true})
+	}
+
+	return collection, env
+}
+
+func postmanItems(f *folder) []postmanItem {
+	items := []postmanItem{}
+	for _, each := range f.Folders {
+		items = append(items, postmanItem{Name: each.Name, Item: postmanItems(each)})
+	}
+	for _, each := range f.Requests {
+		items = append(items, postmanItem{Name: each.Name, Request: postmanRequestOf(each)})
+	}
+	return items
+}
+
+func postmanRequestOf(req request) *postmanRequest {
+	ret := &postmanRequest{
+		Method:      req.Method,
+		Description: req.Doc,
+		Header:      postmanParams(req.Headers),
+		URL: postmanURL{
+			Host:     []string{"{{" + baseURLVar + "}}"},
+			Path:     strings.Split(strings.TrimPrefix(req.Path, "/"), "/"),
+			Query:    postmanParams(req.Query),
+			Variable: postmanParams(req.Params),
+		},
+	}
+
+	raw := "{{" + baseURLVar + "}}" + req.Path
+	var query []string
+	for _, each := range ret.URL.Query {
+		if !each.Disabled {
+			query = append(query, each.Key+"="+each.Value)
+		}
+	}
+	if len(query) > 0 {
+		raw += "?" + strings.Join(query, "&")
+	}
+	ret.URL.Raw = raw
+
+	if len(req.Body) > 0 {
+		ret.Header = append(ret.Header, postmanParam{Key: "Content-Type", Value: jsonContentType})
+		ret.Body = &postmanBody{
+			Mode: "raw",
+			Raw:  req.Body,
+			Options: map[string]interface{}{
+				"raw": map[string]string{"language": "json"},
+			},
+		}
+	}
+	if req.Auth {
+		ret.Auth = &postmanAuth{
+			Type:   "bearer",
+			Bearer: []postmanParam{{Key: "token", Value: "{{" + tokenVar + "}}"}},
+		}
+	}
+
+	return ret
+}
+
+func postmanParams(params []param) []postmanParam {
+	ret := []postmanParam{}
+	for _, each := range params {
+		ret = append(ret, postmanParam{Key: each.Key, Value: each.Value, Disabled: each.Optional})
+	}
+	return ret
+}
@@ -0,0 +1,364 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/pkg/compat"
+)
+
+const (
+	codeRemovedRoute     = "removed-route"
+	codeAddedRoute       = "added-route"
+	codeChangedRoute     = "changed-route"
+	codeRemovedMember    = "removed-member"
+	codeAddedMember      = "added-member"
+	codeChangedType      = "changed-type"
+	codeChangedSource    = "changed-source"
+	codeOptionalRequired = "optional-to-required"
+	codeRequiredOptional = "required-to-optional"
+	codeChangedBody      = "changed-body"
+
+	optionalOption = "optional"
+)
+
+var (
+	// VarStringOld describes the old api file or git revision.
+	VarStringOld string
+	// VarStringNew describes the new api file or git revision.
+	VarStringNew string
+	// VarStringFormat describes the output format, text or json.
+	VarStringFormat string
+
+	sourceKeys = []string{"json", "form", "path", "header"}
+)
+
+type (
+	direction int
+
+	member struct {
+		spec.Member
+		source   string
+		name     string
+		optional bool
+	}
+
+	route struct {
+		spec.Route
+		group string
+	}
+
+	comparer struct {
+		report   compat.Report
+		oldTypes map[string]spec.DefineStruct
+		newTypes map[string]spec.DefineStruct
+	}
+)
+
+const (
+	request direction = iota
+	response
+)
+
+// DiffCommand compares two versions of an api file and reports the changes,
+// it fails if there is any breaking change, only the report is written to the output.
+func DiffCommand(cmd *cobra.Command, _ []string) error {
+	if len(VarStringOld) == 0 {
+		return errors.New("missing --old")
+	}
+	if len(VarStringNew) == 0 {
+		return errors.New("missing --new")
+	}
+
+	oldSpec, err := parse(VarStringOld)
+	if err != nil {
+		return err
+	}
+
+	newSpec, err := parse(VarStringNew)
+	if err != nil {
+		return err
+	}
+
+	report := Compare(oldSpec, newSpec)
+	if err = report.Print(cmd.OutOrStdout(), VarStringFormat); err != nil {
+		return err
+	}
+
+	if report.Breaking() {
+		return errors.New("breaking changes found")
+	}
+
+	return nil
+}
+
+func parse(source string) (*spec.ApiSpec, error) {
+	file, cleanup, err := compat.Resolve(source)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	return parser.Parse(file)
+}
+
+// Compare compares the routes of two api specs and classifies the changes
+func Compare(oldSpec, newSpec *spec.ApiSpec) compat.Report {
+	c := comparer{
+		oldTypes: definedTypes(oldSpec),
+		newTypes: definedTypes(newSpec),
+	}
+
+	oldRoutes, _ := indexRoutes(oldSpec)
+	newRoutes, newHandlers := indexRoutes(newSpec)
+	matched := make(map[string]bool)
+	for _, key := range sortedKeys(oldRoutes) {
+		oldRoute := oldRoutes[key]
+		if newRoute, ok := newRoutes[key]; ok {
+			matched[key] = true
+			c.compareRoute(key, oldRoute, newRoute)
+			continue
+		}
+
+		handler := handlerOf(oldRoute)
+		if newKey, ok := newHandlers[handler]; ok && !matched[newKey] {
+			if _, exists := oldRoutes[newKey]; !exists {
+				matched[newKey] = true
+				c.report.Add(compat.Breaking, codeChangedRoute, key, "route of handler %s changed to %s",
+					oldRoute.Handler, newKey)
+				c.compareRoute(key, oldRoute, newRoutes[newKey])
+				continue
+			}
+		}
+
+		c.report.Add(compat.Breaking, codeRemovedRoute, key, "route removed")
+	}
+
+	for _, key := range sortedKeys(newRoutes) {
+		if matched[key] {
+			continue
+		}
+		if _, ok := oldRoutes[key]; ok {
+			continue
+		}
+		c.report.Add(compat.Compatible, codeAddedRoute, key, "route added")
+	}
+
+	return c.report
+}
+
+func indexRoutes(api *spec.ApiSpec) (map[string]route, map[string]string) {
+	routes := make(map[string]route)
+	handlers := make(map[string]string)
+	for _, g := range api.Service.Groups {
+		prefix := strings.Trim(strings.TrimSpace(g.GetAnnotation(spec.RoutePrefixKey)), `"`)
+		group := g.GetAnnotation("group")
+		for _, r := range g.Routes {
+			key := spec.RouteKey(r.Method, prefix, r.Path)
+			item := route{Route: r, group: group}
+			routes[key] = item
+			handlers[handlerOf(item)] = key
+		}
+	}
+	return routes, handlers
+}
+
+func sortedKeys(routes map[string]route) []string {
+	var keys []string
+	for key := range routes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func handlerOf(r route) string {
+	return spec.HandlerKey(r.group, r.Handler)
+}
+
+func (c *comparer) compareRoute(path string, oldRoute, newRoute route) {
+	c.compareType(path+" request", oldRoute.RequestType, newRoute.RequestType, request, map[string]bool{})
+	c.compareType(path+" response", oldRoute.ResponseType, newRoute.ResponseType, response, map[string]bool{})
+}
+
+func (c *comparer) compareType(path string, oldType, newType spec.Type, dir direction, visited map[string]bool) {
+	oldType, newType = unwrapPointer(oldType), unwrapPointer(newType)
+	switch {
+	case oldType == nil && newType == nil:
+		return
+	case oldType == nil:
+		if dir == request {
+			c.report.Add(compat.Breaking, codeChangedBody, path, "%s body added", newType.Name())
+		} else {
+			c.report.Add(compat.Compatible, codeChangedBody, path, "%s body added", newType.Name())
+		}
+		return
+	case newType == nil:
+		if dir == request {
+			c.report.Add(compat.Compatible, codeChangedBody, path, "%s body removed", oldType.Name())
+		} else {
+			c.report.Add(compat.Breaking, codeChangedBody, path, "%s body removed", oldType.Name())
+		}
+		return
+	}
+
+	switch ov := oldType.(type) {
+	case spec.DefineStruct:
+		nv, ok := newType.(spec.DefineStruct)
+		if !ok {
+			break
+		}
+
+		// the struct name is not a part of the contract, only the members are compared.
+		key := ov.RawName + "/" + nv.RawName
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+		c.compareMembers(path, c.resolve(ov, c.oldTypes), c.resolve(nv, c.newTypes), dir, visited)
+		return
+	case spec.ArrayType:
+		nv, ok := newType.(spec.ArrayType)
+		if !ok {
+			break
+		}
+
+		c.compareType(path+"[]", ov.Value, nv.Value, dir, visited)
+		return
+	case spec.MapType:
+		nv, ok := newType.(spec.MapType)
+		if !ok || ov.Key != nv.Key {
+			break
+		}
+
+		c.compareType(path+"{}", ov.Value, nv.Value, dir, visited)
+		return
+	default:
+		if oldType.Name() == newType.Name() {
+			return
+		}
+	}
+
+	c.report.Add(compat.Breaking, codeChangedType, path, "type changed from %s to %s",
+		oldType.Name(), newType.Name())
+}
+
+func (c *comparer) compareMembers(path string, oldStruct, newStruct spec.DefineStruct, dir direction,
+	visited map[string]bool) {
+	oldMembers := c.members(oldStruct, c.oldTypes)
+	newMembers := c.members(newStruct, c.newTypes)
+	newByName := make(map[string]member)
+	for _, each := range newMembers {
+		newByName[each.name] = each
+	}
+
+	oldByName := make(map[string]member)
+	for _, om := range oldMembers {
+		oldByName[om.name] = om
+		memberPath := fmt.Sprintf("%s.%s", path, om.name)
+		nm, ok := newByName[om.name]
+		if !ok {
+			c.report.Add(compat.Breaking, codeRemovedMember, memberPath, "member %s removed", om.Name)
+			continue
+		}
+
+		if om.source != nm.source {
+			c.report.Add(compat.Breaking, codeChangedSource, memberPath, "member moved from %s to %s",
+				om.source, nm.source)
+		}
+
+		switch {
+		case om.optional && !nm.optional && dir == request:
+			c.report.Add(compat.Breaking, codeOptionalRequired, memberPath, "member changed from optional to required")
+		case om.optional && !nm.optional:
+			c.report.Add(compat.Compatible, codeOptionalRequired, memberPath, "member changed from optional to required")
+		case !om.optional && nm.optional && dir == response:
+			c.report.Add(compat.Breaking, codeRequiredOptional, memberPath, "member changed from required to optional")
+		case !om.optional && nm.optional:
+			c.report.Add(compat.Compatible, codeRequiredOptional, memberPath, "member changed from required to optional")
+		}
+
+		c.compareType(memberPath, om.Type, nm.Type, dir, visited)
+	}
+
+	for _, nm := range newMembers {
+		if _, ok := oldByName[nm.name]; ok {
+			continue
+		}
+
+		memberPath := fmt.Sprintf("%s.%s", path, nm.name)
+		if dir == request && !nm.optional {
+			c.report.Add(compat.Breaking, codeAddedMember, memberPath, "required member %s added", nm.Name)
+		} else {
+			c.report.Add(compat.Compatible, codeAddedMember, memberPath, "member %s added", nm.Name)
+		}
+	}
+}
+
+// members flattens the inline members and resolves the wire name of the members
+func (c *comparer) members(tp spec.DefineStruct, types map[string]spec.DefineStruct) []member {
+	var result []member
+	for _, each := range tp.Members {
+		if each.IsInline {
+			if inline, ok := unwrapPointer(each.Type).(spec.DefineStruct); ok {
+				result = append(result, c.members(c.resolve(inline, types), types)...)
+			}
+			continue
+		}
+
+		m := member{Member: each, name: each.Name}
+		tags, err := spec.Parse(each.Tag)
+		if err == nil {
+			for _, key := range sourceKeys {
+				tag, err := tags.Get(key)
+				if err != nil {
+					continue
+				}
+
+				m.source, m.name = key, tag.Name
+				for _, option := range tag.Options {
+					if option == optionalOption || strings.HasPrefix(option, "default=") {
+						m.optional = true
+					}
+				}
+				break
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// resolve returns the declared struct with members, the struct referenced in arrays,
+// maps and pointers only has the name.
+func (c *comparer) resolve(tp spec.DefineStruct, types map[string]spec.DefineStruct) spec.DefineStruct {
+	if len(tp.Members) > 0 {
+		return tp
+	}
+	if declared, ok := types[tp.RawName]; ok {
+		return declared
+	}
+	return tp
+}
+
+func definedTypes(api *spec.ApiSpec) map[string]spec.DefineStruct {
+	types := make(map[string]spec.DefineStruct)
+	for _, each := range api.Types {
+		if ds, ok := each.(spec.DefineStruct); ok {
+			types[ds.RawName] = ds
+		}
+	}
+	return types
+}
+
+func unwrapPointer(tp spec.Type) spec.Type {
+	if pointer, ok := tp.(spec.PointerType); ok {
+		return unwrapPointer(pointer.Type)
+	}
+	return tp
+}
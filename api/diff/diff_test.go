@@ -0,0 +1,110 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/pkg/compat"
+)
+
+const oldAPI = `syntax = "v1"
+
+type Req {
+	Name string ` + "`json:\"name\"`" + `
+	Age int ` + "`json:\"age,optional\"`" + `
+}
+
+type Resp {
+	Id int64 ` + "`json:\"id\"`" + `
+}
+
+service user {
+	@handler GetUserHandler
+	get /user (Req) returns (Resp)
+	@handler DeleteUserHandler
+	delete /user (Req)
+}
+`
+
+const newAPI = `syntax = "v1"
+
+type Req {
+	Name string ` + "`json:\"name\"`" + `
+	Age int ` + "`json:\"age\"`" + `
+	Nick string ` + "`json:\"nick,optional\"`" + `
+}
+
+type Resp {
+	Id string ` + "`json:\"id\"`" + `
+	Extra string ` + "`json:\"extra\"`" + `
+}
+
+service user {
+	@handler GetUserHandler
+	get /users (Req) returns (Resp)
+	@handler CreateUserHandler
+	post /user (Req)
+}
+`
+
+func TestCompare(t *testing.T) {
+	oldSpec := mustParse(t, oldAPI)
+	newSpec := mustParse(t, newAPI)
+
+	report := Compare(oldSpec, newSpec)
+	assert.True(t, report.Breaking())
+	assert.ElementsMatch(t, []compat.Change{
+		{Kind: compat.Breaking, Code: codeRemovedRoute, Path: "delete /user", Message: "route removed"},
+		{Kind: compat.Breaking, Code: codeChangedRoute, Path: "get /user",
+			Message: "route of handler GetUserHandler changed to get /users"},
+		{Kind: compat.Breaking, Code: codeOptionalRequired, Path: "get /user request.age",
+			Message: "member changed from optional to required"},
+		{Kind: compat.Compatible, Code: codeAddedMember, Path: "get /user request.nick",
+			Message: "member Nick added"},
+		{Kind: compat.Breaking, Code: codeChangedType, Path: "get /user response.id",
+			Message: "type changed from int64 to string"},
+		{Kind: compat.Compatible, Code: codeAddedMember, Path: "get /user response.extra",
+			Message: "member Extra added"},
+		{Kind: compat.Compatible, Code: codeAddedRoute, Path: "post /user", Message: "route added"},
+	}, []compat.Change(report))
+}
+
+func TestCompareSame(t *testing.T) {
+	api := mustParse(t, oldAPI)
+	report := Compare(api, api)
+	assert.False(t, report.Breaking())
+	assert.Empty(t, report)
+}
+
+func TestDiffCommand(t *testing.T) {
+	VarStringOld = filepath.Join(t.TempDir(), "user.api")
+	VarStringNew = filepath.Join(t.TempDir(), "user.api")
+	VarStringFormat = "json"
+	defer func() {
+		VarStringOld, VarStringNew, VarStringFormat = "", "", ""
+	}()
+	assert.Nil(t, ioutil.WriteFile(VarStringOld, []byte(oldAPI), 0o644))
+	assert.Nil(t, ioutil.WriteFile(VarStringNew, []byte(newAPI), 0o644))
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	assert.NotNil(t, DiffCommand(cmd, nil))
+	// the error of the breaking changes is not mixed with the report
+	assert.True(t, json.Valid(out.Bytes()))
+}
+
+func mustParse(t *testing.T, content string) *spec.ApiSpec {
+	file := filepath.Join(t.TempDir(), "user.api")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(content), 0o644))
+	api, err := parser.Parse(file)
+	assert.Nil(t, err)
+	return api
+}
@@ -10,8 +10,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	"github.com/yeyudekuangxiang/goctl/api/parser"
 	"github.com/yeyudekuangxiang/goctl/api/util"
@@ -24,9 +27,17 @@ const (
 	rightParenthesis = ")"
 	leftBrace        = "{"
 	rightBrace       = "}"
+	importKeyword    = "import"
+	typeKeyword      = "type"
+	serviceKeyword   = "service"
+	serverAnnotation = "@server"
+	stdinName        = "<standard input>"
 )
 
 var (
+	keyValueRegex    = regexp.MustCompile(`^([A-Za-z_][\w-]*)\s*:\s*(\S.*)$`)
+	emptyStructRegex = regexp.MustCompile(` struct\s*\{\}`)
+
 	// VarBoolUseStdin describes whether to use stdin or not.
 	VarBoolUseStdin bool
 	// VarBoolSkipCheckDeclare describes whether to skip.
@@ -35,13 +46,44 @@ var (
 	VarStringDir string
 	// VarBoolIgnore describes whether to ignore.
 	VarBoolIgnore bool
+	// VarBoolCheck describes whether to list the unformatted files and fail instead of formatting.
+	VarBoolCheck bool
+	// VarBoolDiff describes whether to print the diffs of the unformatted files instead of formatting.
+	VarBoolDiff bool
 )
 
 // GoFormatApi format api file
 func GoFormatApi(_ *cobra.Command, _ []string) error {
 	var be errorx.BatchError
+	var unformatted int
+	verify := func(name string, data []byte, filename string) {
+		diff, err := formatDiff(name, string(data), filename, VarBoolSkipCheckDeclare)
+		if err != nil {
+			be.Add(util.WrapErr(err, name))
+			return
+		}
+		if len(diff) == 0 {
+			return
+		}
+
+		unformatted++
+		if VarBoolCheck {
+			fmt.Println(name)
+		}
+		if VarBoolDiff {
+			fmt.Print(diff)
+		}
+	}
+
 	if VarBoolUseStdin {
-		if err := apiFormatReader(os.Stdin, VarStringDir, VarBoolSkipCheckDeclare); err != nil {
+		if VarBoolCheck || VarBoolDiff {
+			data, err := ioutil.ReadAll(os.Stdin)
+			if err != nil {
+				return err
+			}
+
+			verify(stdinName, data, VarStringDir)
+		} else if err := apiFormatReader(os.Stdin, VarStringDir, VarBoolSkipCheckDeclare); err != nil {
 			be.Add(err)
 		}
 	} else {
@@ -55,10 +97,26 @@ func GoFormatApi(_ *cobra.Command, _ []string) error {
 		}
 
 		err = filepath.Walk(VarStringDir, func(path string, fi os.FileInfo, errBack error) (err error) {
-			if strings.HasSuffix(path, ".api") {
-				if err := ApiFormatByPath(path, VarBoolSkipCheckDeclare); err != nil {
-					be.Add(util.WrapErr(err, fi.Name()))
+			if !strings.HasSuffix(path, ".api") {
+				return nil
+			}
+
+			if VarBoolCheck || VarBoolDiff {
+				data, err := ioutil.ReadFile(path)
+				if err != nil {
+					be.Add(err)
+					return nil
 				}
+
+				abs, err := filepath.Abs(path)
+				if err != nil {
+					be.Add(err)
+					return nil
+				}
+
+				verify(path, data, abs)
+			} else if err := ApiFormatByPath(path, VarBoolSkipCheckDeclare); err != nil {
+				be.Add(util.WrapErr(err, fi.Name()))
 			}
 			return nil
 		})
@@ -70,7 +128,11 @@ func GoFormatApi(_ *cobra.Command, _ []string) error {
 		os.Exit(1)
 	}
 
-	return be.Err()
+	if VarBoolCheck && unformatted > 0 {
+		return fmt.Errorf("%d api file(s) not formatted, run goctl api format to format them", unformatted)
+	}
+
+	return nil
 }
 
 // apiFormatReader
@@ -114,6 +176,43 @@ func ApiFormatByPath(apiFilePath string, skipCheckDeclare bool) error {
 	return ioutil.WriteFile(apiFilePath, []byte(result), os.ModePerm)
 }
 
+// formatDiff returns the unified diff between the api content and the formatted
+// content, it's empty if the content is formatted.
+func formatDiff(name, data, filename string, skipCheckDeclare bool) (string, error) {
+	result, err := apiFormat(data, skipCheckDeclare, filename)
+	if err != nil {
+		return "", err
+	}
+
+	// the line break at the end of the file is left to the editors, it's not checked
+	if strings.HasSuffix(data, pathx.NL) {
+		result += pathx.NL
+	}
+	if result == data {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(data),
+		B:        splitLines(result),
+		FromFile: name + ".orig",
+		ToFile:   name,
+		Context:  3,
+	})
+}
+
+// splitLines splits the content into lines with the line breaks, unlike difflib.SplitLines
+// it doesn't add an empty line after the last line break.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, pathx.NL)
+	if last := len(lines) - 1; len(lines[last]) == 0 {
+		lines = lines[:last]
+	} else {
+		lines[last] += pathx.NL
+	}
+	return lines
+}
+
 func apiFormat(data string, skipCheckDeclare bool, filename ...string) (string, error) {
 	var err error
 	if skipCheckDeclare {
@@ -129,20 +228,25 @@ func apiFormat(data string, skipCheckDeclare bool, filename ...string) (string,
 	s := bufio.NewScanner(strings.NewReader(data))
 	tapCount := 0
 	newLineCount := 0
-	var preLine string
+	// separate is true if a blank line is needed after a top-level block
+	separate := false
+	var keyword, preLine string
 	for s.Scan() {
 		line := strings.TrimSpace(s.Text())
 		if len(line) == 0 {
-			if newLineCount > 0 {
+			// the blank lines at the beginning of the blocks are removed
+			if newLineCount > 0 || strings.HasSuffix(preLine, leftParenthesis) ||
+				strings.HasSuffix(preLine, leftBrace) {
 				continue
 			}
 			newLineCount++
 		} else {
-			if preLine == rightBrace {
+			if separate {
 				builder.WriteString(pathx.NL)
 			}
 			newLineCount = 0
 		}
+		separate = false
 
 		if tapCount == 0 {
 			format, err := formatGoTypeDef(line, s, &builder)
@@ -151,13 +255,22 @@ func apiFormat(data string, skipCheckDeclare bool, filename ...string) (string,
 			}
 
 			if format {
+				separate = true
 				continue
 			}
 		}
 
 		noCommentLine := util.RemoveComment(line)
+		preLine = noCommentLine
 		if noCommentLine == rightParenthesis || noCommentLine == rightBrace {
+			// the blank lines at the end of the blocks are removed
+			if content := builder.String(); strings.HasSuffix(content, pathx.NL+pathx.NL) {
+				builder.Reset()
+				builder.WriteString(strings.TrimSuffix(content, pathx.NL))
+			}
 			tapCount--
+			// the annotation of the service is kept together with the service
+			separate = tapCount == 0 && keyword != serverAnnotation
 		}
 		if tapCount < 0 {
 			line := strings.TrimSuffix(noCommentLine, rightBrace)
@@ -166,15 +279,100 @@ func apiFormat(data string, skipCheckDeclare bool, filename ...string) (string,
 				tapCount++
 			}
 		}
-		util.WriteIndent(&builder, tapCount)
+		// the routes are in the service, the key-value pairs are in the annotations
+		if tapCount > 1 || tapCount == 1 && keyword != serviceKeyword {
+			line = keyValueRegex.ReplaceAllString(line, "$1: $2")
+		}
+		if len(line) > 0 {
+			util.WriteIndent(&builder, tapCount)
+		}
 		builder.WriteString(line + pathx.NL)
+		if tapCount == 0 && strings.HasPrefix(noCommentLine, typeKeyword+" ") {
+			// the type declared in one line like type Empty {}
+			separate = true
+		}
 		if strings.HasSuffix(noCommentLine, leftParenthesis) || strings.HasSuffix(noCommentLine, leftBrace) {
+			if tapCount == 0 {
+				keyword = strings.TrimSuffix(strings.Fields(noCommentLine)[0], leftParenthesis)
+			}
 			tapCount++
 		}
-		preLine = line
 	}
 
-	return strings.TrimSpace(builder.String()), nil
+	return sortImports(strings.TrimSpace(builder.String())), nil
+}
+
+// sortImports sorts the consecutive import statements and the imports in the
+// import groups, the comments are kept with the imports below them.
+func sortImports(text string) string {
+	lines := strings.Split(text, pathx.NL)
+	var result []string
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, importKeyword+" \""):
+			j := i
+			for j < len(lines) && strings.HasPrefix(lines[j], importKeyword+" \"") {
+				j++
+			}
+			result = append(result, sortLines(lines[i:j])...)
+			i = j
+		case util.RemoveComment(line) == importKeyword+" "+leftParenthesis ||
+			util.RemoveComment(line) == importKeyword+leftParenthesis:
+			result = append(result, line)
+			i++
+			for i < len(lines) && util.RemoveComment(lines[i]) != rightParenthesis {
+				j := i
+				for j < len(lines) && len(strings.TrimSpace(lines[j])) > 0 &&
+					util.RemoveComment(lines[j]) != rightParenthesis {
+					j++
+				}
+				result = append(result, sortLines(lines[i:j])...)
+				if j < len(lines) && len(strings.TrimSpace(lines[j])) == 0 {
+					result = append(result, lines[j])
+					j++
+				}
+				i = j
+			}
+		default:
+			result = append(result, line)
+			i++
+		}
+	}
+
+	return strings.Join(result, pathx.NL)
+}
+
+// sortLines sorts the import lines, the comment lines are attached to the next import
+func sortLines(lines []string) []string {
+	var (
+		entries  [][]string
+		comments []string
+	)
+	for _, line := range lines {
+		if len(util.RemoveComment(line)) == 0 {
+			comments = append(comments, line)
+			continue
+		}
+
+		entries = append(entries, append(comments, line))
+		comments = nil
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return importPath(entries[i]) < importPath(entries[j])
+	})
+
+	var result []string
+	for _, entry := range entries {
+		result = append(result, entry...)
+	}
+	return append(result, comments...)
+}
+
+func importPath(entry []string) string {
+	line := util.RemoveComment(entry[len(entry)-1])
+	return strings.TrimSpace(strings.TrimPrefix(line, importKeyword))
 }
 
 func formatGoTypeDef(line string, scanner *bufio.Scanner, builder *strings.Builder) (bool, error) {
@@ -197,6 +395,7 @@ func formatGoTypeDef(line string, scanner *bufio.Scanner, builder *strings.Build
 				}
 
 				result := strings.ReplaceAll(string(ts), " struct ", " ")
+				result = emptyStructRegex.ReplaceAllString(result, " {}")
 				result = strings.ReplaceAll(result, "type ()", "")
 				builder.WriteString(result)
 				break
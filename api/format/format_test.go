@@ -1,17 +1,22 @@
 package format
 
 import (
+	"flag"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var update = flag.Bool("update", false, "update the golden files")
+
 const (
 	notFormattedStr = `
 type Request struct {
@@ -32,10 +37,12 @@ handler: GreetHandler
 	formattedStr = `type Request {
 	Name string ` + "`" + `path:"name,options=you|me"` + "`" + `
 }
+
 type Response {
 	Message  string    ` + "`" + `json:"message"` + "`" + `
 	Students []Student ` + "`" + `json:"students"` + "`" + `
 }
+
 service A-api {
 	@server(
 		handler: GreetHandler
@@ -74,3 +81,78 @@ func Test_apiFormatReader_issue1721(t *testing.T) {
 	err = apiFormatReader(f, filename, false)
 	assert.NoError(t, err)
 }
+
+func TestFormatGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "comments of the type, service, @doc and info blocks", file: "comments.api"},
+		{name: "aligned members and tags", file: "align.api"},
+		{name: "blank lines", file: "blanklines.api"},
+		{name: "sorted imports", file: "imports.api"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename, err := filepath.Abs(filepath.Join("testdata", tt.file))
+			require.NoError(t, err)
+			data, err := ioutil.ReadFile(filename)
+			require.NoError(t, err)
+
+			result, err := apiFormat(string(data), false, filename)
+			require.NoError(t, err)
+			result += "\n"
+
+			golden := strings.TrimSuffix(filename, ".api") + ".golden"
+			if *update {
+				require.NoError(t, ioutil.WriteFile(golden, []byte(result), 0o644))
+			}
+			expected, err := ioutil.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), result)
+
+			// the formatted content is stable
+			diff, err := formatDiff(tt.file, result, filename, false)
+			assert.NoError(t, err)
+			assert.Empty(t, diff)
+		})
+	}
+}
+
+func TestFormatDiff(t *testing.T) {
+	diff, err := formatDiff("a.api", notFormattedStr, "", true)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(diff, "--- a.api.orig\n+++ a.api\n"))
+	assert.Contains(t, diff, "-  get /greet/from/:name(Request) returns (Response)\n")
+	assert.Contains(t, diff, "+\tget /greet/from/:name(Request) returns (Response)\n")
+	assert.False(t, strings.HasSuffix(diff, "\n+\n"))
+
+	for _, data := range []string{formattedStr, formattedStr + "\n"} {
+		diff, err = formatDiff("a.api", data, "", true)
+		assert.NoError(t, err)
+		assert.Empty(t, diff)
+	}
+}
+
+func TestGoFormatApiCheck(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "a.api")
+	require.NoError(t, ioutil.WriteFile(filename, []byte(notFormattedStr), 0o644))
+
+	VarStringDir = dir
+	VarBoolCheck = true
+	VarBoolSkipCheckDeclare = true
+	defer func() {
+		VarStringDir = ""
+		VarBoolCheck = false
+		VarBoolSkipCheckDeclare = false
+	}()
+
+	assert.Error(t, GoFormatApi(nil, nil))
+	data, err := ioutil.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Equal(t, notFormattedStr, string(data))
+
+	require.NoError(t, ApiFormatByPath(filename, true))
+	assert.NoError(t, GoFormatApi(nil, nil))
+}
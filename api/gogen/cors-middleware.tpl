@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	allOrigins         = "*"
+	defaultCorsHeaders = "Content-Type, Authorization"
+)
+
+var defaultCorsMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete,
+}
+
+type CorsMiddleware struct {
+	origins map[string]bool
+	methods string
+}
+
+// NewCorsMiddleware allows the cross origin requests from the origins, "*" allows all the
+// origins without the credentials.
+func NewCorsMiddleware(origins, methods []string) *CorsMiddleware {
+	if len(methods) == 0 {
+		methods = defaultCorsMethods
+	}
+
+	m := &CorsMiddleware{
+		origins: make(map[string]bool),
+		methods: strings.Join(methods, ", "),
+	}
+	for _, origin := range origins {
+		m.origins[origin] = true
+	}
+	return m
+}
+
+func (m *CorsMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.allowOrigin(w, r)
+		next(w, r)
+	}
+}
+
+// Preflight answers the preflight requests, it's registered as the OPTIONS routes of the paths,
+// because the router rejects the methods without routes before the middlewares run.
+func (m *CorsMiddleware) Preflight(w http.ResponseWriter, r *http.Request) {
+	if m.allowOrigin(w, r) {
+		headers := r.Header.Get("Access-Control-Request-Headers")
+		if len(headers) == 0 {
+			headers = defaultCorsHeaders
+		}
+
+		header := w.Header()
+		header.Set("Access-Control-Allow-Methods", m.methods)
+		header.Set("Access-Control-Allow-Headers", headers)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allowOrigin sets the allowed origin of the request, the credentials are only allowed for the
+// origins in the list, it returns false if the origin is not allowed.
+func (m *CorsMiddleware) allowOrigin(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	header := w.Header()
+	header.Add("Vary", "Origin")
+	switch {
+	case len(origin) == 0:
+		return false
+	case m.origins[origin]:
+		header.Set("Access-Control-Allow-Origin", origin)
+		header.Set("Access-Control-Allow-Credentials", "true")
+		return true
+	case m.origins[allOrigins]:
+		header.Set("Access-Control-Allow-Origin", allOrigins)
+		return true
+	default:
+		return false
+	}
+}
@@ -41,6 +41,8 @@ var (
 	VarStringBranch string
 	// VarStringStyle describes the style of output files.
 	VarStringStyle string
+	// VarBoolTests describes whether to generate the tests of the handlers.
+	VarBoolTests bool
 )
 
 // GoCommand gen go project files from command line
@@ -70,11 +72,12 @@ func GoCommand(_ *cobra.Command, _ []string) error {
 		return errors.New("missing -dir")
 	}
 
-	return DoGenProject(apiFile, dir, namingStyle, jwtMiddlewareDir)
+	return DoGenProject(apiFile, dir, namingStyle, jwtMiddlewareDir, VarBoolTests)
 }
 
-// DoGenProject gen go project files with api file
-func DoGenProject(apiFile, dir, style, jwtMiddlewareDir string) error {
+// DoGenProject gen go project files with api file, the handlers are generated with the
+// contract tests if withTests is true.
+func DoGenProject(apiFile, dir, style, jwtMiddlewareDir string, withTests bool) error {
 	api, err := parser.Parse(apiFile)
 	if err != nil {
 		return err
@@ -100,10 +103,14 @@ func DoGenProject(apiFile, dir, style, jwtMiddlewareDir string) error {
 	logx.Must(genMain(dir, rootPkg, cfg, api))
 	logx.Must(genServiceContext(dir, rootPkg, cfg, api))
 	logx.Must(genTypes(dir, cfg, api))
+	logx.Must(genAuth(dir, rootPkg, cfg, api))
 	logx.Must(genRoutes(dir, rootPkg, jwtMiddlewareDir, cfg, api))
-	logx.Must(genHandlers(dir, rootPkg, cfg, api))
+	logx.Must(genHandlers(dir, rootPkg, cfg, api, withTests))
+	if withTests {
+		logx.Must(genHandlerTests(dir, rootPkg, cfg, api))
+	}
 	logx.Must(genLogic(dir, rootPkg, cfg, api))
-	logx.Must(genMiddleware(dir, cfg, api))
+	logx.Must(genMiddleware(dir, rootPkg, jwtMiddlewareDir, cfg, api))
 
 	if err := backupAndSweep(apiFile); err != nil {
 		return err
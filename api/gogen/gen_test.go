@@ -32,6 +32,10 @@ var (
 	apiJwt string
 	//go:embed testdata/api_jwt_with_middleware.api
 	apiJwtWithMiddleware string
+	//go:embed testdata/api_route_options.api
+	apiRouteOptions string
+	//go:embed testdata/api_jwt_claims.api
+	apiJwtClaims string
 	//go:embed testdata/api_has_no_request.api
 	apiHasNoRequest string
 	//go:embed testdata/api_route_test.api
@@ -48,6 +52,8 @@ var (
 	noStructTagApi string
 	//go:embed testdata/nest_type_api.api
 	nestTypeApi string
+	//go:embed testdata/cors_preflight_test.go.txt
+	corsPreflightTest string
 )
 
 func TestParser(t *testing.T) {
@@ -159,6 +165,55 @@ func TestApiHasJwtAndMiddleware(t *testing.T) {
 	validate(t, filename)
 }
 
+func TestApiRouteOptions(t *testing.T) {
+	filename := "greet.api"
+	err := ioutil.WriteFile(filename, []byte(apiRouteOptions), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"Trace", "Log", "Audit"}, getMiddleware(api))
+
+	validate(t, filename)
+}
+
+func TestApiJwtClaims(t *testing.T) {
+	filename := "claims.api"
+	err := ioutil.WriteFile(filename, []byte(apiJwtClaims), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	claims, err := getClaims(api)
+	assert.Nil(t, err)
+	assert.Len(t, claims, 1)
+	assert.Equal(t, []string{"userId", "role"}, claimNames(claims[0].Members))
+	assert.Equal(t, []string{"Auth"}, getAuths(api))
+
+	validate(t, filename)
+}
+
+func TestApiJwtClaimsInvalid(t *testing.T) {
+	filename := "claims.api"
+	defer os.Remove(filename)
+	for _, content := range []string{
+		strings.Replace(apiJwtClaims, "claims: UserClaims", "claims: Unknown", 1),
+		strings.Replace(apiJwtClaims, "jwt: Auth\n", "", 1),
+	} {
+		err := ioutil.WriteFile(filename, []byte(content), os.ModePerm)
+		assert.Nil(t, err)
+
+		api, err := parser.Parse(filename)
+		assert.Nil(t, err)
+
+		_, err = getClaims(api)
+		assert.Error(t, err)
+	}
+}
+
 func TestApiHasNoRequestBody(t *testing.T) {
 	filename := "greet.api"
 	err := ioutil.WriteFile(filename, []byte(apiHasNoRequest), os.ModePerm)
@@ -264,6 +319,75 @@ func TestCamelStyle(t *testing.T) {
 	validateWithCamel(t, filename, "GoZero")
 }
 
+func TestGenWithTests(t *testing.T) {
+	filename := "greet.api"
+	err := ioutil.WriteFile(filename, []byte(apiJwt), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	dir := "workspace"
+	defer os.RemoveAll(dir)
+	assert.Nil(t, pathx.MkdirIfNotExist(dir))
+	assert.Nil(t, initMod(dir))
+	assert.Nil(t, DoGenProject(filename, dir, "gozero", "", true))
+
+	var tests int
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		code, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Nil(t, validateCode(string(code)))
+		if strings.HasSuffix(path, "_test.go") {
+			tests++
+			assert.Contains(t, string(code), "pathvar.WithVars")
+		}
+		if strings.Contains(path, "internal/handler/") && !strings.HasSuffix(path, "_test.go") &&
+			!strings.HasSuffix(path, "routes.go") {
+			assert.Contains(t, string(code), "var newGreetLogic = ")
+		}
+		return nil
+	})
+	assert.Equal(t, 1, tests)
+}
+
+func TestGenCorsPreflight(t *testing.T) {
+	if testing.Short() {
+		t.Skip("the generated project is built and tested")
+	}
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "cors.api")
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(apiRouteOptions), os.ModePerm))
+	project := filepath.Join(dir, "cors")
+	assert.Nil(t, pathx.MkdirIfNotExist(project))
+	_, err := execx.Run("go mod init cors", project)
+	assert.Nil(t, err)
+	assert.Nil(t, DoGenProject(filename, project, "gozero", "", false))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(project, "internal", "handler", "preflight_test.go"),
+		[]byte(corsPreflightTest), os.ModePerm))
+
+	// the project is built with the go-zero and the go.sum of goctl
+	version, err := execx.Run("go list -m -f {{.Version}} github.com/zeromicro/go-zero", "")
+	assert.Nil(t, err)
+	_, err = execx.Run("go mod edit -require=github.com/zeromicro/go-zero@"+version, project)
+	assert.Nil(t, err)
+	sum, err := ioutil.ReadFile(filepath.Join("..", "..", "go.sum"))
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(project, "go.sum"), sum, os.ModePerm))
+	// the test dependencies of the dependencies are not required
+	_, err = execx.Run("go mod tidy -e", project)
+	assert.Nil(t, err)
+	if _, err = execx.Run("go list -deps -test ./internal/handler/", project); err != nil {
+		t.Skipf("the dependencies of the project are not available: %v", err)
+	}
+
+	_, err = execx.Run("go test ./internal/handler/", project)
+	assert.Nil(t, err)
+}
+
 func validate(t *testing.T, api string) {
 	validateWithCamel(t, api, "gozero")
 }
@@ -277,7 +401,7 @@ func validateWithCamel(t *testing.T, api, camel string) {
 	assert.Nil(t, err)
 	err = initMod(dir)
 	assert.Nil(t, err)
-	err = DoGenProject(api, dir, camel, "")
+	err = DoGenProject(api, dir, camel, "", false)
 	assert.Nil(t, err)
 	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if strings.HasSuffix(path, ".go") {
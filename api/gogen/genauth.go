@@ -0,0 +1,128 @@
+package gogen
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+const (
+	authFilename = "auth"
+	claimsKey    = "claims"
+)
+
+//go:embed auth.tpl
+var authTemplate string
+
+func genAuth(dir, rootPkg string, cfg *config.Config, api *spec.ApiSpec) error {
+	claimsList, err := getClaims(api)
+	if err != nil {
+		return err
+	}
+	if len(claimsList) == 0 {
+		return nil
+	}
+
+	var claims []map[string]string
+	for _, item := range claimsList {
+		var names []string
+		for _, name := range claimNames(item.Members) {
+			names = append(names, strconv.Quote(name))
+		}
+
+		claims = append(claims, map[string]string{
+			"name":  item.RawName,
+			"names": strings.Join(names, ", "),
+		})
+	}
+
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, authFilename)
+	if err != nil {
+		return err
+	}
+
+	filename = filename + ".go"
+	os.Remove(path.Join(dir, authDir, filename))
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          authDir,
+		filename:        filename,
+		templateName:    "authTemplate",
+		category:        category,
+		templateFile:    authTemplateFile,
+		builtinTemplate: authTemplate,
+		data: map[string]interface{}{
+			"typesImport": fmt.Sprintf(`"%s"`, pathx.JoinPackages(rootPkg, typesDir)),
+			"claims":      claims,
+		},
+	})
+}
+
+// getClaims returns the declared claims types, the claims must be declared along with jwt.
+func getClaims(api *spec.ApiSpec) ([]spec.DefineStruct, error) {
+	types := make(map[string]spec.DefineStruct)
+	for _, tp := range api.Types {
+		if ds, ok := tp.(spec.DefineStruct); ok {
+			types[ds.RawName] = ds
+		}
+	}
+
+	var result []spec.DefineStruct
+	seen := make(map[string]bool)
+	for _, g := range api.Service.Groups {
+		claims := g.GetAnnotation(claimsKey)
+		if len(claims) == 0 {
+			continue
+		}
+		if len(g.GetAnnotation("jwt")) == 0 {
+			return nil, fmt.Errorf("claims %s is declared without jwt", claims)
+		}
+
+		tp, ok := types[claims]
+		if !ok {
+			return nil, fmt.Errorf("claims %s is not a declared struct type", claims)
+		}
+		if seen[claims] {
+			continue
+		}
+
+		seen[claims] = true
+		result = append(result, tp)
+	}
+
+	return result, nil
+}
+
+// claimNames returns the json names of the members, they are the keys of the claims
+// put into the context by rest.WithJwt.
+func claimNames(members []spec.Member) []string {
+	var names []string
+	for _, member := range members {
+		if member.IsInline {
+			if ds, ok := member.Type.(spec.DefineStruct); ok {
+				names = append(names, claimNames(ds.Members)...)
+			}
+			continue
+		}
+
+		name := member.Name
+		if tags, err := spec.Parse(member.Tag); err == nil {
+			if tag, err := tags.Get("json"); err == nil && len(tag.Name) > 0 {
+				name = tag.Name
+			}
+		}
+		if name == "-" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
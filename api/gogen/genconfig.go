@@ -23,6 +23,11 @@ const (
 		Secret     string
 		PrevSecret string
 	}
+`
+	corsTemplate = ` struct {
+		Origins []string
+		Methods []string ` + "`json:\",optional\"`" + `
+	}
 `
 )
 
@@ -46,6 +51,10 @@ func genConfig(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 	for _, item := range jwtTransNames {
 		jwtTransList = append(jwtTransList, fmt.Sprintf("%s %s", item, jwtTransTemplate))
 	}
+	var corsList []string
+	for _, item := range getCors(api) {
+		corsList = append(corsList, fmt.Sprintf("%s %s", item, corsTemplate))
+	}
 	authImportStr := fmt.Sprintf("\"%s/rest\"", vars.ProjectOpenSourceURL)
 
 	return genFile(fileGenConfig{
@@ -60,6 +69,7 @@ func genConfig(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 			"authImport": authImportStr,
 			"auth":       strings.Join(auths, "\n"),
 			"jwtTrans":   strings.Join(jwtTransList, "\n"),
+			"cors":       strings.Join(corsList, "\n"),
 		},
 	})
 }
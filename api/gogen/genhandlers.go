@@ -30,9 +30,16 @@ type handlerInfo struct {
 	Call               string
 	HasResp            bool
 	HasRequest         bool
+	// WithTests adds the seam of the logic for the generated tests
+	WithTests        bool
+	ResponseType     string
+	LogicInterface   string
+	LogicConstructor string
 }
 
-func genHandler(dir, rootPkg string, cfg *config.Config, group spec.Group, route spec.Route) error {
+func genHandler(dir, rootPkg string, cfg *config.Config, group spec.Group, route spec.Route,
+	withTests bool,
+) error {
 	handler := getHandlerName(route)
 	handlerPath := getHandlerFolderPath(group, route)
 	pkgName := handlerPath[strings.LastIndex(handlerPath, "/")+1:]
@@ -47,15 +54,19 @@ func genHandler(dir, rootPkg string, cfg *config.Config, group spec.Group, route
 	}
 
 	return doGenToFile(dir, handler, cfg, group, route, handlerInfo{
-		PkgName:        pkgName,
-		ImportPackages: genHandlerImports(group, route, parentPkg),
-		HandlerName:    handler,
-		RequestType:    util.Title(route.RequestTypeName()),
-		LogicName:      logicName,
-		LogicType:      strings.Title(getLogicName(route)),
-		Call:           strings.Title(strings.TrimSuffix(handler, "Handler")),
-		HasResp:        len(route.ResponseTypeName()) > 0,
-		HasRequest:     len(route.RequestTypeName()) > 0,
+		PkgName:          pkgName,
+		ImportPackages:   genHandlerImports(group, route, parentPkg, withTests),
+		HandlerName:      handler,
+		RequestType:      util.Title(route.RequestTypeName()),
+		LogicName:        logicName,
+		LogicType:        strings.Title(getLogicName(route)),
+		Call:             strings.Title(strings.TrimSuffix(handler, "Handler")),
+		HasResp:          len(route.ResponseTypeName()) > 0,
+		HasRequest:       len(route.RequestTypeName()) > 0,
+		WithTests:        withTests,
+		ResponseType:     responseGoTypeName(route, typesPacket),
+		LogicInterface:   util.Untitle(getLogicName(route)),
+		LogicConstructor: "new" + strings.Title(getLogicName(route)),
 	})
 }
 
@@ -79,10 +90,10 @@ func doGenToFile(dir, handler string, cfg *config.Config, group spec.Group,
 	})
 }
 
-func genHandlers(dir, rootPkg string, cfg *config.Config, api *spec.ApiSpec) error {
+func genHandlers(dir, rootPkg string, cfg *config.Config, api *spec.ApiSpec, withTests bool) error {
 	for _, group := range api.Service.Groups {
 		for _, route := range group.Routes {
-			if err := genHandler(dir, rootPkg, cfg, group, route); err != nil {
+			if err := genHandler(dir, rootPkg, cfg, group, route, withTests); err != nil {
 				return err
 			}
 		}
@@ -91,12 +102,14 @@ func genHandlers(dir, rootPkg string, cfg *config.Config, api *spec.ApiSpec) err
 	return nil
 }
 
-func genHandlerImports(group spec.Group, route spec.Route, parentPkg string) string {
+func genHandlerImports(group spec.Group, route spec.Route, parentPkg string, withTests bool) string {
 	imports := []string{
 		fmt.Sprintf("\"%s\"", pathx.JoinPackages(parentPkg, getLogicFolderPath(group, route))),
 		fmt.Sprintf("\"%s\"", pathx.JoinPackages(parentPkg, contextDir)),
 	}
-	if len(route.RequestTypeName()) > 0 {
+	// the seam of the logic refers to the response type
+	if len(route.RequestTypeName()) > 0 ||
+		withTests && strings.Contains(responseGoTypeName(route, typesPacket), typesPacket+".") {
 		imports = append(imports, fmt.Sprintf("\"%s\"\n", pathx.JoinPackages(parentPkg, typesDir)))
 	}
 
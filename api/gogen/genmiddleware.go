@@ -7,12 +7,21 @@ import (
 	"github.com/yeyudekuangxiang/goctl/api/spec"
 	"github.com/yeyudekuangxiang/goctl/config"
 	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
 )
 
-//go:embed middleware.tpl
-var middlewareImplementCode string
+var (
+	//go:embed middleware.tpl
+	middlewareImplementCode string
+	//go:embed cors-middleware.tpl
+	corsMiddlewareCode string
+	//go:embed ratelimit-middleware.tpl
+	rateLimitMiddlewareCode string
+	//go:embed jwt-middleware.tpl
+	jwtMiddlewareCode string
+)
 
-func genMiddleware(dir string, cfg *config.Config, api *spec.ApiSpec) error {
+func genMiddleware(dir, rootPkg, jwtMiddlewareDir string, cfg *config.Config, api *spec.ApiSpec) error {
 	middlewares := getMiddleware(api)
 	for _, item := range middlewares {
 		middlewareFilename := strings.TrimSuffix(strings.ToLower(item), "middleware") + "_middleware"
@@ -39,5 +48,109 @@ func genMiddleware(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 		}
 	}
 
+	if len(jwtMiddlewareDir) == 0 {
+		if err := genJwtMiddleware(dir, rootPkg, cfg, api); err != nil {
+			return err
+		}
+	}
+
+	return genBuiltinMiddleware(dir, cfg, api)
+}
+
+// genJwtMiddleware generates the middlewares of the jwt annotations like Jwt:Auth, the middleware
+// verifies the token and puts the claims into the context if the claims are declared.
+func genJwtMiddleware(dir, rootPkg string, cfg *config.Config, api *spec.ApiSpec) error {
+	claims := make(map[string]string)
+	var names []string
+	for _, g := range api.Service.Groups {
+		jwt := g.GetAnnotation("jwt")
+		i := strings.Index(jwt, ":")
+		if i == -1 {
+			continue
+		}
+
+		name := jwt[:i]
+		if _, ok := claims[name]; !ok {
+			names = append(names, name)
+		}
+		if len(claims[name]) == 0 {
+			claims[name] = g.GetAnnotation(claimsKey)
+		}
+	}
+
+	for _, name := range names {
+		filename, err := format.FileNamingFormat(cfg.NamingFormat, strings.ToLower(name)+"_middleware")
+		if err != nil {
+			return err
+		}
+
+		err = genFile(fileGenConfig{
+			dir:             dir,
+			subdir:          middlewareDir,
+			filename:        filename + ".go",
+			templateName:    "jwtMiddlewareTemplate",
+			category:        category,
+			templateFile:    jwtMiddlewareFile,
+			builtinTemplate: jwtMiddlewareCode,
+			data: map[string]string{
+				"name":       strings.Title(name),
+				"claims":     claims[name],
+				"authImport": pathx.JoinPackages(rootPkg, authDir),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// genBuiltinMiddleware generates the middlewares of the cors and rateLimit annotations
+func genBuiltinMiddleware(dir string, cfg *config.Config, api *spec.ApiSpec) error {
+	builtins := []struct {
+		key          string
+		filename     string
+		templateFile string
+		template     string
+	}{
+		{corsKey, "cors_middleware", corsMiddlewareFile, corsMiddlewareCode},
+		{rateLimitKey, "rate_limit_middleware", rateLimitMiddlewareFile, rateLimitMiddlewareCode},
+	}
+
+	for _, item := range builtins {
+		if !hasAnnotation(api, item.key) {
+			continue
+		}
+
+		filename, err := format.FileNamingFormat(cfg.NamingFormat, item.filename)
+		if err != nil {
+			return err
+		}
+
+		err = genFile(fileGenConfig{
+			dir:             dir,
+			subdir:          middlewareDir,
+			filename:        filename + ".go",
+			templateName:    item.key + "Template",
+			category:        category,
+			templateFile:    item.templateFile,
+			builtinTemplate: item.template,
+			data:            map[string]string{},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func hasAnnotation(api *spec.ApiSpec, key string) bool {
+	for _, g := range api.Service.Groups {
+		if len(g.GetAnnotation(key)) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -1,10 +1,13 @@
 package gogen
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -19,8 +22,14 @@ import (
 
 const (
 	jwtTransKey    = "jwtTransition"
+	maxBytesKey    = "maxBytes"
+	corsKey        = "cors"
+	rateLimitKey   = "rateLimit"
+	middlewareKey  = "middleware"
 	routesFilename = "routes"
-	routesTemplate = `// Code generated by goctl. DO NOT EDIT.
+	// rateLimitJwtPrefix is the prefix of the rateLimit keys by the jwt claims
+	rateLimitJwtPrefix = "jwt:"
+	routesTemplate     = `// Code generated by goctl. DO NOT EDIT.
 package handler
 
 import (
@@ -36,12 +45,17 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 `
 	routesAdditionTemplate = `
 	server.AddRoutes(
-		{{.routes}} {{.jwt}}{{.signature}} {{.prefix}} {{.timeout}}
+		{{.routes}} {{.options}}
 	)
 `
 	timeoutThreshold = time.Millisecond
 )
 
+var (
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	pathVarRegex    = regexp.MustCompile(`:[^/]+`)
+)
+
 var mapping = map[string]string{
 	"delete":  "http.MethodDelete",
 	"get":     "http.MethodGet",
@@ -61,14 +75,34 @@ type (
 		signatureEnabled bool
 		authName         string
 		timeout          string
+		maxBytes         string
+		cors             string
+		rateLimit        string
 		middlewares      []string
 		prefix           string
 		jwtTrans         string
 	}
 	route struct {
-		method  string
-		path    string
-		handler string
+		method      string
+		path        string
+		handler     string
+		middlewares []string
+	}
+
+	// routeAddition is the structured model of a server.AddRoutes call
+	routeAddition struct {
+		// middlewares wrap all the routes of the group, the first one is the outermost.
+		middlewares []string
+		routes      []route
+		// jwtMiddleware is set if the jwt is verified by a custom middleware instead of rest.WithJwt
+		jwtMiddleware bool
+		jwt           string
+		jwtTrans      string
+		signature     string
+		prefix        string
+		timeout       string
+		maxBytes      string
+		hasTimeout    bool
 	}
 )
 
@@ -84,100 +118,46 @@ func genRoutes(dir, rootPkg, jwtMiddlePath string, cfg *config.Config, api *spec
 		return err
 	}
 
-	var hasTimeout bool
-	gt := template.Must(template.New("groupTemplate").Parse(templateText))
-	hasJwtMiddleware := false
+	var additions []routeAddition
+	var hasMiddleware bool
 	for _, g := range groups {
-		var gbuilder strings.Builder
-		gbuilder.WriteString("[]rest.Route{")
-		for _, r := range g.routes {
-			fmt.Fprintf(&gbuilder, `
-		{
-			Method:  %s,
-			Path:    "%s",
-			Handler: %s,
-		},`,
-				r.method, r.path, r.handler)
-		}
-
-		var jwt, jwtMiddleware string
-
-		if g.jwtEnabled {
-			if i := strings.Index(g.authName, ":"); i != -1 {
-				jwtMiddleware = fmt.Sprintf("middleware.%sMiddleware(serverCtx.Config.%s.AccessSecret)", g.authName[:i], g.authName[i+1:])
-			} else {
-				jwt = fmt.Sprintf("\n rest.WithJwt(serverCtx.Config.%s.AccessSecret),", g.authName)
-			}
-		}
-		if len(g.jwtTrans) > 0 {
-			jwt = jwt + fmt.Sprintf("\n rest.WithJwtTransition(serverCtx.Config.%s.PrevSecret,serverCtx.Config.%s.Secret),", g.jwtTrans, g.jwtTrans)
-		}
-		var signature, prefix string
-		if g.signatureEnabled {
-			signature = "\n rest.WithSignature(serverCtx.Config.Signature),"
-		}
-		if len(g.prefix) > 0 {
-			prefix = fmt.Sprintf(`
-rest.WithPrefix("%s"),`, g.prefix)
-		}
-
-		var timeout string
-		if len(g.timeout) > 0 {
-			duration, err := time.ParseDuration(g.timeout)
-			if err != nil {
-				return err
-			}
-
-			// why we check this, maybe some users set value 1, it's 1ns, not 1s.
-			if duration < timeoutThreshold {
-				return fmt.Errorf("timeout should not less than 1ms, now %v", duration)
-			}
-
-			timeout = fmt.Sprintf("rest.WithTimeout(%d * time.Millisecond),", duration/time.Millisecond)
-			hasTimeout = true
+		addition, err := g.addition()
+		if err != nil {
+			return err
 		}
 
-		var routes string
-		if len(g.middlewares) > 0 {
-			gbuilder.WriteString("\n}...,")
-			params := g.middlewares
-			for i := range params {
-				params[i] = "serverCtx." + params[i]
-			}
-			middlewareStr := strings.Join(params, ", ")
-			if jwtMiddleware != "" {
-				routes = fmt.Sprintf("rest.WithMiddlewares(\n[]rest.Middleware{%s, %s }, \n %s \n),",
-					jwtMiddleware, middlewareStr, strings.TrimSpace(gbuilder.String()))
-			} else {
-				routes = fmt.Sprintf("rest.WithMiddlewares(\n[]rest.Middleware{ %s }, \n %s \n),",
-					middlewareStr, strings.TrimSpace(gbuilder.String()))
-			}
-		} else {
-			if jwtMiddleware != "" {
-				gbuilder.WriteString("\n}...,")
-				routes = fmt.Sprintf("rest.WithMiddlewares(\n[]rest.Middleware{%s}, \n %s \n),",
-					jwtMiddleware, strings.TrimSpace(gbuilder.String()))
-			} else {
-				gbuilder.WriteString("\n},")
-				routes = strings.TrimSpace(gbuilder.String())
-			}
-		}
+		additions = append(additions, addition)
+		hasMiddleware = hasMiddleware || len(g.cors) > 0 || len(g.rateLimit) > 0
+	}
+	preflights, err := preflightAdditions(groups)
+	if err != nil {
+		return err
+	}
+	additions = append(additions, preflights...)
 
-		if jwtMiddleware != "" {
-			jwt = ""
-			hasJwtMiddleware = true
-		}
+	var hasTimeout, hasJwtMiddleware bool
+	gt := template.Must(template.New("groupTemplate").Parse(templateText))
+	for _, addition := range additions {
+		hasTimeout = hasTimeout || addition.hasTimeout
+		hasJwtMiddleware = hasJwtMiddleware || addition.jwtMiddleware
 		if err := gt.Execute(&builder, map[string]string{
-			"routes":    routes,
-			"jwt":       jwt,
-			"signature": signature,
-			"prefix":    prefix,
-			"timeout":   timeout,
+			"routes":    addition.routesExpr(),
+			"options":   strings.Join(addition.options(), " "),
+			"jwt":       addition.jwt + addition.jwtTrans,
+			"signature": addition.signature,
+			"prefix":    addition.prefix,
+			"timeout":   addition.timeout,
+			"maxBytes":  addition.maxBytes,
 		}); err != nil {
 			return err
 		}
 	}
 
+	// both of the packages are imported as middleware
+	if hasJwtMiddleware && len(jwtMiddlePath) > 0 && hasMiddleware {
+		return errors.New("--jwtMiddleware can not be used along with cors or rateLimit")
+	}
+
 	routeFilename, err := format.FileNamingFormat(cfg.NamingFormat, routesFilename)
 	if err != nil {
 		return err
@@ -197,7 +177,7 @@ rest.WithPrefix("%s"),`, g.prefix)
 		builtinTemplate: routesTemplate,
 		data: map[string]interface{}{
 			"hasTimeout":        hasTimeout,
-			"importPackages":    genRouteImports(rootPkg, api),
+			"importPackages":    genRouteImports(rootPkg, jwtMiddlePath, api, hasMiddleware, hasJwtMiddleware),
 			"routesAdditions":   strings.TrimSpace(builder.String()),
 			"jwtMiddlewarePath": jwtMiddlePath,
 			"hasJwtMiddleware":  hasJwtMiddleware,
@@ -205,9 +185,277 @@ rest.WithPrefix("%s"),`, g.prefix)
 	})
 }
 
-func genRouteImports(parentPkg string, api *spec.ApiSpec) string {
+// addition builds the server.AddRoutes call of the group, the middlewares are ordered as:
+// cors, rateLimit, the jwt middleware, the middlewares of the group, and then the middlewares
+// of each route which wrap the handler directly. The rateLimit keyed by a jwt claim follows
+// the jwt middleware, which puts the claims into the context.
+func (g group) addition() (routeAddition, error) {
+	var addition routeAddition
+	if len(g.cors) > 0 {
+		cors, err := parseCors(g.cors)
+		if err != nil {
+			return addition, err
+		}
+		addition.middlewares = append(addition.middlewares, cors+".Handle")
+	}
+
+	var rateLimit string
+	var rateLimitByJwt bool
+	if len(g.rateLimit) > 0 {
+		var key string
+		var err error
+		rateLimit, key, err = parseRateLimit(g.rateLimit)
+		if err != nil {
+			return addition, err
+		}
+
+		rateLimitByJwt = strings.HasPrefix(key, rateLimitJwtPrefix)
+		if rateLimitByJwt && !g.jwtEnabled {
+			return addition, fmt.Errorf("rateLimit key %q requires jwt in the group", key)
+		}
+		if !rateLimitByJwt {
+			addition.middlewares = append(addition.middlewares, rateLimit)
+		}
+	}
+
+	if g.jwtEnabled {
+		if i := strings.Index(g.authName, ":"); i != -1 {
+			// the jwt middleware falls back to the previous secret instead of rest.WithJwtTransition,
+			// which would enable the builtin jwt verification as well
+			prevSecret := `""`
+			if len(g.jwtTrans) > 0 {
+				prevSecret = fmt.Sprintf("serverCtx.Config.%s.PrevSecret", g.jwtTrans)
+			}
+			addition.jwtMiddleware = true
+			addition.middlewares = append(addition.middlewares, fmt.Sprintf(
+				"middleware.%sMiddleware(serverCtx.Config.%s.AccessSecret, %s)", g.authName[:i],
+				g.authName[i+1:], prevSecret))
+		} else {
+			addition.jwt = fmt.Sprintf("\n rest.WithJwt(serverCtx.Config.%s.AccessSecret),", g.authName)
+		}
+	}
+	// rest.WithJwt verifies the token before all the middlewares
+	if rateLimitByJwt {
+		addition.middlewares = append(addition.middlewares, rateLimit)
+	}
+	if len(g.jwtTrans) > 0 && !addition.jwtMiddleware {
+		addition.jwtTrans = fmt.Sprintf("\n rest.WithJwtTransition(serverCtx.Config.%s.PrevSecret,serverCtx.Config.%s.Secret),",
+			g.jwtTrans, g.jwtTrans)
+	}
+	for _, item := range g.middlewares {
+		addition.middlewares = append(addition.middlewares, "serverCtx."+item)
+	}
+
+	if g.signatureEnabled {
+		addition.signature = "\n rest.WithSignature(serverCtx.Config.Signature),"
+	}
+	addition.prefix = prefixOption(g.prefix)
+
+	if len(g.timeout) > 0 {
+		duration, err := time.ParseDuration(g.timeout)
+		if err != nil {
+			return addition, err
+		}
+
+		// why we check this, maybe some users set value 1, it's 1ns, not 1s.
+		if duration < timeoutThreshold {
+			return addition, fmt.Errorf("timeout should not less than 1ms, now %v", duration)
+		}
+
+		addition.timeout = fmt.Sprintf("rest.WithTimeout(%d * time.Millisecond),", duration/time.Millisecond)
+		addition.hasTimeout = true
+	}
+
+	if len(g.maxBytes) > 0 {
+		maxBytes, err := strconv.ParseInt(g.maxBytes, 10, 64)
+		if err != nil || maxBytes <= 0 {
+			return addition, fmt.Errorf("maxBytes should be a positive integer, now %q", g.maxBytes)
+		}
+
+		addition.maxBytes = fmt.Sprintf("\n rest.WithMaxBytes(%d),", maxBytes)
+	}
+
+	addition.routes = g.routes
+	return addition, nil
+}
+
+// preflightAdditions returns the OPTIONS routes of the paths in the cors groups, the router
+// rejects the preflight requests of the paths without OPTIONS routes before the middlewares.
+// The paths which have their own OPTIONS routes are skipped.
+func preflightAdditions(groups []group) ([]routeAddition, error) {
+	// the cors of the paths, it's empty for the OPTIONS routes declared in the api
+	corsOf := make(map[string]string)
+	for _, g := range groups {
+		for _, r := range g.routes {
+			if r.method == mapping["options"] {
+				corsOf[routePattern(g.prefix, r.path)] = ""
+			}
+		}
+	}
+
+	var additions []routeAddition
+	for _, g := range groups {
+		if len(g.cors) == 0 {
+			continue
+		}
+
+		cors, err := parseCors(g.cors)
+		if err != nil {
+			return nil, err
+		}
+
+		addition := routeAddition{prefix: prefixOption(g.prefix)}
+		for _, r := range g.routes {
+			pattern := routePattern(g.prefix, r.path)
+			if each, ok := corsOf[pattern]; ok {
+				if len(each) > 0 && each != g.cors {
+					return nil, fmt.Errorf("path %s is in the groups of the different cors %s and %s",
+						path.Join(g.prefix, r.path), each, g.cors)
+				}
+				continue
+			}
+
+			corsOf[pattern] = g.cors
+			addition.routes = append(addition.routes, route{
+				method:  mapping["options"],
+				path:    r.path,
+				handler: cors + ".Preflight",
+			})
+		}
+		if len(addition.routes) > 0 {
+			additions = append(additions, addition)
+		}
+	}
+
+	return additions, nil
+}
+
+// routePattern returns the path with the prefix, the path variables are the same in the router
+func routePattern(prefix, route string) string {
+	return pathVarRegex.ReplaceAllString(path.Join("/", prefix, route), ":")
+}
+
+func prefixOption(prefix string) string {
+	if len(prefix) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+rest.WithPrefix("%s"),`, prefix)
+}
+
+// options returns the route options in the order of the generated code
+func (a routeAddition) options() []string {
+	var options []string
+	for _, each := range []string{a.jwt, a.jwtTrans, a.signature, a.prefix, a.timeout, a.maxBytes} {
+		if len(each) > 0 {
+			options = append(options, each)
+		}
+	}
+	return options
+}
+
+// routesExpr returns the first argument of server.AddRoutes
+func (a routeAddition) routesExpr() string {
+	var builder strings.Builder
+	builder.WriteString("[]rest.Route{")
+	for _, r := range a.routes {
+		handler := r.handler
+		for i := len(r.middlewares) - 1; i >= 0; i-- {
+			handler = fmt.Sprintf("serverCtx.%s(%s)", r.middlewares[i], handler)
+		}
+
+		fmt.Fprintf(&builder, `
+		{
+			Method:  %s,
+			Path:    "%s",
+			Handler: %s,
+		},`,
+			r.method, r.path, handler)
+	}
+
+	if len(a.middlewares) == 0 {
+		builder.WriteString("\n},")
+		return strings.TrimSpace(builder.String())
+	}
+
+	builder.WriteString("\n}...,")
+	return fmt.Sprintf("rest.WithMiddlewares(\n[]rest.Middleware{%s}, \n %s \n),",
+		strings.Join(a.middlewares, ", "), strings.TrimSpace(builder.String()))
+}
+
+// parseCors parses the cors annotation, it's the name of the config which declares
+// the allowed origins and methods, like the jwt annotation. The cors middleware is returned.
+func parseCors(value string) (string, error) {
+	if !identifierRegex.MatchString(value) {
+		return "", fmt.Errorf("cors should be the name of the config, now %q", value)
+	}
+
+	return fmt.Sprintf("middleware.NewCorsMiddleware(serverCtx.Config.%s.Origins, serverCtx.Config.%s.Methods)",
+		value, value), nil
+}
+
+// parseRateLimit parses the rateLimit annotation like "qps=100 burst=200 key=jwt:userId" and
+// returns the middleware expression and the key, the burst defaults to qps, the key is ip,
+// forwarded or jwt:<claim> and defaults to ip.
+func parseRateLimit(value string) (string, string, error) {
+	var qps, burst int
+	key := "ip"
+	for _, field := range strings.Fields(value) {
+		i := strings.Index(field, "=")
+		if i <= 0 {
+			return "", "", fmt.Errorf("invalid rateLimit option %q, expected key=value", field)
+		}
+
+		var err error
+		switch k, v := field[:i], field[i+1:]; k {
+		case "qps":
+			qps, err = strconv.Atoi(v)
+		case "burst":
+			burst, err = strconv.Atoi(v)
+		case "key":
+			if v != "ip" && v != "forwarded" &&
+				(!strings.HasPrefix(v, rateLimitJwtPrefix) || len(v) == len(rateLimitJwtPrefix)) {
+				return "", "", fmt.Errorf("invalid rateLimit key %q, expected ip, forwarded or jwt:<claim>", v)
+			}
+			key = v
+		default:
+			return "", "", fmt.Errorf("unknown rateLimit option %q, expected qps, burst or key", k)
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("invalid rateLimit option %q: %v", field, err)
+		}
+	}
+	if qps <= 0 {
+		return "", "", fmt.Errorf("rateLimit requires a positive qps, now %q", value)
+	}
+	if burst <= 0 {
+		burst = qps
+	}
+
+	return fmt.Sprintf("middleware.NewRateLimitMiddleware(%d, %d, %q).Handle", qps, burst, key), key, nil
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func genRouteImports(parentPkg, jwtMiddlewarePath string, api *spec.ApiSpec, hasMiddleware,
+	hasJwtMiddleware bool) string {
 	importSet := collection.NewSet()
 	importSet.AddStr(fmt.Sprintf("\"%s\"", pathx.JoinPackages(parentPkg, contextDir)))
+	switch {
+	case hasJwtMiddleware && len(jwtMiddlewarePath) > 0:
+		importSet.AddStr(fmt.Sprintf("middleware \"%s\"", jwtMiddlewarePath))
+	case hasMiddleware || hasJwtMiddleware:
+		importSet.AddStr(fmt.Sprintf("\"%s\"", pathx.JoinPackages(parentPkg, middlewareDir)))
+	}
 	for _, group := range api.Service.Groups {
 		for _, route := range group.Routes {
 			folder := route.GetAnnotation(groupProperty)
@@ -246,13 +494,17 @@ func getRoutes(api *spec.ApiSpec) ([]group, error) {
 				}
 			}
 			groupedRoutes.routes = append(groupedRoutes.routes, route{
-				method:  mapping[r.Method],
-				path:    r.Path,
-				handler: handler,
+				method:      mapping[r.Method],
+				path:        r.Path,
+				handler:     handler,
+				middlewares: splitList(r.GetAnnotation(middlewareKey)),
 			})
 		}
 
 		groupedRoutes.timeout = g.GetAnnotation("timeout")
+		groupedRoutes.maxBytes = g.GetAnnotation(maxBytesKey)
+		groupedRoutes.cors = g.GetAnnotation(corsKey)
+		groupedRoutes.rateLimit = g.GetAnnotation(rateLimitKey)
 
 		jwt := g.GetAnnotation("jwt")
 		if len(jwt) > 0 {
@@ -268,11 +520,7 @@ func getRoutes(api *spec.ApiSpec) ([]group, error) {
 		if signature == "true" {
 			groupedRoutes.signatureEnabled = true
 		}
-		middleware := g.GetAnnotation("middleware")
-		if len(middleware) > 0 {
-			groupedRoutes.middlewares = append(groupedRoutes.middlewares,
-				strings.Split(middleware, ",")...)
-		}
+		groupedRoutes.middlewares = splitList(g.GetAnnotation(middlewareKey))
 		prefix := g.GetAnnotation(spec.RoutePrefixKey)
 		prefix = strings.ReplaceAll(prefix, `"`, "")
 		prefix = strings.TrimSpace(prefix)
@@ -0,0 +1,162 @@
+package gogen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteAddition(t *testing.T) {
+	routes := []route{
+		{method: "http.MethodGet", path: "/a", handler: "AHandler(serverCtx)"},
+		{method: "http.MethodPost", path: "/b", handler: "BHandler(serverCtx)", middlewares: []string{"Audit", "Log"}},
+	}
+
+	tests := []struct {
+		name        string
+		group       group
+		middlewares []string
+		options     []string
+		err         bool
+	}{
+		{
+			name: "plain",
+		},
+		{
+			name:  "jwt",
+			group: group{jwtEnabled: true, authName: "Auth", jwtTrans: "Trans"},
+			options: []string{
+				"\n rest.WithJwt(serverCtx.Config.Auth.AccessSecret),",
+				"\n rest.WithJwtTransition(serverCtx.Config.Trans.PrevSecret,serverCtx.Config.Trans.Secret),",
+			},
+		},
+		{
+			name:        "jwt middleware",
+			group:       group{jwtEnabled: true, authName: "Jwt:Auth", middlewares: []string{"Log"}},
+			middlewares: []string{`middleware.JwtMiddleware(serverCtx.Config.Auth.AccessSecret, "")`, "serverCtx.Log"},
+		},
+		{
+			name:        "jwt middleware with transition",
+			group:       group{jwtEnabled: true, authName: "Jwt:Auth", jwtTrans: "Trans"},
+			middlewares: []string{"middleware.JwtMiddleware(serverCtx.Config.Auth.AccessSecret, serverCtx.Config.Trans.PrevSecret)"},
+		},
+		{
+			name:  "signature prefix timeout maxBytes",
+			group: group{signatureEnabled: true, prefix: "/v1", timeout: "3s", maxBytes: "1024"},
+			options: []string{
+				"\n rest.WithSignature(serverCtx.Config.Signature),",
+				"\nrest.WithPrefix(\"/v1\"),",
+				"rest.WithTimeout(3000 * time.Millisecond),",
+				"\n rest.WithMaxBytes(1024),",
+			},
+		},
+		{
+			name: "middleware order",
+			group: group{
+				jwtEnabled:  true,
+				authName:    "Jwt:Auth",
+				cors:        "Cors",
+				rateLimit:   "qps=10",
+				middlewares: []string{"Trace", "Log"},
+			},
+			middlewares: []string{
+				"middleware.NewCorsMiddleware(serverCtx.Config.Cors.Origins, serverCtx.Config.Cors.Methods).Handle",
+				`middleware.NewRateLimitMiddleware(10, 10, "ip").Handle`,
+				`middleware.JwtMiddleware(serverCtx.Config.Auth.AccessSecret, "")`,
+				"serverCtx.Trace",
+				"serverCtx.Log",
+			},
+		},
+		{
+			name: "rateLimit by jwt claim",
+			group: group{
+				jwtEnabled:  true,
+				authName:    "Jwt:Auth",
+				cors:        "Cors",
+				rateLimit:   "qps=100 burst=200 key=jwt:userId",
+				middlewares: []string{"Log"},
+			},
+			middlewares: []string{
+				"middleware.NewCorsMiddleware(serverCtx.Config.Cors.Origins, serverCtx.Config.Cors.Methods).Handle",
+				`middleware.JwtMiddleware(serverCtx.Config.Auth.AccessSecret, "")`,
+				`middleware.NewRateLimitMiddleware(100, 200, "jwt:userId").Handle`,
+				"serverCtx.Log",
+			},
+		},
+		{
+			name:        "rateLimit by forwarded address",
+			group:       group{rateLimit: "qps=100 key=forwarded"},
+			middlewares: []string{`middleware.NewRateLimitMiddleware(100, 100, "forwarded").Handle`},
+		},
+		{name: "invalid timeout", group: group{timeout: "1"}, err: true},
+		{name: "invalid maxBytes", group: group{maxBytes: "1k"}, err: true},
+		{name: "invalid cors", group: group{cors: "origins=*"}, err: true},
+		{name: "rateLimit without qps", group: group{rateLimit: "burst=10"}, err: true},
+		{name: "invalid rateLimit key", group: group{rateLimit: "qps=10 key=header"}, err: true},
+		{name: "rateLimit by jwt claim without jwt", group: group{rateLimit: "qps=10 key=jwt:userId"}, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.group.routes = routes
+			addition, err := test.group.addition()
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.middlewares, addition.middlewares)
+			assert.Equal(t, test.options, addition.options())
+			assert.Equal(t, routes, addition.routes)
+		})
+	}
+}
+
+func TestRouteAdditionRoutesExpr(t *testing.T) {
+	addition := routeAddition{
+		middlewares: []string{"serverCtx.Log"},
+		routes: []route{
+			{method: "http.MethodPost", path: "/b", handler: "BHandler(serverCtx)", middlewares: []string{"Audit", "Trace"}},
+		},
+	}
+
+	expr := addition.routesExpr()
+	assert.Contains(t, expr, "rest.WithMiddlewares(\n[]rest.Middleware{serverCtx.Log}")
+	assert.Contains(t, expr, "Handler: serverCtx.Audit(serverCtx.Trace(BHandler(serverCtx))),")
+	assert.Contains(t, expr, "}...,")
+
+	addition.middlewares = nil
+	expr = addition.routesExpr()
+	assert.NotContains(t, expr, "rest.WithMiddlewares")
+}
+
+func TestPreflightAdditions(t *testing.T) {
+	preflight := "middleware.NewCorsMiddleware(serverCtx.Config.Cors.Origins, serverCtx.Config.Cors.Methods).Preflight"
+	groups := []group{
+		{
+			cors:   "Cors",
+			prefix: "/v1",
+			routes: []route{
+				{method: "http.MethodGet", path: "/users/:id"},
+				{method: "http.MethodPut", path: "/users/:userId"},
+				{method: "http.MethodGet", path: "/items"},
+			},
+		},
+		{
+			routes: []route{{method: "http.MethodOptions", path: "/v1/items"}},
+		},
+	}
+
+	additions, err := preflightAdditions(groups)
+	assert.Nil(t, err)
+	// the paths of the same pattern share the route, and the declared OPTIONS routes are kept
+	assert.Equal(t, []routeAddition{{
+		prefix: "\nrest.WithPrefix(\"/v1\"),",
+		routes: []route{{method: "http.MethodOptions", path: "/users/:id", handler: preflight}},
+	}}, additions)
+
+	groups = append(groups, group{cors: "AdminCors", routes: []route{{method: "http.MethodPost", path: "/v1/users/:id"}}})
+	_, err = preflightAdditions(groups)
+	assert.Error(t, err)
+}
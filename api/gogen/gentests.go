@@ -0,0 +1,336 @@
+package gogen
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	apiutil "github.com/yeyudekuangxiang/goctl/api/util"
+	"github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/pkg/golang"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+//go:embed handler-test.tpl
+var handlerTestTemplate string
+
+type (
+	handlerTestInfo struct {
+		handlerInfo
+		TestName string
+		Method   string
+		Target   string
+		Body     string
+		Headers  []testParam
+		PathVars []testParam
+		// Checks are the fields of the request that the handler must parse
+		Checks         []testParam
+		ResponseValue  string
+		ResponseShape  string
+		ResponseFields []string
+	}
+
+	testParam struct {
+		Name  string
+		Value string
+	}
+)
+
+// genHandlerTests generates a contract test for each handler, the test sends a request built
+// from the request type and checks the parsed request and the shape of the response.
+func genHandlerTests(dir, rootPkg string, cfg *config.Config, api *spec.ApiSpec) error {
+	types := apiutil.DefinedStructs(api)
+	for _, group := range api.Service.Groups {
+		for _, route := range group.Routes {
+			if err := genHandlerTest(dir, cfg, group, route, types); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func genHandlerTest(dir string, cfg *config.Config, group spec.Group, route spec.Route,
+	types map[string]spec.DefineStruct,
+) error {
+	handler := getHandlerName(route)
+	handlerPath := getHandlerFolderPath(group, route)
+	if handlerPath != handlerDir {
+		handler = strings.Title(handler)
+	}
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, handler)
+	if err != nil {
+		return err
+	}
+
+	logic := strings.Title(getLogicName(route))
+	constructor := "new" + logic
+	// the handlers generated without --tests have no seam to stub the logic
+	code, err := os.ReadFile(filepath.Join(dir, handlerPath, filename+".go"))
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(string(code), "var "+constructor+" ") {
+		fmt.Println(aurora.Yellow(fmt.Sprintf("%s has no %s, the test of it is skipped, "+
+			"remove it and generate again to add the test", handler, constructor)))
+		return nil
+	}
+
+	parentPkg, err := golang.GetParentPackage(dir)
+	if err != nil {
+		return err
+	}
+
+	info := handlerTestInfo{
+		handlerInfo: handlerInfo{
+			PkgName:          handlerPath[strings.LastIndex(handlerPath, "/")+1:],
+			ImportPackages:   genHandlerTestImports(route, parentPkg),
+			HandlerName:      handler,
+			RequestType:      util.Title(route.RequestTypeName()),
+			LogicType:        logic,
+			Call:             strings.Title(strings.TrimSuffix(handler, "Handler")),
+			HasResp:          len(route.ResponseTypeName()) > 0,
+			HasRequest:       len(route.RequestTypeName()) > 0,
+			ResponseType:     responseGoTypeName(route, typesPacket),
+			LogicInterface:   util.Untitle(getLogicName(route)),
+			LogicConstructor: constructor,
+		},
+		TestName: "Test" + strings.Title(handler),
+		Method:   "http.Method" + strings.Title(strings.ToLower(route.Method)),
+		Target:   route.Path,
+	}
+	if err := info.fillRequest(route, types); err != nil {
+		return err
+	}
+	info.fillResponse(route, types)
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          handlerPath,
+		filename:        filename + "_test.go",
+		templateName:    "handlerTestTemplate",
+		category:        category,
+		templateFile:    handlerTestTemplateFile,
+		builtinTemplate: handlerTestTemplate,
+		data:            info,
+	})
+}
+
+func genHandlerTestImports(route spec.Route, parentPkg string) string {
+	imports := []string{fmt.Sprintf("%q", pathx.JoinPackages(parentPkg, contextDir))}
+	if len(route.RequestTypeName()) > 0 ||
+		strings.Contains(responseGoTypeName(route, typesPacket), typesPacket+".") {
+		imports = append(imports, fmt.Sprintf("%q", pathx.JoinPackages(parentPkg, typesDir)))
+	}
+
+	return strings.Join(imports, "\n\t")
+}
+
+// fillRequest fills the path, the query, the headers and the json body with the examples of
+// the fields, the parsed primitive fields are checked.
+func (info *handlerTestInfo) fillRequest(route spec.Route, types map[string]spec.DefineStruct) error {
+	if route.RequestType == nil {
+		return nil
+	}
+
+	ds, ok := route.RequestType.(spec.DefineStruct)
+	if !ok {
+		return nil
+	}
+	if declared, ok := types[ds.RawName]; ok {
+		ds = declared
+	}
+
+	var (
+		query   []string
+		body    = make(map[string]interface{})
+		paths   = make(map[string]string)
+		example = newExample(types)
+	)
+	for _, field := range apiutil.Fields(ds, types) {
+		tp, isPrimitive := field.Type.(spec.PrimitiveType)
+		if field.Location == apiutil.JSONLocation {
+			if !isPrimitive && field.Optional {
+				continue
+			}
+
+			body[field.WireName] = example.Field(field)
+			if isPrimitive {
+				info.Checks = append(info.Checks, testParam{
+					Name:  strings.Title(field.Name),
+					Value: goLiteral(tp.RawName, exampleOf(field, tp.RawName)),
+				})
+			}
+			continue
+		}
+
+		// the non-primitive params are not supported to be carried out of the json body
+		if !isPrimitive {
+			continue
+		}
+
+		value := exampleOf(field, tp.RawName)
+		switch field.Location {
+		case apiutil.PathLocation:
+			paths[field.WireName] = value
+			info.PathVars = append(info.PathVars, testParam{Name: field.WireName, Value: value})
+		case apiutil.FormLocation:
+			query = append(query, field.WireName+"="+value)
+		case apiutil.HeaderLocation:
+			info.Headers = append(info.Headers, testParam{Name: field.WireName, Value: value})
+		default:
+			continue
+		}
+		info.Checks = append(info.Checks, testParam{
+			Name:  strings.Title(field.Name),
+			Value: goLiteral(tp.RawName, value),
+		})
+	}
+
+	target, err := apiutil.ReplacePathParams(route.Path, func(name string) (string, bool) {
+		value, ok := paths[name]
+		return value, ok
+	})
+	if err != nil {
+		return err
+	}
+	if len(query) > 0 {
+		target += "?" + strings.Join(query, "&")
+	}
+	info.Target = target
+
+	if len(body) > 0 {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		info.Body = string(data)
+	}
+
+	return nil
+}
+
+// fillResponse fills the stubbed response and the required json fields of it
+func (info *handlerTestInfo) fillResponse(route spec.Route, types map[string]spec.DefineStruct) {
+	if route.ResponseType == nil {
+		return
+	}
+
+	info.ResponseShape = "interface{}"
+	info.ResponseValue = "nil"
+	switch tp := route.ResponseType.(type) {
+	case spec.DefineStruct:
+		info.ResponseShape = "map[string]interface{}"
+		info.ResponseValue = "&" + strings.TrimPrefix(info.ResponseType, "*") + "{}"
+		if declared, ok := types[tp.RawName]; ok {
+			tp = declared
+		}
+		for _, field := range apiutil.Fields(tp, types) {
+			if field.Location == apiutil.JSONLocation && !field.Member.IsOmitEmpty() {
+				info.ResponseFields = append(info.ResponseFields, field.WireName)
+			}
+		}
+	case spec.ArrayType:
+		info.ResponseShape = "[]interface{}"
+		info.ResponseValue = info.ResponseType + "{}"
+	case spec.MapType:
+		info.ResponseShape = "map[string]interface{}"
+		info.ResponseValue = info.ResponseType + "{}"
+	}
+}
+
+// exampleOf returns the example of the primitive field which satisfies the tag
+func exampleOf(field apiutil.Field, tp string) string {
+	if value, ok := ruleExample(field, tp); ok {
+		return value
+	}
+
+	return primitiveExample(tp, field.WireName)
+}
+
+// ruleExample returns the default value, the first option or a number in the range of the tag
+func ruleExample(field apiutil.Field, tp string) (string, bool) {
+	rule := field.Rule()
+	switch {
+	case rule.HasDefault:
+		return rule.Default, true
+	case len(rule.Options) > 0:
+		return rule.Options[0], true
+	case rule.Range != nil:
+		return rangeExample(tp, *rule.Range), true
+	default:
+		return "", false
+	}
+}
+
+func primitiveExample(tp, name string) string {
+	switch tp {
+	case "string":
+		return name
+	case "bool":
+		return "true"
+	case "float32", "float64":
+		return "1.5"
+	default:
+		return "1"
+	}
+}
+
+func rangeExample(tp string, r apiutil.Range) string {
+	if tp == "float32" || tp == "float64" {
+		return strconv.FormatFloat((r.Min+r.Max)/2, 'f', -1, 64)
+	}
+
+	v := math.Ceil(r.Min)
+	if r.LeftOpen && v == r.Min {
+		v++
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// newExample returns the examples of the json bodies with the required fields only
+func newExample(types map[string]spec.DefineStruct) *apiutil.Example {
+	return &apiutil.Example{
+		Types: types,
+		Rule: func(field apiutil.Field, tp string) (interface{}, bool) {
+			value, ok := ruleExample(field, tp)
+			return jsonValue(tp, value), ok
+		},
+		Primitive: func(tp, name string) interface{} {
+			return jsonValue(tp, primitiveExample(tp, name))
+		},
+		Required: true,
+	}
+}
+
+func jsonValue(tp, value string) interface{} {
+	switch tp {
+	case "string":
+		return value
+	case "bool":
+		return value == "" || value == "true"
+	default:
+		if len(value) == 0 {
+			value = "1"
+		}
+		return json.Number(value)
+	}
+}
+
+func goLiteral(tp, value string) string {
+	if tp == "string" {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
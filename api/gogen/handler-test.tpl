@@ -0,0 +1,84 @@
+package {{.PkgName}}
+
+import (
+	"context"
+	{{if .HasResp}}"encoding/json"
+	{{end}}"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	{{if .PathVars}}"github.com/zeromicro/go-zero/rest/pathvar"
+	{{end}}{{.ImportPackages}}
+)
+
+type stub{{.LogicType}} struct {
+	{{if .HasRequest}}req *types.{{.RequestType}}
+	{{end}}err error
+}
+
+func (s *stub{{.LogicType}}) {{.Call}}({{if .HasRequest}}req *types.{{.RequestType}}{{end}}) {{if .HasResp}}({{.ResponseType}}, error){{else}}error{{end}} {
+	{{if .HasRequest}}s.req = req
+	{{end}}return {{if .HasResp}}{{.ResponseValue}}, {{end}}s.err
+}
+
+func {{.TestName}}(t *testing.T) {
+	origin := {{.LogicConstructor}}
+	defer func() {
+		{{.LogicConstructor}} = origin
+	}()
+
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "ok", code: http.StatusOK},
+		{name: "logic error", err: errors.New("logic error"), code: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stub := &stub{{.LogicType}}{err: test.err}
+			{{.LogicConstructor}} = func(context.Context, *svc.ServiceContext) {{.LogicInterface}} {
+				return stub
+			}
+
+			r := httptest.NewRequest({{.Method}}, "{{.Target}}", strings.NewReader(`{{.Body}}`))
+			{{if .Body}}r.Header.Set("Content-Type", "application/json")
+			{{end}}{{range .Headers}}r.Header.Set("{{.Name}}", "{{.Value}}")
+			{{end}}{{if .PathVars}}r = pathvar.WithVars(r, map[string]string{
+				{{range .PathVars}}"{{.Name}}": "{{.Value}}",
+				{{end}}})
+			{{end}}w := httptest.NewRecorder()
+			{{.HandlerName}}(&svc.ServiceContext{})(w, r)
+
+			if w.Code != test.code {
+				t.Fatalf("expected status %d, got %d: %s", test.code, w.Code, w.Body.String())
+			}
+			if test.err != nil {
+				return
+			}
+{{- if .Checks}}
+{{range .Checks}}
+			if stub.req.{{.Name}} != {{.Value}} {
+				t.Errorf("expected {{.Name}} to be %v, got %v", {{.Value}}, stub.req.{{.Name}})
+			}
+{{- end}}
+{{- end}}
+{{- if .HasResp}}
+
+			var resp {{.ResponseShape}}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unexpected response %s: %v", w.Body.String(), err)
+			}
+{{- range .ResponseFields}}
+			if _, ok := resp["{{.}}"]; !ok {
+				t.Errorf("expected {{.}} in the response %s", w.Body.String())
+			}
+{{- end}}
+{{- end}}
+		})
+	}
+}
@@ -1,12 +1,22 @@
 package {{.PkgName}}
 
 import (
-	"net/http"
+	{{if .WithTests}}"context"
+	{{end}}"net/http"
 
 	"github.com/zeromicro/go-zero/rest/httpx"
 	{{.ImportPackages}}
 )
+{{if .WithTests}}
+// {{.LogicInterface}} is the seam of the logic, the tests replace {{.LogicConstructor}} with a stub.
+type {{.LogicInterface}} interface {
+	{{.Call}}({{if .HasRequest}}req *types.{{.RequestType}}{{end}}) {{if .HasResp}}(resp {{.ResponseType}}, err error){{else}}error{{end}}
+}
 
+var {{.LogicConstructor}} = func(ctx context.Context, svcCtx *svc.ServiceContext) {{.LogicInterface}} {
+	return {{.LogicName}}.New{{.LogicType}}(ctx, svcCtx)
+}
+{{end}}
 func {{.HandlerName}}(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		{{if .HasRequest}}var req types.{{.RequestType}}
@@ -15,7 +25,7 @@ func {{.HandlerName}}(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
-		{{end}}l := {{.LogicName}}.New{{.LogicType}}(r.Context(), svcCtx)
+		{{end}}l := {{if .WithTests}}{{.LogicConstructor}}(r.Context(), svcCtx){{else}}{{.LogicName}}.New{{.LogicType}}(r.Context(), svcCtx){{end}}
 		{{if .HasResp}}resp, {{end}}err := l.{{.Call}}({{if .HasRequest}}&req{{end}})
 		if err != nil {
 			httpx.Error(w, err)
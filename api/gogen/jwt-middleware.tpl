@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+{{if .claims}}
+	"{{.authImport}}"
+{{end}}
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/handler"
+)
+
+// {{.name}}Middleware verifies the token with the secret or the previous secret of jwtTransition,
+// the requests without a valid token are rejected with 401.
+func {{.name}}Middleware(secret, prevSecret string) rest.Middleware {
+	authorize := handler.Authorize(secret, handler.WithPrevSecret(prevSecret))
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		{{if .claims}}return authorize(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := auth.{{.claims}}FromContext(r.Context())
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			next(w, r.WithContext(auth.ContextWith{{.claims}}(r.Context(), claims)))
+		})).ServeHTTP{{else}}return authorize(next).ServeHTTP{{end}}
+	}
+}
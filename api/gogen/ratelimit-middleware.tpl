@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	jwtKeyPrefix = "jwt:"
+	forwardedKey = "forwarded"
+	// sweepInterval is the min interval of evicting the idle limiters
+	sweepInterval = time.Minute
+)
+
+type (
+	RateLimitMiddleware struct {
+		qps      rate.Limit
+		burst    int
+		key      string
+		idle     time.Duration
+		lock     sync.Mutex
+		limiters map[string]*limiterEntry
+		swept    time.Time
+	}
+
+	limiterEntry struct {
+		limiter *rate.Limiter
+		seen    time.Time
+	}
+)
+
+// NewRateLimitMiddleware limits the requests of each key, the key is ip, forwarded or jwt:<claim>.
+// The ip is the remote address, forwarded is the address appended to X-Forwarded-For by the
+// trusted proxy in front of the service. The limiters idle longer than their refill time are
+// evicted, they're the same as the new ones.
+func NewRateLimitMiddleware(qps, burst int, key string) *RateLimitMiddleware {
+	idle := time.Duration(burst) * time.Second / time.Duration(qps)
+	if idle < sweepInterval {
+		idle = sweepInterval
+	}
+
+	return &RateLimitMiddleware{
+		qps:      rate.Limit(qps),
+		burst:    burst,
+		key:      key,
+		idle:     idle,
+		limiters: make(map[string]*limiterEntry),
+	}
+}
+
+func (m *RateLimitMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !m.limiter(m.keyOf(r)).Allow() {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// keyOf returns the key of the request, it falls back to the remote ip if the claim or the
+// header is absent.
+func (m *RateLimitMiddleware) keyOf(r *http.Request) string {
+	switch {
+	case strings.HasPrefix(m.key, jwtKeyPrefix):
+		// the claims are put into the context by the jwt verification in front of the middleware
+		if value := r.Context().Value(strings.TrimPrefix(m.key, jwtKeyPrefix)); value != nil {
+			return jwtKeyPrefix + fmt.Sprint(value)
+		}
+	case m.key == forwardedKey:
+		// only the last address is appended by the trusted proxy, the others could be forged
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			addrs := strings.Split(values[len(values)-1], ",")
+			if addr := strings.TrimSpace(addrs[len(addrs)-1]); len(addr) > 0 {
+				return addr
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (m *RateLimitMiddleware) limiter(key string) *rate.Limiter {
+	now := time.Now()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if now.Sub(m.swept) >= sweepInterval {
+		m.swept = now
+		for k, entry := range m.limiters {
+			if now.Sub(entry.seen) >= m.idle {
+				delete(m.limiters, k)
+			}
+		}
+	}
+
+	entry, ok := m.limiters[key]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(m.qps, m.burst)}
+		m.limiters[key] = entry
+	}
+	entry.seen = now
+	return entry.limiter
+}
@@ -8,26 +8,36 @@ import (
 
 const (
 	category                    = "api"
+	authTemplateFile            = "auth.tpl"
 	configTemplateFile          = "config.tpl"
 	contextTemplateFile         = "context.tpl"
+	corsMiddlewareFile          = "cors-middleware.tpl"
 	etcTemplateFile             = "etc.tpl"
 	handlerTemplateFile         = "handler.tpl"
+	handlerTestTemplateFile     = "handler-test.tpl"
+	jwtMiddlewareFile           = "jwt-middleware.tpl"
 	logicTemplateFile           = "logic.tpl"
 	mainTemplateFile            = "main.tpl"
 	middlewareImplementCodeFile = "middleware.tpl"
+	rateLimitMiddlewareFile     = "ratelimit-middleware.tpl"
 	routesTemplateFile          = "routes.tpl"
 	routesAdditionTemplateFile  = "route-addition.tpl"
 	typesTemplateFile           = "types.tpl"
 )
 
 var templates = map[string]string{
+	authTemplateFile:            authTemplate,
 	configTemplateFile:          configTemplate,
 	contextTemplateFile:         contextTemplate,
+	corsMiddlewareFile:          corsMiddlewareCode,
 	etcTemplateFile:             etcTemplate,
 	handlerTemplateFile:         handlerTemplate,
+	handlerTestTemplateFile:     handlerTestTemplate,
+	jwtMiddlewareFile:           jwtMiddlewareCode,
 	logicTemplateFile:           logicTemplate,
 	mainTemplateFile:            mainTemplate,
 	middlewareImplementCodeFile: middlewareImplementCode,
+	rateLimitMiddlewareFile:     rateLimitMiddlewareCode,
 	routesTemplateFile:          routesTemplate,
 	routesAdditionTemplateFile:  routesAdditionTemplate,
 	typesTemplateFile:           typesTemplate,
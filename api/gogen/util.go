@@ -107,6 +107,10 @@ func getAuths(api *spec.ApiSpec) []string {
 	authNames := collection.NewSet()
 	for _, g := range api.Service.Groups {
 		jwt := g.GetAnnotation("jwt")
+		// the jwt verified by a custom middleware is declared as Middleware:Auth
+		if i := strings.Index(jwt, ":"); i != -1 {
+			jwt = jwt[i+1:]
+		}
 		if len(jwt) > 0 {
 			authNames.Add(jwt)
 		}
@@ -125,13 +129,26 @@ func getJwtTrans(api *spec.ApiSpec) []string {
 	return jwtTransList.KeysStr()
 }
 
+func getCors(api *spec.ApiSpec) []string {
+	corsList := collection.NewSet()
+	for _, g := range api.Service.Groups {
+		cors := g.GetAnnotation(corsKey)
+		if len(cors) > 0 {
+			corsList.Add(cors)
+		}
+	}
+	return corsList.KeysStr()
+}
+
 func getMiddleware(api *spec.ApiSpec) []string {
 	result := collection.NewSet()
 	for _, g := range api.Service.Groups {
-		middleware := g.GetAnnotation("middleware")
-		if len(middleware) > 0 {
-			for _, item := range strings.Split(middleware, ",") {
-				result.Add(strings.TrimSpace(item))
+		for _, item := range splitList(g.GetAnnotation(middlewareKey)) {
+			result.Add(item)
+		}
+		for _, r := range g.Routes {
+			for _, item := range splitList(r.GetAnnotation(middlewareKey)) {
+				result.Add(item)
 			}
 		}
 	}
@@ -3,6 +3,7 @@ package gogen
 const (
 	internal      = "internal/"
 	typesPacket   = "types"
+	authDir       = internal + "auth"
 	configDir     = internal + "config"
 	contextDir    = internal + "svc"
 	handlerDir    = internal + "handler"
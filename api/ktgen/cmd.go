@@ -14,6 +14,8 @@ var (
 	VarStringAPI string
 	// VarStringPKG describes a package.
 	VarStringPKG string
+	// VarBoolCoroutines describes whether to generate suspend functions with kotlinx.serialization.
+	VarBoolCoroutines bool
 )
 
 // KtCommand generates kotlin code command entrance
@@ -40,6 +42,10 @@ func KtCommand(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if VarBoolCoroutines {
+		return genCoroutines(dir, pkg, api)
+	}
+
 	api.Service = api.Service.JoinPrefix()
 	e = genBase(dir, pkg, api)
 	if e != nil {
@@ -0,0 +1,341 @@
+package ktgen
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+const (
+	authKey  = "jwt"
+	groupKey = "group"
+)
+
+var (
+	//go:embed coroutinesbase.tpl
+	coroutinesBaseTemplate string
+	//go:embed coroutines.tpl
+	coroutinesTemplate string
+
+	keywords = map[string]bool{
+		"as": true, "break": true, "class": true, "continue": true, "do": true, "else": true,
+		"false": true, "for": true, "fun": true, "if": true, "in": true, "interface": true, "is": true,
+		"null": true, "object": true, "package": true, "return": true, "super": true, "this": true,
+		"throw": true, "true": true, "try": true, "typealias": true, "typeof": true, "val": true,
+		"var": true, "when": true, "while": true,
+	}
+)
+
+type (
+	ktFile struct {
+		Package string
+		Object  string
+		Classes []ktClass
+		Routes  []ktRoute
+	}
+
+	ktClass struct {
+		Name   string
+		Docs   []string
+		Fields []ktField
+	}
+
+	ktField struct {
+		Name     string
+		Wire     string
+		Location string
+		Type     string
+		// Default is the default value of the optional fields and the fields not carried
+		// in the json body, which are transient for kotlinx.serialization.
+		Default string
+		Comment string
+	}
+
+	ktRoute struct {
+		Func     string
+		Method   string
+		Path     string
+		Docs     []string
+		Request  string
+		Response string
+		Query    []ktField
+		Headers  []ktField
+		Body     bool
+		Auth     bool
+	}
+)
+
+// Transient returns true if the field is not carried in the json body
+func (f ktField) Transient() bool {
+	return f.Location != util.JSONLocation
+}
+
+// genCoroutines generates the kotlinx.serialization data classes and the suspend
+// functions of the api, the functions return the response or throw ApiException.
+func genCoroutines(dir, pkg string, api *spec.ApiSpec) error {
+	file, err := buildKtFile(pkg, api)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, "BaseApi.kt")
+	if _, err := os.Stat(path); err == nil {
+		fmt.Println("BaseApi.kt already exists, skipped it.")
+	} else if err := executeKt(path, coroutinesBaseTemplate, pkg); err != nil {
+		return err
+	}
+
+	return executeKt(filepath.Join(dir, file.Object+".kt"), coroutinesTemplate, file)
+}
+
+func executeKt(path, text string, data interface{}) error {
+	t, err := template.New(filepath.Base(path)).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	fp, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	return t.Execute(fp, data)
+}
+
+func buildKtFile(pkg string, api *spec.ApiSpec) (ktFile, error) {
+	file := ktFile{
+		Package: pkg,
+		Object:  strcase.ToCamel(strings.TrimSuffix(api.Service.Name, "-api") + "Api"),
+	}
+	types := util.DefinedStructs(api)
+	classes := make(map[string]ktClass)
+	for _, tp := range api.Types {
+		ds, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		class, err := buildKtClass(ds, types)
+		if err != nil {
+			return file, err
+		}
+
+		classes[class.Name] = class
+		file.Classes = append(file.Classes, class)
+	}
+
+	names := make(map[string]int)
+	service := api.Service.JoinPrefix()
+	for _, g := range service.Groups {
+		for _, r := range g.Routes {
+			route, err := buildKtRoute(g, r, classes)
+			if err != nil {
+				return file, err
+			}
+
+			// the handlers of different groups may have the same name
+			names[route.Func]++
+			if names[route.Func] > 1 {
+				route.Func = propertyName(g.GetAnnotation(groupKey) + "_" + strings.Trim(route.Func, "`"))
+			}
+			file.Routes = append(file.Routes, route)
+		}
+	}
+
+	return file, nil
+}
+
+func buildKtClass(ds spec.DefineStruct, types map[string]spec.DefineStruct) (ktClass, error) {
+	class := ktClass{Name: ds.RawName, Docs: trimComments(ds.Docs)}
+	for _, member := range util.Fields(ds, types) {
+		tp := util.UnwrapPointer(member.Type)
+		name, err := ktTypeName(tp)
+		if err != nil {
+			return class, fmt.Errorf("%s.%s: %w", ds.RawName, member.Name, err)
+		}
+
+		field := ktField{
+			Name:     propertyName(member.Name),
+			Wire:     member.WireName,
+			Location: member.Location,
+			Type:     name,
+			Comment:  strings.TrimSpace(strings.TrimPrefix(member.GetComment(), "//")),
+		}
+		zero := ktZeroValue(tp)
+		switch {
+		case member.Optional || zero == "null":
+			field.Type += "?"
+			field.Default = "null"
+		case field.Transient():
+			field.Default = zero
+		}
+
+		class.Fields = append(class.Fields, field)
+	}
+
+	return class, nil
+}
+
+func buildKtRoute(g spec.Group, r spec.Route, classes map[string]ktClass) (ktRoute, error) {
+	route := ktRoute{
+		Func:   propertyName(strings.TrimSuffix(r.Handler, "Handler")),
+		Method: strings.ToUpper(r.Method),
+		Path:   r.Path,
+		Docs:   routeDocs(r),
+		Auth:   len(g.GetAnnotation(authKey)) > 0,
+	}
+
+	if r.RequestType != nil {
+		ds, ok := r.RequestType.(spec.DefineStruct)
+		if !ok {
+			return route, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+				r.RequestType.Name())
+		}
+
+		class := classes[ds.RawName]
+		route.Request = class.Name
+		paths := make(map[string]string)
+		for _, field := range class.Fields {
+			switch field.Location {
+			case util.PathLocation:
+				paths[field.Wire] = field.Name
+			case util.FormLocation:
+				route.Query = append(route.Query, field)
+			case util.HeaderLocation:
+				route.Headers = append(route.Headers, field)
+			default:
+				route.Body = true
+			}
+		}
+
+		path, err := util.ReplacePathParams(r.Path, func(name string) (string, bool) {
+			property, ok := paths[name]
+			return fmt.Sprintf("${encodePath(req.%s)}", property), ok
+		})
+		if err != nil {
+			return route, err
+		}
+		route.Path = path
+	} else if util.HasPathParams(r.Path) {
+		return route, fmt.Errorf("route %s %s: path parameters require a request type", r.Method, r.Path)
+	}
+
+	if r.ResponseType != nil {
+		name, err := ktTypeName(util.UnwrapPointer(r.ResponseType))
+		if err != nil {
+			return route, fmt.Errorf("route %s %s: %w", r.Method, r.Path, err)
+		}
+		route.Response = name
+	}
+
+	return route, nil
+}
+
+func ktTypeName(tp spec.Type) (string, error) {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		return ktPrimitiveName(v.RawName), nil
+	case spec.DefineStruct:
+		return v.RawName, nil
+	case spec.InterfaceType:
+		return "JsonElement", nil
+	case spec.PointerType:
+		return ktTypeName(util.UnwrapPointer(v))
+	case spec.ArrayType:
+		name, err := ktTypeName(v.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("List<%s>", name), nil
+	case spec.MapType:
+		name, err := ktTypeName(v.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Map<%s, %s>", ktPrimitiveName(v.Key), name), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", tp.Name())
+	}
+}
+
+func ktPrimitiveName(name string) string {
+	switch name {
+	case "bool":
+		return "Boolean"
+	case "string":
+		return "String"
+	case "float32":
+		return "Float"
+	case "float64":
+		return "Double"
+	case "int8", "int16", "int32", "uint8", "uint16", "byte", "rune":
+		return "Int"
+	case "int", "int64", "uint", "uint32", "uint64", "uintptr":
+		return "Long"
+	default:
+		return "JsonElement"
+	}
+}
+
+func ktZeroValue(tp spec.Type) string {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		switch ktPrimitiveName(v.RawName) {
+		case "Boolean":
+			return "false"
+		case "String":
+			return `""`
+		case "Float":
+			return "0f"
+		case "Double":
+			return "0.0"
+		case "Long":
+			return "0L"
+		case "Int":
+			return "0"
+		}
+	case spec.ArrayType:
+		return "emptyList()"
+	case spec.MapType:
+		return "emptyMap()"
+	}
+	return "null"
+}
+
+func propertyName(name string) string {
+	property := strcase.ToLowerCamel(name)
+	if keywords[property] {
+		return "`" + property + "`"
+	}
+	return property
+}
+
+func routeDocs(r spec.Route) []string {
+	if doc := strings.Trim(r.JoinedDoc(), `"`); len(doc) > 0 {
+		return []string{doc}
+	}
+	return nil
+}
+
+func trimComments(docs spec.Doc) []string {
+	var result []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(doc), "//"))
+		if len(doc) > 0 {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package ktgen
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenCoroutines(t *testing.T) {
+	api, err := parser.Parse("testdata/example.api")
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	assert.Nil(t, genCoroutines(dir, "com.example.user", api))
+
+	for _, name := range []string{"BaseApi.kt", "UserApi.kt"} {
+		actual, err := ioutil.ReadFile(filepath.Join(dir, name))
+		assert.Nil(t, err)
+
+		golden := filepath.Join("testdata", "golden", name+".golden")
+		if *update {
+			assert.Nil(t, ioutil.WriteFile(golden, actual, 0o644))
+		}
+
+		expected, err := ioutil.ReadFile(golden)
+		assert.Nil(t, err)
+		assert.Equal(t, string(expected), string(actual), name)
+	}
+}
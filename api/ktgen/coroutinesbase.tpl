@@ -0,0 +1,65 @@
+package {{.}}
+
+import kotlinx.coroutines.Dispatchers
+import kotlinx.coroutines.withContext
+import kotlinx.serialization.json.Json
+import java.net.HttpURLConnection
+import java.net.URL
+import java.net.URLEncoder
+
+var SERVER = "http://localhost:8080"
+
+// TOKEN is sent as the bearer token of the routes with jwt
+var TOKEN: String? = null
+
+val json = Json {
+    ignoreUnknownKeys = true
+}
+
+class ApiException(val code: Int, val body: String) : Exception("http status $code: $body")
+
+fun encodePath(value: Any?): String = URLEncoder.encode(value.toString(), "UTF-8").replace("+", "%20")
+
+suspend fun apiRequest(
+    method: String,
+    path: String,
+    query: List<Pair<String, Any?>> = emptyList(),
+    headers: List<Pair<String, Any?>> = emptyList(),
+    body: String? = null,
+    auth: Boolean = false,
+): String = withContext(Dispatchers.IO) {
+    val params = query.flatMap { (name, value) ->
+        when (value) {
+            null -> emptyList()
+            is Iterable<*> -> value.map { name to it }
+            else -> listOf(name to value)
+        }
+    }.joinToString("&") { (name, value) ->
+        URLEncoder.encode(name, "UTF-8") + "=" + URLEncoder.encode(value.toString(), "UTF-8")
+    }
+    val url = URL(SERVER + path + if (params.isEmpty()) "" else "?$params")
+    with(url.openConnection() as HttpURLConnection) {
+        connectTimeout = 3000
+        requestMethod = method
+        headers.forEach { (name, value) ->
+            if (value != null) {
+                setRequestProperty(name, value.toString())
+            }
+        }
+        val token = TOKEN
+        if (auth && token != null) {
+            setRequestProperty("Authorization", "Bearer $token")
+        }
+        if (body != null) {
+            setRequestProperty("Content-Type", "application/json; charset=utf-8")
+            doOutput = true
+            outputStream.use { it.write(body.toByteArray()) }
+        }
+
+        if (responseCode >= 400) {
+            val message = errorStream?.bufferedReader()?.use { it.readText() } ?: ""
+            throw ApiException(responseCode, message)
+        }
+        inputStream.bufferedReader().use { it.readText() }
+    }
+}
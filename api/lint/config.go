@@ -0,0 +1,100 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/zeromicro/go-zero/core/conf"
+)
+
+// DefaultConfigFile is the config file looked up in the working directory and
+// the directory of the api file if --config is not specified.
+const DefaultConfigFile = ".goctl-lint.yaml"
+
+// Config describes the lint config, such as:
+//
+//	enable:
+//	  - kebab-path
+//	  - json-lower-camel
+//	disable:
+//	  - require-doc
+//	severity:
+//	  json-lower-camel: warning
+type Config struct {
+	// Enable is the rules to run, all the registered rules run if it's empty.
+	Enable []string `json:"enable,optional"`
+	// Disable is the rules to skip.
+	Disable []string `json:"disable,optional"`
+	// Severity overrides the severity of the rules, the default severity is error.
+	Severity map[string]string `json:"severity,optional"`
+}
+
+// LoadConfig loads the config from the file, if file is empty, DefaultConfigFile is
+// looked up in the working directory and dir, an empty config is returned if not found.
+func LoadConfig(file, dir string) (*Config, error) {
+	var cfg Config
+	if len(file) == 0 {
+		for _, each := range []string{DefaultConfigFile, filepath.Join(dir, DefaultConfigFile)} {
+			if _, err := os.Stat(each); err == nil {
+				file = each
+				break
+			}
+		}
+	}
+	if len(file) == 0 {
+		return &cfg, nil
+	}
+
+	if err := conf.Load(file, &cfg); err != nil {
+		return nil, err
+	}
+
+	for _, rule := range append(append([]string{}, cfg.Enable...), cfg.Disable...) {
+		if _, ok := rules[rule]; !ok {
+			return nil, fmt.Errorf("%s: unknown rule %q", file, rule)
+		}
+	}
+	for rule, severity := range cfg.Severity {
+		if _, ok := rules[rule]; !ok {
+			return nil, fmt.Errorf("%s: unknown rule %q", file, rule)
+		}
+		switch spec.Severity(severity) {
+		case spec.SeverityError, spec.SeverityWarning, spec.SeverityInfo:
+		default:
+			return nil, fmt.Errorf("%s: invalid severity %q of rule %q", file, severity, rule)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// Enabled returns true if the rule is enabled by the config
+func (c *Config) Enabled(rule string) bool {
+	for _, each := range c.Disable {
+		if each == rule {
+			return false
+		}
+	}
+
+	if len(c.Enable) == 0 {
+		return true
+	}
+
+	for _, each := range c.Enable {
+		if each == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// SeverityOf returns the severity of the rule
+func (c *Config) SeverityOf(rule string) spec.Severity {
+	if severity, ok := c.Severity[rule]; ok {
+		return spec.Severity(severity)
+	}
+
+	return spec.SeverityError
+}
@@ -0,0 +1,241 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/format"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/validate"
+)
+
+var (
+	// VarStringAPI describes the api file.
+	VarStringAPI string
+	// VarStringConfig describes the lint config file.
+	VarStringConfig string
+	// VarStringFormat describes the output format, text, json or sarif.
+	VarStringFormat string
+	// VarBoolFix describes whether to fix the fixable issues.
+	VarBoolFix bool
+	// VarBoolList describes whether to list the registered rules.
+	VarBoolList bool
+)
+
+// Result describes an issue found in an api file
+type Result struct {
+	Issue
+	Rule       string
+	Diagnostic spec.Diagnostic
+}
+
+// LintCommand lints the api file with the rules enabled by the config, only the report is written
+// to the output, so it's parseable in json or sarif.
+func LintCommand(cmd *cobra.Command, _ []string) error {
+	if VarBoolList {
+		for _, rule := range Rules() {
+			fmt.Fprintf(cmd.OutOrStdout(), "%-20s %s\n", rule.Name(), rule.Description())
+		}
+		return nil
+	}
+
+	apiFile := VarStringAPI
+	if len(apiFile) == 0 {
+		return errors.New("missing -api")
+	}
+
+	cfg, err := LoadConfig(VarStringConfig, filepath.Dir(apiFile))
+	if err != nil {
+		return err
+	}
+
+	results, err := Lint(apiFile, cfg)
+	if err != nil {
+		return err
+	}
+
+	if VarBoolFix {
+		fixed, err := Fix(apiFile, results)
+		if err != nil {
+			return err
+		}
+
+		if fixed > 0 {
+			fmt.Fprintln(cmd.ErrOrStderr(), aurora.Green(fmt.Sprintf("%d issue(s) fixed", fixed)))
+			results, err = Lint(apiFile, cfg)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var diagnostics spec.Diagnostics
+	for _, each := range results {
+		diagnostics = append(diagnostics, each.Diagnostic)
+	}
+	if err := validate.Print(cmd.OutOrStdout(), VarStringFormat, diagnostics); err != nil {
+		return err
+	}
+
+	if diagnostics.HasError() {
+		return fmt.Errorf("%s: %d issue(s) found", apiFile, len(diagnostics))
+	}
+
+	return nil
+}
+
+// Lint parses the api file and checks it with the rules enabled by cfg, the
+// issues suppressed by lint:ignore comments are skipped.
+func Lint(apiFile string, cfg *Config) ([]Result, error) {
+	api, err := parser.Parse(apiFile)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(apiFile)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	var results []Result
+	for _, rule := range Rules() {
+		if !cfg.Enabled(rule.Name()) {
+			continue
+		}
+
+		for _, issue := range rule.Check(api) {
+			if issue.Ignored(rule.Name()) {
+				continue
+			}
+
+			line, column := locate(lines, issue)
+			results = append(results, Result{
+				Issue: issue,
+				Rule:  rule.Name(),
+				Diagnostic: spec.Diagnostic{
+					File:     apiFile,
+					Line:     line,
+					Column:   column,
+					Severity: cfg.SeverityOf(rule.Name()),
+					Code:     rule.Name(),
+					Message:  issue.Message,
+				},
+			})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Diagnostic.Line < results[j].Diagnostic.Line
+	})
+	return results, nil
+}
+
+// Fix applies the fixes of the fixable results to the api file and formats it,
+// the count of the fixed issues is returned.
+func Fix(apiFile string, results []Result) (int, error) {
+	data, err := ioutil.ReadFile(apiFile)
+	if err != nil {
+		return 0, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	var fixed int
+	for _, each := range results {
+		if !each.Fixable() || each.Diagnostic.Line == 0 {
+			continue
+		}
+
+		index := each.Diagnostic.Line - 1
+		line := lines[index]
+		if !strings.Contains(line, each.From) {
+			continue
+		}
+
+		lines[index] = strings.Replace(line, each.From, each.To, 1)
+		fixed++
+	}
+	if fixed == 0 {
+		return 0, nil
+	}
+
+	info, err := os.Stat(apiFile)
+	if err != nil {
+		return 0, err
+	}
+
+	err = ioutil.WriteFile(apiFile, []byte(strings.Join(lines, "\n")), info.Mode())
+	if err != nil {
+		return 0, err
+	}
+
+	return fixed, format.ApiFormatByPath(apiFile, false)
+}
+
+// locate finds the 1-based line and 0-based column of the issue in the api file,
+// 0 is returned if the issue is not declared in the file, such as in an imported file.
+func locate(lines []string, issue Issue) (int, int) {
+	start := 0
+	if len(issue.Scope) > 0 {
+		scope := regexp.MustCompile(`^\s*(type\s+)?` + regexp.QuoteMeta(issue.Scope) + `\s*(struct\s*)?\{`)
+		start = -1
+		for i, line := range lines {
+			if scope.MatchString(line) {
+				start = i
+				break
+			}
+		}
+		if start < 0 {
+			return 0, 0
+		}
+	}
+
+	for i := start; i < len(lines); i++ {
+		column := indexToken(lines[i], issue.Anchor)
+		if column >= 0 {
+			return i + 1, column
+		}
+	}
+
+	return 0, 0
+}
+
+// indexToken returns the index of the anchor as whole tokens in the line, the anchor joined
+// with the names or the paths around it is skipped, e.g. @handler Get in @handler GetUser.
+func indexToken(line, anchor string) int {
+	for offset := 0; offset < len(line); {
+		index := strings.Index(line[offset:], anchor)
+		if index < 0 {
+			return -1
+		}
+
+		index += offset
+		end := index + len(anchor)
+		if (index == 0 || !isTokenChar(line[index-1])) && (end == len(line) || !isTokenChar(line[end])) {
+			return index
+		}
+		offset = index + 1
+	}
+
+	return -1
+}
+
+// isTokenChar reports whether the byte is a part of the names or the paths, the bytes of the
+// non-ASCII characters are treated as the parts of the names.
+func isTokenChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c >= 0x80:
+		return true
+	default:
+		return strings.IndexByte("_-./:", c) >= 0
+	}
+}
@@ -0,0 +1,139 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+func TestLint(t *testing.T) {
+	results, err := Lint("testdata/lint.api", &Config{})
+	assert.Nil(t, err)
+
+	type position struct {
+		line int
+		rule string
+	}
+	var actual []position
+	for _, each := range results {
+		actual = append(actual, position{line: each.Diagnostic.Line, rule: each.Rule})
+	}
+	assert.ElementsMatch(t, []position{
+		{line: 5, rule: "json-lower-camel"},
+		{line: 7, rule: "no-interface"},
+		{line: 19, rule: "handler-name"},
+		{line: 20, rule: "kebab-path"},
+		{line: 20, rule: "require-doc"},
+		{line: 20, rule: "returns-wrapping"},
+	}, actual)
+}
+
+func TestLintConfig(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, DefaultConfigFile)
+	err := ioutil.WriteFile(file, []byte(`enable:
+  - kebab-path
+  - require-doc
+disable:
+  - require-doc
+severity:
+  kebab-path: warning
+`), 0o644)
+	assert.Nil(t, err)
+
+	cfg, err := LoadConfig("", dir)
+	assert.Nil(t, err)
+	results, err := Lint("testdata/lint.api", cfg)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "kebab-path", results[0].Rule)
+	assert.Equal(t, spec.SeverityWarning, results[0].Diagnostic.Severity)
+
+	for _, content := range []string{
+		"severity:\n  kebab-path: fatal\n",
+		"enable:\n  - kebab-paths\n",
+		"disable:\n  - require-docs\n",
+	} {
+		err = ioutil.WriteFile(file, []byte(content), 0o644)
+		assert.Nil(t, err)
+		_, err = LoadConfig(file, "")
+		assert.NotNil(t, err)
+	}
+}
+
+func TestLocate(t *testing.T) {
+	lines := []string{
+		"service greet-api {",
+		"\t@handler GetUser",
+		"\tget /users/:id(Request) returns (Response)",
+		"\t@handler Get",
+		"\tget /users(Request) returns (Response)",
+		"}",
+	}
+	line, column := locate(lines, Issue{Anchor: "@handler Get"})
+	assert.Equal(t, 4, line)
+	assert.Equal(t, 1, column)
+	line, _ = locate(lines, Issue{Anchor: "get /users"})
+	assert.Equal(t, 5, line)
+	line, _ = locate(lines, Issue{Anchor: "get /user"})
+	assert.Equal(t, 0, line)
+}
+
+func TestFix(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/lint.api")
+	assert.Nil(t, err)
+	apiFile := filepath.Join(t.TempDir(), "lint.api")
+	assert.Nil(t, ioutil.WriteFile(apiFile, data, 0o644))
+
+	results, err := Lint(apiFile, &Config{})
+	assert.Nil(t, err)
+	fixed, err := Fix(apiFile, results)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fixed)
+
+	results, err = Lint(apiFile, &Config{})
+	assert.Nil(t, err)
+	for _, each := range results {
+		assert.False(t, each.Fixable())
+	}
+
+	data, err = ioutil.ReadFile(apiFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `json:"userName"`)
+	assert.Contains(t, string(data), "get /user-info/:name(Request)")
+}
+
+func TestLintCommand(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/lint.api")
+	assert.Nil(t, err)
+	apiFile := filepath.Join(t.TempDir(), "lint.api")
+	assert.Nil(t, ioutil.WriteFile(apiFile, data, 0o644))
+
+	VarStringAPI, VarStringFormat, VarBoolFix = apiFile, "sarif", true
+	defer func() {
+		VarStringAPI, VarStringFormat, VarBoolFix = "", "", false
+	}()
+
+	var stdout, stderr bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	assert.NotNil(t, LintCommand(cmd, nil))
+	// the report is the only output, the fixes are reported to stderr
+	assert.True(t, json.Valid(stdout.Bytes()))
+	assert.Contains(t, stderr.String(), "2 issue(s) fixed")
+}
+
+func TestIgnored(t *testing.T) {
+	issue := Issue{Comments: []string{"// lint:ignore kebab-path,require-doc"}}
+	assert.True(t, issue.Ignored("kebab-path"))
+	assert.True(t, issue.Ignored("require-doc"))
+	assert.False(t, issue.Ignored("no-interface"))
+	assert.True(t, Issue{Comments: []string{"// lint:ignore all"}}.Ignored("no-interface"))
+}
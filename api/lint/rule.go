@@ -0,0 +1,114 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+const ignoreDirective = "lint:ignore"
+
+type (
+	// Rule describes a lint rule which checks the api spec
+	Rule interface {
+		// Name returns the unique name of the rule, it's used in the config file
+		// and the lint:ignore comments.
+		Name() string
+		// Description returns a brief description of the rule
+		Description() string
+		// Check checks the api spec and returns the issues found
+		Check(api *spec.ApiSpec) []Issue
+	}
+
+	// Issue describes a problem found by a Rule
+	Issue struct {
+		Message string
+		// Anchor is the text used to locate the issue in the api file, such as
+		// `@handler Foo` or `get /foo`.
+		Anchor string
+		// Scope is the name of the type which contains the element, the Anchor is
+		// looked up inside the type if it's not empty.
+		Scope string
+		// From and To describe a mechanical fix of the issue, From in the located
+		// line is replaced with To, the issue is not fixable if From is empty.
+		From string
+		To   string
+		// Comments are the comments attached to the element, they are used to find
+		// the lint:ignore directive.
+		Comments []string
+	}
+)
+
+var rules = map[string]Rule{}
+
+// Register registers a rule, the rule with the same name is replaced.
+func Register(rule Rule) {
+	rules[rule.Name()] = rule
+}
+
+// Rules returns all the registered rules sorted by name
+func Rules() []Rule {
+	var list []Rule
+	for _, rule := range rules {
+		list = append(list, rule)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name() < list[j].Name()
+	})
+	return list
+}
+
+// Fixable returns true if the issue can be fixed automatically
+func (i Issue) Fixable() bool {
+	return len(i.From) > 0
+}
+
+// Ignored returns true if any comment of the issue contains a lint:ignore
+// directive for the rule, such as: // lint:ignore json-lower-camel,no-interface
+func (i Issue) Ignored(rule string) bool {
+	for _, comment := range i.Comments {
+		index := strings.Index(comment, ignoreDirective)
+		if index < 0 {
+			continue
+		}
+
+		fields := strings.Fields(comment[index+len(ignoreDirective):])
+		if len(fields) == 0 {
+			return true
+		}
+
+		for _, name := range strings.Split(fields[0], ",") {
+			if name == rule || name == "all" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func routeAnchor(route spec.Route) string {
+	return fmt.Sprintf("%s %s", route.Method, route.Path)
+}
+
+func handlerAnchor(route spec.Route) string {
+	if len(route.GetAnnotation("handler")) > 0 {
+		return "handler: " + route.Handler
+	}
+
+	return "@handler " + route.Handler
+}
+
+func routeComments(route spec.Route) []string {
+	var comments []string
+	comments = append(comments, route.Comment...)
+	comments = append(comments, route.HandlerComment...)
+	comments = append(comments, route.Doc...)
+	comments = append(comments, route.HandlerDoc...)
+	return comments
+}
+
+func memberComments(member spec.Member) []string {
+	return append([]string{member.Comment}, member.Docs...)
+}
@@ -0,0 +1,266 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+const (
+	handlerSuffix = "Handler"
+	jsonTagKey    = "json"
+)
+
+var (
+	kebabRegex      = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	lowerCamelRegex = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+	handlerVerbs    = []string{
+		"Get", "List", "Search", "Query", "Create", "Add", "Update", "Patch", "Set",
+		"Delete", "Remove", "Upload", "Download", "Login", "Logout", "Register",
+	}
+)
+
+func init() {
+	Register(handlerNameRule{})
+	Register(kebabPathRule{})
+	Register(requireDocRule{})
+	Register(jsonLowerCamelRule{})
+	Register(noInterfaceRule{})
+	Register(returnsWrappingRule{})
+}
+
+type (
+	handlerNameRule     struct{}
+	kebabPathRule       struct{}
+	requireDocRule      struct{}
+	jsonLowerCamelRule  struct{}
+	noInterfaceRule     struct{}
+	returnsWrappingRule struct{}
+)
+
+func (handlerNameRule) Name() string {
+	return "handler-name"
+}
+
+func (handlerNameRule) Description() string {
+	return "handlers must be named as <Verb><Resource>Handler, such as GetUserHandler"
+}
+
+func (handlerNameRule) Check(api *spec.ApiSpec) []Issue {
+	var issues []Issue
+	for _, route := range api.Service.Routes() {
+		if validHandlerName(route.Handler) {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message: fmt.Sprintf("handler %s should be named as <Verb><Resource>Handler, verb is one of %s",
+				route.Handler, strings.Join(handlerVerbs, ", ")),
+			Anchor:   handlerAnchor(route),
+			Comments: routeComments(route),
+		})
+	}
+	return issues
+}
+
+func validHandlerName(handler string) bool {
+	if !strings.HasSuffix(handler, handlerSuffix) {
+		return false
+	}
+
+	name := strings.TrimSuffix(handler, handlerSuffix)
+	for _, verb := range handlerVerbs {
+		if !strings.HasPrefix(name, verb) {
+			continue
+		}
+
+		resource := strings.TrimPrefix(name, verb)
+		if len(resource) > 0 && resource[0] >= 'A' && resource[0] <= 'Z' {
+			return true
+		}
+	}
+	return false
+}
+
+func (kebabPathRule) Name() string {
+	return "kebab-path"
+}
+
+func (kebabPathRule) Description() string {
+	return "path segments must be kebab-case, such as /user-info/:id"
+}
+
+func (kebabPathRule) Check(api *spec.ApiSpec) []Issue {
+	var issues []Issue
+	for _, route := range api.Service.Routes() {
+		fixed := kebabPath(route.Path)
+		if fixed == route.Path {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message:  fmt.Sprintf("path %s should be kebab-case: %s", route.Path, fixed),
+			Anchor:   routeAnchor(route),
+			From:     route.Path,
+			To:       fixed,
+			Comments: routeComments(route),
+		})
+	}
+	return issues
+}
+
+func kebabPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if len(segment) == 0 || strings.HasPrefix(segment, ":") || kebabRegex.MatchString(segment) {
+			continue
+		}
+		segments[i] = strcase.ToKebab(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func (requireDocRule) Name() string {
+	return "require-doc"
+}
+
+func (requireDocRule) Description() string {
+	return "every route must have a @doc annotation"
+}
+
+func (requireDocRule) Check(api *spec.ApiSpec) []Issue {
+	var issues []Issue
+	for _, route := range api.Service.Routes() {
+		if len(route.AtDoc.Text) > 0 || len(route.AtDoc.Properties) > 0 {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message:  fmt.Sprintf("route %s has no @doc", routeAnchor(route)),
+			Anchor:   routeAnchor(route),
+			Comments: routeComments(route),
+		})
+	}
+	return issues
+}
+
+func (jsonLowerCamelRule) Name() string {
+	return "json-lower-camel"
+}
+
+func (jsonLowerCamelRule) Description() string {
+	return "json tag names must be lowerCamel, such as userName"
+}
+
+func (jsonLowerCamelRule) Check(api *spec.ApiSpec) []Issue {
+	var issues []Issue
+	eachMember(api, func(tp spec.DefineStruct, member spec.Member) {
+		tags, err := spec.Parse(member.Tag)
+		if err != nil {
+			return
+		}
+
+		tag, err := tags.Get(jsonTagKey)
+		if err != nil || tag.Name == "-" || lowerCamelRegex.MatchString(tag.Name) {
+			return
+		}
+
+		fixed := strcase.ToLowerCamel(tag.Name)
+		issues = append(issues, Issue{
+			Message: fmt.Sprintf("json tag %q of %s.%s should be lowerCamel: %q", tag.Name, tp.RawName,
+				member.Name, fixed),
+			Anchor:   member.Tag,
+			Scope:    tp.RawName,
+			From:     fmt.Sprintf(`%s:"%s`, jsonTagKey, tag.Name),
+			To:       fmt.Sprintf(`%s:"%s`, jsonTagKey, fixed),
+			Comments: memberComments(member),
+		})
+	})
+	return issues
+}
+
+func (noInterfaceRule) Name() string {
+	return "no-interface"
+}
+
+func (noInterfaceRule) Description() string {
+	return "members must not be interface{}, including map values and array elements"
+}
+
+func (noInterfaceRule) Check(api *spec.ApiSpec) []Issue {
+	var issues []Issue
+	eachMember(api, func(tp spec.DefineStruct, member spec.Member) {
+		if !containsInterface(member.Type) {
+			return
+		}
+
+		issues = append(issues, Issue{
+			Message:  fmt.Sprintf("member %s.%s should not be interface{}", tp.RawName, member.Name),
+			Anchor:   member.Tag,
+			Scope:    tp.RawName,
+			Comments: memberComments(member),
+		})
+	})
+	return issues
+}
+
+func containsInterface(tp spec.Type) bool {
+	switch v := tp.(type) {
+	case spec.InterfaceType:
+		return true
+	case spec.MapType:
+		return containsInterface(v.Value)
+	case spec.ArrayType:
+		return containsInterface(v.Value)
+	case spec.PointerType:
+		return containsInterface(v.Type)
+	}
+	return false
+}
+
+func (returnsWrappingRule) Name() string {
+	return "returns-wrapping"
+}
+
+func (returnsWrappingRule) Description() string {
+	return "responses must be wrapped in a struct instead of returning arrays, maps or basic types"
+}
+
+func (returnsWrappingRule) Check(api *spec.ApiSpec) []Issue {
+	var issues []Issue
+	for _, route := range api.Service.Routes() {
+		if route.ResponseType == nil {
+			continue
+		}
+		if _, ok := route.ResponseType.(spec.DefineStruct); ok {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message: fmt.Sprintf("route %s returns %s, it should be wrapped in a struct",
+				routeAnchor(route), route.ResponseType.Name()),
+			Anchor:   routeAnchor(route),
+			Comments: routeComments(route),
+		})
+	}
+	return issues
+}
+
+func eachMember(api *spec.ApiSpec, fn func(tp spec.DefineStruct, member spec.Member)) {
+	for _, tp := range api.Types {
+		ds, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		for _, member := range ds.Members {
+			if member.IsInline {
+				continue
+			}
+			fn(ds, member)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/rest"
+)
+
+var (
+	// VarStringAPI describes the api file.
+	VarStringAPI string
+	// VarStringHost describes the host of the mock server.
+	VarStringHost string
+	// VarIntPort describes the port of the mock server.
+	VarIntPort int
+	// VarInt64Seed describes the seed of the fake data.
+	VarInt64Seed int64
+	// VarStringExamples describes the directory of the example files.
+	VarStringExamples string
+)
+
+// MockCommand starts a mock server of the api file
+func MockCommand(_ *cobra.Command, _ []string) error {
+	apiFile := VarStringAPI
+	if apiFile == "" {
+		return errors.New("missing -api")
+	}
+
+	api, err := parser.Parse(apiFile)
+	if err != nil {
+		return err
+	}
+
+	if err := api.Validate(); err != nil {
+		return err
+	}
+
+	m := NewMock(api, WithSeed(VarInt64Seed), WithExamples(VarStringExamples))
+	public, secured, err := m.Routes()
+	if err != nil {
+		return err
+	}
+
+	server, err := rest.NewServer(rest.RestConf{
+		ServiceConf: service.ServiceConf{
+			Name: api.Service.Name,
+			Log:  logx.LogConf{Mode: "console", Encoding: "plain", Level: "info"},
+		},
+		Host:     VarStringHost,
+		Port:     VarIntPort,
+		MaxBytes: 1 << 20,
+		Timeout:  3000,
+	})
+	if err != nil {
+		return err
+	}
+	defer server.Stop()
+
+	server.AddRoutes(public)
+	if len(secured) > 0 {
+		server.AddRoutes(secured, rest.WithJwt(TestSecret))
+		token, err := Token(24 * time.Hour)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("jwt secret: %s\n", TestSecret)
+		fmt.Printf("token: %s\n", token)
+	}
+
+	fmt.Println(aurora.Green(fmt.Sprintf("Mock server of %s is listening on %s:%d",
+		api.Service.Name, VarStringHost, VarIntPort)))
+	server.Start()
+	return nil
+}
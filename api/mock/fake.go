@@ -0,0 +1,119 @@
+package mock
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+var words = []string{
+	"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel",
+	"india", "juliet", "kilo", "lima", "mike", "november", "oscar", "papa",
+}
+
+// Faker generates the fake values of the types, the same seed generates the same values
+type Faker struct {
+	rand    *rand.Rand
+	example *util.Example
+}
+
+// NewFaker returns a Faker of the types, the types are resolved by the names
+func NewFaker(seed int64, types map[string]spec.DefineStruct) *Faker {
+	f := &Faker{rand: rand.New(rand.NewSource(seed))}
+	f.example = &util.Example{
+		Types:     types,
+		Rule:      f.rule,
+		Primitive: f.primitive,
+		Items: func() int {
+			return f.rand.Intn(3) + 1
+		},
+	}
+	return f
+}
+
+// Field returns the fake value of the field, the default value, the options and the
+// range of the tag are honored.
+func (f *Faker) Field(field util.Field) interface{} {
+	return f.example.Field(field)
+}
+
+// Value returns the fake value of the type, the strings are guessed by the name.
+func (f *Faker) Value(tp spec.Type, name string) interface{} {
+	return f.example.Value(tp, name)
+}
+
+// rule returns the default value, one of the options or a number in the range of the tag
+func (f *Faker) rule(field util.Field, tp string) (interface{}, bool) {
+	rule := field.Rule()
+	switch {
+	case rule.HasDefault:
+		return util.PrimitiveValue(tp, rule.Default), true
+	case len(rule.Options) > 0:
+		return util.PrimitiveValue(tp, rule.Options[f.rand.Intn(len(rule.Options))]), true
+	case rule.Range != nil:
+		return f.number(tp, *rule.Range), true
+	default:
+		return nil, false
+	}
+}
+
+// primitive returns the fake value of the primitive type, the strings are guessed by the
+// name of the field.
+func (f *Faker) primitive(tp, name string) interface{} {
+	switch tp {
+	case "bool":
+		return f.rand.Intn(2) == 1
+	case "string":
+		lower := strings.ToLower(name)
+		switch {
+		case strings.Contains(lower, "email"):
+			return fmt.Sprintf("%s@example.com", words[f.rand.Intn(len(words))])
+		case strings.Contains(lower, "url"):
+			return fmt.Sprintf("https://example.com/%s", words[f.rand.Intn(len(words))])
+		default:
+			return fmt.Sprintf("%s-%d", words[f.rand.Intn(len(words))], f.rand.Intn(1000))
+		}
+	case "float32", "float64":
+		return f.number(tp, util.Range{Min: 0, Max: 1000})
+	default:
+		if isInteger(tp) {
+			return f.number(tp, util.Range{Min: 1, Max: 1000})
+		}
+		return nil
+	}
+}
+
+// number returns a number in the range, the integers are in the range of the type.
+func (f *Faker) number(tp string, r util.Range) interface{} {
+	if !isInteger(tp) {
+		// the rounding may hit the open bounds, fall back to the middle
+		v := math.Round((r.Min+f.rand.Float64()*(r.Max-r.Min))*100) / 100
+		if !r.Contains(v) {
+			return (r.Min + r.Max) / 2
+		}
+		return v
+	}
+
+	low, high := math.Ceil(r.Min), math.Floor(r.Max)
+	if r.LeftOpen && low == r.Min {
+		low++
+	}
+	if r.RightOpen && high == r.Max {
+		high--
+	}
+	if tp == "int8" || tp == "uint8" || tp == "byte" {
+		high = math.Min(high, 127)
+	}
+	if high < low {
+		return int64(low)
+	}
+	return int64(low) + f.rand.Int63n(int64(high-low)+1)
+}
+
+func isInteger(tp string) bool {
+	return strings.HasPrefix(tp, "int") || strings.HasPrefix(tp, "uint") || tp == "byte" || tp == "rune"
+}
@@ -0,0 +1,205 @@
+package mock
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/handler"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/rest/router"
+)
+
+const (
+	// TestSecret is the fixed secret of the jwt groups, the tokens signed with it are accepted.
+	TestSecret = "goctl-mock-secret"
+
+	authKey  = "jwt"
+	groupKey = "group"
+)
+
+type (
+	// Option customizes the Mock.
+	Option func(m *Mock)
+
+	// Mock serves the routes of the api with the fake responses.
+	Mock struct {
+		api      *spec.ApiSpec
+		types    map[string]spec.DefineStruct
+		seed     int64
+		examples string
+	}
+)
+
+// NewMock returns a Mock of the api.
+func NewMock(api *spec.ApiSpec, opts ...Option) *Mock {
+	m := &Mock{
+		api:   api,
+		types: util.DefinedStructs(api),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithSeed sets the seed of the fake data, the same seed generates the same responses.
+func WithSeed(seed int64) Option {
+	return func(m *Mock) {
+		m.seed = seed
+	}
+}
+
+// WithExamples sets the directory of the example files, the <group>/<handler>.json in it is
+// responded instead of the fake data, the handlers out of the groups are <handler>.json.
+func WithExamples(dir string) Option {
+	return func(m *Mock) {
+		m.examples = dir
+	}
+}
+
+// Routes returns the mock routes of the public groups and the jwt groups.
+func (m *Mock) Routes() (public, secured []rest.Route, err error) {
+	service := m.api.Service.JoinPrefix()
+	for _, g := range service.Groups {
+		for _, r := range g.Routes {
+			h, err := m.handler(g, r)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			route := rest.Route{
+				Method:  strings.ToUpper(r.Method),
+				Path:    r.Path,
+				Handler: h,
+			}
+			if len(g.GetAnnotation(authKey)) > 0 {
+				secured = append(secured, route)
+			} else {
+				public = append(public, route)
+			}
+		}
+	}
+
+	return public, secured, nil
+}
+
+// Handler returns the http handler of the routes, the jwt groups are authorized by
+// the TestSecret.
+func (m *Mock) Handler() (http.Handler, error) {
+	public, secured, err := m.Routes()
+	if err != nil {
+		return nil, err
+	}
+
+	rt := router.NewRouter()
+	authorize := handler.Authorize(TestSecret)
+	for _, r := range public {
+		if err := rt.Handle(r.Method, r.Path, r.Handler); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range secured {
+		if err := rt.Handle(r.Method, r.Path, authorize(r.Handler)); err != nil {
+			return nil, err
+		}
+	}
+
+	return rt, nil
+}
+
+// Token returns a token signed by the TestSecret for the jwt groups.
+func Token(expire time.Duration) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat": now.Unix(),
+		"exp": now.Add(expire).Unix(),
+	})
+	return token.SignedString([]byte(TestSecret))
+}
+
+func (m *Mock) handler(g spec.Group, r spec.Route) (http.HandlerFunc, error) {
+	var request *spec.DefineStruct
+	if r.RequestType != nil {
+		ds, ok := r.RequestType.(spec.DefineStruct)
+		if !ok {
+			return nil, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+				r.RequestType.Name())
+		}
+		if declared, ok := m.types[ds.RawName]; ok {
+			ds = declared
+		}
+		request = &ds
+	}
+
+	// the seed of each route is fixed, so the responses don't depend on the order of the requests
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(r.Method + " " + r.Path))
+	seed := m.seed + int64(h.Sum64())
+	v := validator{types: m.types}
+	example := exampleFile(g, r)
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		if request != nil {
+			if err := v.request(*request, req); err != nil {
+				httpx.Error(w, err)
+				return
+			}
+		}
+
+		if data, ok, err := m.example(example); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		} else if ok {
+			w.Header().Set(httpx.ContentType, httpx.JsonContentType)
+			_, _ = w.Write(data)
+			return
+		}
+
+		if r.ResponseType == nil {
+			httpx.Ok(w)
+			return
+		}
+		httpx.OkJson(w, NewFaker(seed, m.types).Value(r.ResponseType, ""))
+	}, nil
+}
+
+// exampleFile returns the relative path of the example file of the route, the handlers are
+// grouped by the group annotation of the route or the group like the generated handlers.
+func exampleFile(g spec.Group, r spec.Route) string {
+	if len(r.Handler) == 0 {
+		return ""
+	}
+
+	folder := r.GetAnnotation(groupKey)
+	if len(folder) == 0 {
+		folder = g.GetAnnotation(groupKey)
+	}
+	return filepath.Join(folder, r.Handler+".json")
+}
+
+// example returns the content of the example file, it's read on each request, so the examples
+// can be edited without restarting.
+func (m *Mock) example(file string) ([]byte, bool, error) {
+	if len(m.examples) == 0 || len(file) == 0 {
+		return nil, false, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(m.examples, file))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
@@ -0,0 +1,166 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+func newHandler(t *testing.T, opts ...Option) (http.Handler, *spec.ApiSpec) {
+	api, err := parser.Parse("testdata/example.api")
+	assert.Nil(t, err)
+
+	h, err := NewMock(api, opts...).Handler()
+	assert.Nil(t, err)
+	return h, api
+}
+
+func serve(h http.Handler, method, target, body string, header map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestMockResponses(t *testing.T) {
+	h, api := newHandler(t, WithSeed(1))
+	token, err := Token(time.Hour)
+	assert.Nil(t, err)
+	auth := map[string]string{"Authorization": "Bearer " + token, "X-Tenant": "goctl"}
+
+	assert.Equal(t, http.StatusOK, serve(h, http.MethodGet, "/v1/ping", "", nil).Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(h, http.MethodGet, "/v1/users/1", "", nil).Code)
+
+	w := serve(h, http.MethodGet, "/v1/users/1", "", auth)
+	assert.Equal(t, http.StatusOK, w.Code)
+	// the same seed generates the same responses
+	assert.Equal(t, w.Body.String(), serve(h, http.MethodGet, "/v1/users/1", "", auth).Body.String())
+
+	// the fake data is valid against the response type
+	var user interface{}
+	decoder := json.NewDecoder(w.Body)
+	decoder.UseNumber()
+	assert.Nil(t, decoder.Decode(&user))
+	types := validator{types: NewMock(api).types}
+	assert.Nil(t, types.value(types.types["User"], user, ""))
+	values := user.(map[string]interface{})
+	assert.Equal(t, true, values["active"])
+	assert.Contains(t, []string{"admin", "member"}, values["role"])
+	assert.True(t, strings.HasSuffix(values["email"].(string), "@example.com"))
+	age, err := values["age"].(json.Number).Int64()
+	assert.Nil(t, err)
+	assert.True(t, age >= 18 && age <= 60)
+
+	w = serve(h, http.MethodGet, "/v1/users?page=2", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Body.String(), "["))
+
+	other, _ := newHandler(t, WithSeed(2))
+	assert.NotEqual(t, serve(h, http.MethodGet, "/v1/users", "", nil).Body.String(),
+		serve(other, http.MethodGet, "/v1/users", "", nil).Body.String())
+}
+
+func TestMockValidation(t *testing.T) {
+	h, _ := newHandler(t)
+	token, err := Token(time.Hour)
+	assert.Nil(t, err)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+	tenant := map[string]string{"Authorization": "Bearer " + token, "X-Tenant": "goctl"}
+
+	valid := `{"id":1,"name":"kevin","email":"kevin@example.com","age":20,"role":"admin","tags":[]}`
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		header map[string]string
+		code   int
+		err    string
+	}{
+		{name: "valid", method: http.MethodPost, target: "/v1/users?notify=true", body: valid, code: http.StatusOK},
+		{name: "missing form", method: http.MethodPost, target: "/v1/users", body: valid,
+			code: http.StatusBadRequest, err: `form field "notify" is not set`},
+		{name: "missing field", method: http.MethodPost, target: "/v1/users?notify=true", body: `{"id":1}`,
+			code: http.StatusBadRequest, err: `field "name" is not set`},
+		{name: "wrong type", method: http.MethodPost, target: "/v1/users?notify=true",
+			body: strings.Replace(valid, `"id":1`, `"id":"1"`, 1), code: http.StatusBadRequest,
+			err: `field "id" is not a valid int64`},
+		{name: "not in options", method: http.MethodPost, target: "/v1/users?notify=true",
+			body: strings.Replace(valid, `"admin"`, `"root"`, 1), code: http.StatusBadRequest,
+			err: `field "role" must be one of admin|member`},
+		{name: "out of range", method: http.MethodPost, target: "/v1/users?notify=true",
+			body: strings.Replace(valid, `"age":20`, `"age":70`, 1), code: http.StatusBadRequest,
+			err: `field "age" must be in range [18:60]`},
+		{name: "nested", method: http.MethodPost, target: "/v1/users?notify=true",
+			body: strings.Replace(valid, `"tags":[]`, `"tags":[1]`, 1), code: http.StatusBadRequest,
+			err: `field "tags[0]" is not a valid string`},
+		{name: "invalid path", method: http.MethodGet, target: "/v1/users/abc", header: tenant,
+			code: http.StatusBadRequest, err: `path field "id" is not a valid int64`},
+		{name: "missing header", method: http.MethodGet, target: "/v1/users/1", header: auth,
+			code: http.StatusBadRequest, err: `header field "X-Tenant" is not set`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(h, test.method, test.target, test.body, test.header)
+			assert.Equal(t, test.code, w.Code)
+			if len(test.err) > 0 {
+				assert.Equal(t, test.err, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
+
+func TestMockExamples(t *testing.T) {
+	dir := t.TempDir()
+	example := `{"id":42,"name":"example"}`
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "ListUsersHandler.json"), []byte(example), 0o644))
+
+	h, _ := newHandler(t, WithExamples(dir))
+	w := serve(h, http.MethodGet, "/v1/users", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, example, w.Body.String())
+	assert.NotEqual(t, example, serve(h, http.MethodPost, "/v1/users?notify=1",
+		`{"id":1,"name":"a","email":"a","age":18,"role":"admin","tags":[]}`, nil).Body.String())
+
+	// the handlers of the same name in the groups don't share the example
+	assert.NotEqual(t, example, serve(h, http.MethodGet, "/v1/admin/users", "", nil).Body.String())
+	admin := `[{"id":7,"name":"admin"}]`
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "admin"), 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "admin", "ListUsersHandler.json"), []byte(admin), 0o644))
+	assert.Equal(t, admin, serve(h, http.MethodGet, "/v1/admin/users", "", nil).Body.String())
+	assert.Equal(t, example, serve(h, http.MethodGet, "/v1/users", "", nil).Body.String())
+}
+
+func TestCheckRuleOpenRange(t *testing.T) {
+	field := util.Field{
+		Member:   spec.Member{Tag: "`" + `form:"ratio,range=(0:1]"` + "`"},
+		Location: util.FormLocation,
+	}
+	rule := field.Rule()
+	assert.NotNil(t, rule.Range)
+	assert.Nil(t, checkRule(rule, "ratio", 1))
+	assert.Nil(t, checkRule(rule, "ratio", 0.5))
+	assert.Equal(t, `field "ratio" must be in range (0:1]`, checkRule(rule, "ratio", 0).Error())
+	assert.NotNil(t, checkRule(rule, "ratio", 1.5))
+
+	// the fake values never hit the open bounds
+	faker := NewFaker(1, nil)
+	for i := 0; i < 100; i++ {
+		v := faker.number("int", util.Range{Min: 0, Max: 2, LeftOpen: true, RightOpen: true})
+		assert.Equal(t, int64(1), v)
+	}
+}
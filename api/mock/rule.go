@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+// checkRule returns an error if the value is not one of the options or out of the range
+func checkRule(r util.Rule, name string, value interface{}) error {
+	if len(r.Options) > 0 {
+		s := fmt.Sprint(value)
+		for _, each := range r.Options {
+			if each == s {
+				return nil
+			}
+		}
+		return fmt.Errorf("field %q must be one of %s", name, strings.Join(r.Options, "|"))
+	}
+
+	if r.Range != nil {
+		v, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+		if err == nil && !r.Range.Contains(v) {
+			return fmt.Errorf("field %q must be in range %s", name, formatRange(*r.Range))
+		}
+	}
+
+	return nil
+}
+
+func formatRange(r util.Range) string {
+	left, right := "[", "]"
+	if r.LeftOpen {
+		left = "("
+	}
+	if r.RightOpen {
+		right = ")"
+	}
+	return fmt.Sprintf("%s%v:%v%s", left, r.Min, r.Max, right)
+}
@@ -0,0 +1,208 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+)
+
+// validator validates the requests against the request types
+type validator struct {
+	types map[string]spec.DefineStruct
+}
+
+// request validates the path, form, header and json fields of the request
+func (v validator) request(ds spec.DefineStruct, r *http.Request) error {
+	fields := util.Fields(ds, v.types)
+	vars := pathvar.Vars(r)
+	hasBody := false
+	for _, field := range fields {
+		var (
+			value string
+			ok    bool
+		)
+		switch field.Location {
+		case util.JSONLocation:
+			hasBody = true
+			continue
+		case util.PathLocation:
+			value, ok = vars[field.WireName]
+		case util.FormLocation:
+			if err := r.ParseForm(); err != nil {
+				return err
+			}
+			_, ok = r.Form[field.WireName]
+			value = r.Form.Get(field.WireName)
+		case util.HeaderLocation:
+			value = r.Header.Get(field.WireName)
+			ok = len(value) > 0
+		default:
+			continue
+		}
+
+		if err := v.param(field, value, ok); err != nil {
+			return err
+		}
+	}
+
+	if !hasBody {
+		return nil
+	}
+
+	var body interface{}
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		if errors.Is(err, io.EOF) {
+			body = map[string]interface{}{}
+		} else {
+			return fmt.Errorf("invalid json body: %w", err)
+		}
+	}
+
+	return v.value(ds, body, "")
+}
+
+func (v validator) param(field util.Field, value string, ok bool) error {
+	if !ok {
+		if field.Optional || field.Rule().HasDefault {
+			return nil
+		}
+		return fmt.Errorf("%s field %q is not set", field.Location, field.WireName)
+	}
+
+	tp, isPrimitive := util.UnwrapPointer(field.Type).(spec.PrimitiveType)
+	if isPrimitive && !validPrimitive(tp.RawName, value) {
+		return fmt.Errorf("%s field %q is not a valid %s", field.Location, field.WireName, tp.RawName)
+	}
+
+	return checkRule(field.Rule(), field.WireName, value)
+}
+
+// value validates the decoded json value against the type, name is the path of the value
+// like user.tags[0].
+func (v validator) value(tp spec.Type, value interface{}, name string) error {
+	if value == nil {
+		switch tp.(type) {
+		case spec.PointerType, spec.ArrayType, spec.MapType, spec.InterfaceType:
+			return nil
+		default:
+			return fmt.Errorf("field %q must not be null", name)
+		}
+	}
+
+	switch t := tp.(type) {
+	case spec.PrimitiveType:
+		if !validJSONPrimitive(t.RawName, value) {
+			return fmt.Errorf("field %q is not a valid %s", name, t.RawName)
+		}
+	case spec.PointerType:
+		return v.value(util.UnwrapPointer(t), value, name)
+	case spec.ArrayType:
+		values, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("field %q is not an array", name)
+		}
+		for i, each := range values {
+			if err := v.value(t.Value, each, fmt.Sprintf("%s[%d]", name, i)); err != nil {
+				return err
+			}
+		}
+	case spec.MapType:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field %q is not an object", name)
+		}
+		for key, each := range values {
+			if err := v.value(t.Value, each, join(name, key)); err != nil {
+				return err
+			}
+		}
+	case spec.DefineStruct:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			if len(name) == 0 {
+				return errors.New("json body is not an object")
+			}
+			return fmt.Errorf("field %q is not an object", name)
+		}
+		if declared, ok := v.types[t.RawName]; ok {
+			t = declared
+		}
+		return v.object(t, values, name)
+	}
+
+	return nil
+}
+
+func (v validator) object(ds spec.DefineStruct, values map[string]interface{}, name string) error {
+	for _, field := range util.Fields(ds, v.types) {
+		if field.Location != util.JSONLocation {
+			continue
+		}
+
+		key := join(name, field.WireName)
+		value, ok := values[field.WireName]
+		if !ok {
+			if field.Optional || field.Rule().HasDefault {
+				continue
+			}
+			return fmt.Errorf("field %q is not set", key)
+		}
+
+		if err := v.value(field.Type, value, key); err != nil {
+			return err
+		}
+		if _, ok := util.UnwrapPointer(field.Type).(spec.PrimitiveType); ok && value != nil {
+			if err := checkRule(field.Rule(), key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validJSONPrimitive(tp string, value interface{}) bool {
+	switch tp {
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	default:
+		number, ok := value.(json.Number)
+		return ok && validPrimitive(tp, number.String())
+	}
+}
+
+func validPrimitive(tp, value string) bool {
+	var err error
+	switch tp {
+	case "string":
+	case "bool":
+		_, err = strconv.ParseBool(value)
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(value, 64)
+	case "int", "int8", "int16", "int32", "int64", "rune":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		_, err = strconv.ParseUint(value, 10, 64)
+	}
+	return err == nil
+}
+
+func join(name, key string) string {
+	if len(name) == 0 {
+		return key
+	}
+	return name + "." + key
+}
@@ -84,6 +84,6 @@ func CreateServiceCommand(args []string) error {
 		return err
 	}
 
-	err = gogen.DoGenProject(apiFilePath, abs, VarStringStyle, VarStringMiddleware)
+	err = gogen.DoGenProject(apiFilePath, abs, VarStringStyle, VarStringMiddleware, false)
 	return err
 }
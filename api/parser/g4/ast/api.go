@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/yeyudekuangxiang/goctl/api/parser/g4/gen/api"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
 )
 
 const (
@@ -51,7 +52,7 @@ func (v *ApiVisitor) VisitApi(ctx *api.ApiContext) interface{} {
 func (v *ApiVisitor) acceptService(root, final *Api) {
 	for _, service := range root.Service {
 		if _, ok := final.serviceM[service.ServiceApi.Name.Text()]; !ok && len(final.serviceM) > 0 {
-			v.panic(service.ServiceApi.Name, "multiple service declaration")
+			v.report(service.ServiceApi.Name, spec.CodeMultipleService, "multiple service declaration")
 		}
 		v.duplicateServerItemCheck(service)
 
@@ -69,7 +70,7 @@ func (v *ApiVisitor) acceptService(root, final *Api) {
 		for _, route := range service.ServiceApi.ServiceRoute {
 			uniqueRoute := fmt.Sprintf("%s %s", route.Route.Method.Text(), path.Join(prefix, route.Route.Path.Text()))
 			if _, ok := final.routeM[uniqueRoute]; ok {
-				v.panic(route.Route.Method, fmt.Sprintf("duplicate route '%s'", uniqueRoute))
+				v.report(route.Route.Method, spec.CodeDuplicateRoute, fmt.Sprintf("duplicate route '%s'", uniqueRoute))
 			}
 
 			final.routeM[uniqueRoute] = Holder
@@ -78,7 +79,7 @@ func (v *ApiVisitor) acceptService(root, final *Api) {
 				atServerM := map[string]PlaceHolder{}
 				for _, kv := range route.AtServer.Kv {
 					if _, ok := atServerM[kv.Key.Text()]; ok {
-						v.panic(kv.Key, fmt.Sprintf("duplicate key '%s'", kv.Key.Text()))
+						v.report(kv.Key, spec.CodeDuplicateKey, fmt.Sprintf("duplicate key '%s'", kv.Key.Text()))
 					}
 					atServerM[kv.Key.Text()] = Holder
 					if kv.Key.Text() == "handler" {
@@ -92,11 +93,13 @@ func (v *ApiVisitor) acceptService(root, final *Api) {
 			}
 
 			if handlerExpr == nil {
-				v.panic(route.Route.Method, "mismatched handler")
+				v.report(route.Route.Method, spec.CodeMissingHandler, "mismatched handler")
+				continue
 			}
 
 			if handlerExpr.Text() == "" {
-				v.panic(handlerExpr, "mismatched handler")
+				v.report(handlerExpr, spec.CodeMissingHandler, "mismatched handler")
+				continue
 			}
 
 			handlerKey := handlerExpr.Text()
@@ -104,7 +107,7 @@ func (v *ApiVisitor) acceptService(root, final *Api) {
 				handlerKey = fmt.Sprintf("%s/%s", group, handlerExpr.Text())
 			}
 			if _, ok := final.handlerM[handlerKey]; ok {
-				v.panic(handlerExpr, fmt.Sprintf("duplicate handler '%s'", handlerExpr.Text()))
+				v.report(handlerExpr, spec.CodeDuplicateHandler, fmt.Sprintf("duplicate handler '%s'", handlerExpr.Text()))
 			}
 			final.handlerM[handlerKey] = Holder
 		}
@@ -117,7 +120,7 @@ func (v *ApiVisitor) duplicateServerItemCheck(service *Service) {
 		atServerM := map[string]PlaceHolder{}
 		for _, kv := range service.AtServer.Kv {
 			if _, ok := atServerM[kv.Key.Text()]; ok {
-				v.panic(kv.Key, fmt.Sprintf("duplicate key '%s'", kv.Key.Text()))
+				v.report(kv.Key, spec.CodeDuplicateKey, fmt.Sprintf("duplicate key '%s'", kv.Key.Text()))
 			}
 
 			atServerM[kv.Key.Text()] = Holder
@@ -128,7 +131,7 @@ func (v *ApiVisitor) duplicateServerItemCheck(service *Service) {
 func (v *ApiVisitor) acceptType(root, final *Api) {
 	for _, tp := range root.Type {
 		if _, ok := final.typeM[tp.NameExpr().Text()]; ok {
-			v.panic(tp.NameExpr(), fmt.Sprintf("duplicate type '%s'", tp.NameExpr().Text()))
+			v.report(tp.NameExpr(), spec.CodeDuplicateType, fmt.Sprintf("duplicate type '%s'", tp.NameExpr().Text()))
 		}
 
 		final.typeM[tp.NameExpr().Text()] = Holder
@@ -140,12 +143,12 @@ func (v *ApiVisitor) acceptInfo(root, final *Api) {
 	if root.Info != nil {
 		infoM := map[string]PlaceHolder{}
 		if final.Info != nil {
-			v.panic(root.Info.Info, "multiple info declaration")
+			v.report(root.Info.Info, spec.CodeSyntax, "multiple info declaration")
 		}
 
 		for _, value := range root.Info.Kvs {
 			if _, ok := infoM[value.Key.Text()]; ok {
-				v.panic(value.Key, fmt.Sprintf("duplicate key '%s'", value.Key.Text()))
+				v.report(value.Key, spec.CodeDuplicateKey, fmt.Sprintf("duplicate key '%s'", value.Key.Text()))
 			}
 			infoM[value.Key.Text()] = Holder
 		}
@@ -157,7 +160,7 @@ func (v *ApiVisitor) acceptInfo(root, final *Api) {
 func (v *ApiVisitor) acceptImport(root, final *Api) {
 	for _, imp := range root.Import {
 		if _, ok := final.importM[imp.Value.Text()]; ok {
-			v.panic(imp.Import, fmt.Sprintf("duplicate import '%s'", imp.Value.Text()))
+			v.report(imp.Import, spec.CodeImport, fmt.Sprintf("duplicate import '%s'", imp.Value.Text()))
 		}
 
 		final.importM[imp.Value.Text()] = Holder
@@ -168,7 +171,7 @@ func (v *ApiVisitor) acceptImport(root, final *Api) {
 func (v *ApiVisitor) acceptSyntax(root, final *Api) {
 	if root.Syntax != nil {
 		if final.Syntax != nil {
-			v.panic(root.Syntax.Syntax, "multiple syntax declaration")
+			v.report(root.Syntax.Syntax, spec.CodeSyntax, "multiple syntax declaration")
 		}
 
 		final.Syntax = root.Syntax
@@ -9,6 +9,7 @@ import (
 
 	"github.com/zeromicro/antlr"
 	"github.com/yeyudekuangxiang/goctl/api/parser/g4/gen/api"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
 	"github.com/yeyudekuangxiang/goctl/util/console"
 )
 
@@ -27,6 +28,10 @@ type (
 		fileMap                  map[string]PlaceHolder
 		importStatck             importStack
 		syntax                   *SyntaxExpr
+		// collect makes the parser record errors into diagnostics instead of
+		// failing on the first one, see Diagnose
+		collect     bool
+		diagnostics spec.Diagnostics
 	}
 
 	// ParserOption defines an function with argument Parser
@@ -223,6 +228,9 @@ func (p *Parser) invoke(linePrefix, content string) (v *Api, err error) {
 	if p.debug {
 		visitorOptions = append(visitorOptions, WithVisitorDebug())
 	}
+	if p.collect {
+		visitorOptions = append(visitorOptions, WithVisitorDiagnostics(&p.diagnostics))
+	}
 
 	visitor := NewApiVisitor(visitorOptions...)
 	v = apiParser.Api().Accept(visitor).(*Api)
@@ -506,8 +514,14 @@ func (p *Parser) readContent(filename string) (string, error) {
 	return string(data), nil
 }
 
-// SyntaxError accepts errors and panic it
+// SyntaxError accepts errors and panic it, the error is recorded as a diagnostic
+// instead if the parser is diagnosing
 func (p *Parser) SyntaxError(_ antlr.Recognizer, _ interface{}, line, column int, msg string, _ antlr.RecognitionException) {
+	if p.collect {
+		p.diagnostics = append(p.diagnostics, spec.NewDiagnostic(p.linePrefix, line, column, spec.CodeSyntax, "%s", msg))
+		return
+	}
+
 	str := fmt.Sprintf(`%s line %d:%d  %s`, p.linePrefix, line, column, msg)
 	if p.debug {
 		p.log.Error(str)
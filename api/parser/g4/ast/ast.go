@@ -7,6 +7,7 @@ import (
 
 	"github.com/zeromicro/antlr"
 	"github.com/yeyudekuangxiang/goctl/api/parser/g4/gen/api"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
 	"github.com/yeyudekuangxiang/goctl/util/console"
 )
 
@@ -26,6 +27,8 @@ type (
 		log      console.Console
 		prefix   string
 		infoFlag bool
+		// diagnostics collects the recoverable errors instead of panicking if not nil
+		diagnostics *spec.Diagnostics
 	}
 
 	// VisitorOption defines a function with argument ApiVisitor
@@ -73,6 +76,16 @@ func (v *ApiVisitor) panic(expr Expr, msg string) {
 	panic(errString)
 }
 
+// report records a recoverable error if the visitor collects diagnostics, otherwise
+// it panics like panic.
+func (v *ApiVisitor) report(expr Expr, code, msg string) {
+	if v.diagnostics == nil {
+		v.panic(expr, msg)
+	}
+
+	*v.diagnostics = append(*v.diagnostics, spec.NewDiagnostic(v.prefix, expr.Line(), expr.Column(), code, "%s", msg))
+}
+
 // WithVisitorPrefix returns a VisitorOption wrap with specified prefix
 func WithVisitorPrefix(prefix string) VisitorOption {
 	return func(v *ApiVisitor) {
@@ -80,6 +93,14 @@ func WithVisitorPrefix(prefix string) VisitorOption {
 	}
 }
 
+// WithVisitorDiagnostics returns a VisitorOption which makes the visitor collect
+// recoverable errors into diagnostics instead of panicking
+func WithVisitorDiagnostics(diagnostics *spec.Diagnostics) VisitorOption {
+	return func(v *ApiVisitor) {
+		v.diagnostics = diagnostics
+	}
+}
+
 // WithVisitorDebug returns a debug VisitorOption
 func WithVisitorDebug() VisitorOption {
 	return func(v *ApiVisitor) {
@@ -0,0 +1,266 @@
+package ast
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/parser/g4/gen/api"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+// panicRegex matches the message of ApiVisitor.panic, such as: foo.api line 1:2  msg
+var panicRegex = regexp.MustCompile(`^(.*?)\s*line (\d+):(\d+)\s+(.*)$`)
+
+// Diagnose parses the api from the specified file name like Parse, but it doesn't stop at
+// the first error, all the syntax and semantic errors of the file and its imports are returned.
+// The returned Api is nil if the main file has any syntax error.
+func (p *Parser) Diagnose(filename string) (*Api, spec.Diagnostics) {
+	p.collect = true
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, spec.Diagnostics{spec.NewDiagnostic(filename, 0, 0, spec.CodeImport, "%v", err)}
+	}
+
+	p.src = abs
+	data, err := p.readContent(filename)
+	if err != nil {
+		return nil, spec.Diagnostics{spec.NewDiagnostic(filename, 0, 0, spec.CodeImport, "%v", err)}
+	}
+
+	p.importStatck.push(p.src)
+	root := p.diagnoseInvoke(filename, data)
+	if root == nil {
+		return nil, p.result()
+	}
+
+	p.syntax = root.Syntax
+	apiAstList := append([]*Api{root}, p.diagnoseImportedApi(root.Import)...)
+	p.diagnoseDeclaration(apiAstList)
+	return p.memberFill(apiAstList), p.result()
+}
+
+func (p *Parser) diagnoseImportedApi(imports []*ImportExpr) []*Api {
+	var apiAstList []*Api
+	for _, imp := range imports {
+		impPath := strings.ReplaceAll(imp.Value.Text(), "\"", "")
+		if !filepath.IsAbs(impPath) {
+			impPath = filepath.Join(filepath.Dir(p.src), impPath)
+		}
+		if err := p.importStatck.push(impPath); err != nil {
+			p.addDiagnostic(imp.Value, spec.CodeImport, "%v: %s", err, imp.Value.Text())
+			continue
+		}
+		if p.alreadyImported(impPath) {
+			p.importStatck.pop()
+			continue
+		}
+		p.fileMap[impPath] = PlaceHolder{}
+
+		data, err := p.readContent(impPath)
+		if err != nil {
+			p.addDiagnostic(imp.Value, spec.CodeImport, "%v", err)
+			p.importStatck.pop()
+			continue
+		}
+
+		nestedApi := p.diagnoseInvoke(impPath, data)
+		if nestedApi == nil {
+			p.importStatck.pop()
+			continue
+		}
+
+		if p.syntax != nil && nestedApi.Syntax != nil &&
+			p.syntax.Version.Text() != nestedApi.Syntax.Version.Text() {
+			p.addDiagnostic(nestedApi.Syntax.Syntax, spec.CodeSyntax,
+				"multiple syntax declaration, expecting syntax '%s', but found '%s'",
+				p.syntax.Version.Text(), nestedApi.Syntax.Version.Text())
+		}
+
+		apiAstList = append(apiAstList, nestedApi)
+		apiAstList = append(apiAstList, p.diagnoseImportedApi(nestedApi.Import)...)
+		p.importStatck.pop()
+	}
+
+	return apiAstList
+}
+
+// diagnoseInvoke parses the content, it returns nil if the content has any syntax error.
+func (p *Parser) diagnoseInvoke(linePrefix, content string) *Api {
+	count := len(p.diagnostics)
+	v, err := p.invoke(linePrefix, content)
+	for _, each := range p.diagnostics[count:] {
+		// the tree is broken after a syntax error, the error from the visitor is meaningless.
+		if each.Code == spec.CodeSyntax {
+			return nil
+		}
+	}
+	if err != nil {
+		p.diagnostics = append(p.diagnostics, panicToDiagnostic(p.linePrefix, err))
+		return nil
+	}
+
+	return v
+}
+
+// diagnoseDeclaration checks the semantics across all the api files
+func (p *Parser) diagnoseDeclaration(apiList []*Api) {
+	types := make(map[string]TypeExpr)
+	for _, root := range apiList {
+		for _, each := range root.Type {
+			name := each.NameExpr()
+			if _, ok := types[name.Text()]; ok {
+				p.addDiagnostic(name, spec.CodeDuplicateType, "duplicate type declaration '%s'", name.Text())
+				continue
+			}
+			types[name.Text()] = each
+		}
+	}
+
+	handlers := make(map[string]PlaceHolder)
+	routes := make(map[string]PlaceHolder)
+	for _, root := range apiList {
+		for _, each := range root.Type {
+			tp, ok := each.(*TypeStruct)
+			if !ok {
+				continue
+			}
+
+			for _, field := range tp.Fields {
+				if !p.skipCheckTypeDeclaration {
+					p.diagnoseType(types, field.DataType)
+				}
+				p.diagnoseTag(field)
+			}
+		}
+
+		for _, service := range root.Service {
+			var prefix, group string
+			if service.AtServer != nil {
+				if expr := service.AtServer.Kv.Get(prefixKey); expr != nil {
+					prefix = expr.Text()
+					if err := spec.ValidatePrefix(prefix); err != nil {
+						p.addDiagnostic(expr, spec.CodeInvalidPrefix, "invalid prefix '%s': %v", prefix, err)
+					}
+				}
+				if expr := service.AtServer.Kv.Get(groupKey); expr != nil {
+					group = expr.Text()
+				}
+			}
+
+			for _, each := range service.ServiceApi.ServiceRoute {
+				route := each.Route
+				if !p.skipCheckTypeDeclaration {
+					if route.Req != nil && route.Req.Name.IsNotNil() {
+						p.diagnoseType(types, route.Req.Name)
+					}
+					if route.Reply != nil && route.Reply.Name.IsNotNil() {
+						p.diagnoseType(types, route.Reply.Name)
+					}
+				}
+
+				var handler Expr
+				if each.AtHandler != nil || each.AtServer != nil {
+					handler = each.GetHandler()
+				}
+				if handler == nil {
+					p.addDiagnostic(route.Method, spec.CodeMissingHandler, "missing handler annotation for '%s'",
+						route.Path.Text())
+					continue
+				}
+				if err := spec.ValidateHandler(handler.Text()); err != nil {
+					p.addDiagnostic(handler, spec.CodeInvalidHandler, "handler '%s' invalid: %v", handler.Text(), err)
+				}
+
+				handlerKey := spec.HandlerKey(group, handler.Text())
+				if _, ok := handlers[handlerKey]; ok {
+					p.addDiagnostic(handler, spec.CodeDuplicateHandler, "duplicate handler '%s'", handlerKey)
+				}
+				handlers[handlerKey] = Holder
+
+				routeKey := spec.RouteKey(route.Method.Text(), prefix, route.Path.Text())
+				if _, ok := routes[routeKey]; ok {
+					p.addDiagnostic(route.Method, spec.CodeDuplicateRoute, "duplicate route '%s'", routeKey)
+				}
+				routes[routeKey] = Holder
+			}
+		}
+	}
+}
+
+func (p *Parser) diagnoseType(types map[string]TypeExpr, expr DataType) {
+	var name Expr
+	switch v := expr.(type) {
+	case *Literal:
+		name = v.Literal
+	case *Pointer:
+		name = v.Name
+	case *Map:
+		p.diagnoseType(types, v.Value)
+	case *Array:
+		p.diagnoseType(types, v.Literal)
+	}
+	if name == nil || api.IsBasicType(name.Text()) {
+		return
+	}
+
+	if _, ok := types[name.Text()]; !ok {
+		p.addDiagnostic(name, spec.CodeUndefinedType, "can not found declaration '%s' in context", name.Text())
+	}
+}
+
+func (p *Parser) diagnoseTag(field *TypeField) {
+	if field.IsAnonymous {
+		return
+	}
+
+	if field.Tag == nil {
+		p.addDiagnostic(field.Name, spec.CodeInvalidTag, "field %s has no tag", field.Name.Text())
+		return
+	}
+
+	if err := spec.ValidateTag(field.Tag.Text()); err != nil {
+		p.addDiagnostic(field.Tag, spec.CodeInvalidTag, "invalid tag %s: %v", field.Tag.Text(), err)
+	}
+}
+
+func (p *Parser) addDiagnostic(expr Expr, code, format string, args ...interface{}) {
+	p.diagnostics = append(p.diagnostics, spec.NewDiagnostic(expr.Prefix(), expr.Line(), expr.Column(),
+		code, format, args...))
+}
+
+// result removes the diagnostics reported twice, such as duplicate routes found
+// by both the visitor and diagnoseDeclaration, and sorts them by position.
+func (p *Parser) result() spec.Diagnostics {
+	var result spec.Diagnostics
+	seen := make(map[string]PlaceHolder)
+	for _, each := range p.diagnostics {
+		key := fmt.Sprintf("%s:%d:%d:%s", each.File, each.Line, each.Column, each.Code)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = Holder
+		result = append(result, each)
+	}
+
+	result.Sort()
+	return result
+}
+
+func panicToDiagnostic(file string, err error) spec.Diagnostic {
+	match := panicRegex.FindStringSubmatch(err.Error())
+	if len(match) != 5 {
+		return spec.NewDiagnostic(file, 0, 0, spec.CodeSyntax, "%v", err)
+	}
+
+	line, _ := strconv.Atoi(match[2])
+	column, _ := strconv.Atoi(match[3])
+	if len(match[1]) > 0 {
+		file = match[1]
+	}
+
+	return spec.NewDiagnostic(file, line, column, spec.CodeSyntax, "%s", match[4])
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	"github.com/yeyudekuangxiang/goctl/api/parser/g4/gen/api"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
 )
 
 type (
@@ -266,7 +267,7 @@ func (v *ApiVisitor) VisitNormalField(ctx *api.NormalFieldContext) interface{} {
 		tagText := ctx.GetTag().GetText()
 		tagExpr := v.newExprWithToken(ctx.GetTag())
 		if !api.MatchTag(tagText) {
-			v.panic(tagExpr, fmt.Sprintf("mismatched tag, found input '%s'", tagText))
+			v.report(tagExpr, spec.CodeInvalidTag, fmt.Sprintf("mismatched tag, found input '%s'", tagText))
 		}
 		field.Tag = tagExpr
 		field.CommentExpr = v.getComment(ctx)
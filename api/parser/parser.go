@@ -33,6 +33,33 @@ func Parse(filename string) (*spec.ApiSpec, error) {
 	return spec, nil
 }
 
+// Diagnose parses the api file and collects all the syntax and semantic errors of it
+// instead of returning the first one, the spec is nil if there is any error diagnostic.
+func Diagnose(filename string) (*spec.ApiSpec, spec.Diagnostics) {
+	astParser := ast.NewParser(ast.WithParserPrefix(filepath.Base(filename)))
+	ast, diagnostics := astParser.Diagnose(filename)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	apiSpec := new(spec.ApiSpec)
+	p := parser{ast: ast, spec: apiSpec}
+	err := p.convert2Spec()
+	if err != nil {
+		return nil, append(diagnostics, spec.NewDiagnostic(filename, 0, 0, spec.CodeSyntax, "%v", err))
+	}
+
+	for _, each := range apiSpec.Diagnose() {
+		each.File = filename
+		diagnostics = append(diagnostics, each)
+	}
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return apiSpec, diagnostics
+}
+
 func parseContent(content string, skipCheckTypeDeclaration bool, filename ...string) (*spec.ApiSpec, error) {
 	var astParser *ast.Parser
 	if skipCheckTypeDeclaration {
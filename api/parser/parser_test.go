@@ -35,3 +35,56 @@ func TestMissingService(t *testing.T) {
 	err = sp.Validate()
 	assert.Equal(t, spec.ErrMissingService, err)
 }
+
+func TestDiagnose(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		sp, diagnostics := Diagnose("testdata/test.api")
+		assert.Empty(t, diagnostics)
+		assert.Equal(t, "greet-api", sp.Service.Name)
+	})
+
+	t.Run("syntax", func(t *testing.T) {
+		sp, diagnostics := Diagnose("testdata/invalid_syntax.api")
+		assert.Nil(t, sp)
+		assert.True(t, len(diagnostics) > 1)
+		for _, each := range diagnostics {
+			assert.Equal(t, spec.CodeSyntax, each.Code)
+			assert.Equal(t, spec.SeverityError, each.Severity)
+			assert.True(t, each.Line > 0)
+		}
+	})
+
+	t.Run("semantic", func(t *testing.T) {
+		sp, diagnostics := Diagnose("testdata/invalid_semantic.api")
+		assert.Nil(t, sp)
+
+		type position struct {
+			line int
+			code string
+		}
+		var actual []position
+		for _, each := range diagnostics {
+			actual = append(actual, position{line: each.Line, code: each.Code})
+		}
+		assert.Equal(t, []position{
+			{line: 5, code: spec.CodeUndefinedType},
+			{line: 6, code: spec.CodeInvalidTag},
+			{line: 11, code: spec.CodeUndefinedType},
+			{line: 15, code: spec.CodeInvalidPrefix},
+			{line: 21, code: spec.CodeDuplicateHandler},
+			{line: 30, code: spec.CodeDuplicateRoute},
+		}, actual)
+	})
+}
+
+func TestSpecDiagnose(t *testing.T) {
+	sp, err := ParseContent(testApi)
+	assert.Nil(t, err)
+	assert.Empty(t, sp.Diagnose())
+
+	sp.Service.Groups = append(sp.Service.Groups, sp.Service.Groups[0])
+	diagnostics := sp.Diagnose()
+	assert.Len(t, diagnostics, 2)
+	assert.Equal(t, spec.CodeDuplicateHandler, diagnostics[0].Code)
+	assert.Equal(t, spec.CodeDuplicateRoute, diagnostics[1].Code)
+}
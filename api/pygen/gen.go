@@ -0,0 +1,63 @@
+package pygen
+
+import (
+	_ "embed"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+var (
+	//go:embed models.tpl
+	modelsTemplate string
+	//go:embed client.tpl
+	clientTemplate string
+	//go:embed init.tpl
+	initTemplate string
+)
+
+// Generate generates the python package of the api into dir, the package contains
+// the dataclasses of the types and the sync and asyncio clients of the routes.
+func Generate(dir string, api *spec.ApiSpec) error {
+	pkg, err := buildPackage(api)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name     string
+		template string
+	}{
+		{"__init__.py", initTemplate},
+		{"models.py", modelsTemplate},
+		{"client.py", clientTemplate},
+	}
+	for _, file := range files {
+		if err := execute(filepath.Join(dir, file.name), file.template, pkg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func execute(filename, text string, data interface{}) error {
+	t, err := template.New(filepath.Base(filename)).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	fp, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	return t.Execute(fp, data)
+}
@@ -0,0 +1,48 @@
+package pygen
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	api, err := parser.Parse("testdata/example.api")
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	assert.Nil(t, Generate(dir, api))
+
+	for _, name := range []string{"__init__.py", "models.py", "client.py"} {
+		actual, err := ioutil.ReadFile(filepath.Join(dir, name))
+		assert.Nil(t, err)
+
+		golden := filepath.Join("testdata", "golden", name+".golden")
+		if *update {
+			assert.Nil(t, ioutil.WriteFile(golden, actual, 0o644))
+		}
+
+		expected, err := ioutil.ReadFile(golden)
+		assert.Nil(t, err)
+		assert.Equal(t, string(expected), string(actual), name)
+	}
+}
+
+func TestPathExpr(t *testing.T) {
+	expr, err := pathExpr("/users/:id/books/:bookId", map[string]string{"id": "id", "bookId": "book_id"})
+	assert.Nil(t, err)
+	assert.Equal(t, `f"/users/{_path(req.id)}/books/{_path(req.book_id)}"`, expr)
+
+	expr, err = pathExpr("/users", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, `"/users"`, expr)
+
+	_, err = pathExpr("/users/:id", nil)
+	assert.Error(t, err)
+}
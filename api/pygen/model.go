@@ -0,0 +1,356 @@
+package pygen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+const (
+	authKey  = "jwt"
+	groupKey = "group"
+)
+
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true,
+	"async": true, "await": true, "break": true, "class": true, "continue": true, "def": true,
+	"del": true, "elif": true, "else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true, "is": true,
+	"lambda": true, "nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+type (
+	pyPackage struct {
+		Service string
+		Classes []pyClass
+		Routes  []pyRoute
+	}
+
+	pyClass struct {
+		Name   string
+		Docs   []string
+		Fields []pyField
+	}
+
+	pyField struct {
+		Attr     string
+		Wire     string
+		Location string
+		Type     string
+		Default  string
+		Optional bool
+		Comment  string
+		// Encode is the expression which encodes self.Attr into json
+		Encode string
+		// Decode is the expression which decodes the json value in variable value
+		Decode string
+	}
+
+	pyRoute struct {
+		Func     string
+		Method   string
+		Path     string
+		Docs     []string
+		Request  string
+		Response string
+		// Decode is the expression which decodes the response in variable data
+		Decode  string
+		Params  []pyField
+		Headers []pyField
+		Body    bool
+		Auth    bool
+	}
+)
+
+// JSONFields returns the fields carried in the json body
+func (c pyClass) JSONFields() []pyField {
+	var fields []pyField
+	for _, each := range c.Fields {
+		if each.Location == util.JSONLocation {
+			fields = append(fields, each)
+		}
+	}
+	return fields
+}
+
+func buildPackage(api *spec.ApiSpec) (pyPackage, error) {
+	pkg := pyPackage{Service: api.Service.Name}
+	types := util.DefinedStructs(api)
+	classes := make(map[string]pyClass)
+	for _, tp := range api.Types {
+		ds, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		class, err := buildClass(ds, types)
+		if err != nil {
+			return pkg, err
+		}
+
+		classes[class.Name] = class
+		pkg.Classes = append(pkg.Classes, class)
+	}
+
+	names := make(map[string]int)
+	service := api.Service.JoinPrefix()
+	for _, g := range service.Groups {
+		for _, r := range g.Routes {
+			route, err := buildRoute(g, r, classes)
+			if err != nil {
+				return pkg, err
+			}
+
+			// the handlers of different groups may have the same name
+			names[route.Func]++
+			if names[route.Func] > 1 {
+				route.Func = fmt.Sprintf("%s_%s", strcase.ToSnake(g.GetAnnotation(groupKey)), route.Func)
+			}
+			pkg.Routes = append(pkg.Routes, route)
+		}
+	}
+
+	return pkg, nil
+}
+
+func buildClass(ds spec.DefineStruct, types map[string]spec.DefineStruct) (pyClass, error) {
+	class := pyClass{Name: ds.RawName, Docs: trimComments(ds.Docs)}
+	for _, member := range util.Fields(ds, types) {
+		tp := util.UnwrapPointer(member.Type)
+		hint, err := typeHint(tp)
+		if err != nil {
+			return class, fmt.Errorf("%s.%s: %w", ds.RawName, member.Name, err)
+		}
+
+		field := pyField{
+			Attr:     attrName(member.Name),
+			Wire:     member.WireName,
+			Location: member.Location,
+			Type:     hint,
+			Default:  defaultValue(tp),
+			Optional: member.Optional,
+			Comment:  strings.TrimSpace(strings.TrimPrefix(member.GetComment(), "//")),
+			Decode:   decodeExpr(tp, "value", 0),
+		}
+		if field.Default == "None" {
+			field.Optional = true
+		}
+		if field.Optional {
+			field.Default = "None"
+			if !strings.HasPrefix(field.Type, "Optional[") && field.Type != "Any" {
+				field.Type = fmt.Sprintf("Optional[%s]", field.Type)
+			}
+		}
+		field.Encode = "self." + field.Attr
+		if !isPrimitive(tp) {
+			field.Encode = fmt.Sprintf("_encode(self.%s)", field.Attr)
+		}
+
+		class.Fields = append(class.Fields, field)
+	}
+
+	return class, nil
+}
+
+func buildRoute(g spec.Group, r spec.Route, classes map[string]pyClass) (pyRoute, error) {
+	handler := strings.TrimSuffix(r.Handler, "Handler")
+	route := pyRoute{
+		Func:     attrName(strcase.ToSnake(handler)),
+		Method:   strings.ToUpper(r.Method),
+		Path:     strconv.Quote(r.Path),
+		Docs:     routeDocs(r),
+		Response: "None",
+		Auth:     len(g.GetAnnotation(authKey)) > 0,
+	}
+
+	if r.RequestType != nil {
+		ds, ok := r.RequestType.(spec.DefineStruct)
+		if !ok {
+			return route, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+				r.RequestType.Name())
+		}
+
+		class := classes[ds.RawName]
+		route.Request = class.Name
+		paths := make(map[string]string)
+		for _, field := range class.Fields {
+			switch field.Location {
+			case util.PathLocation:
+				paths[field.Wire] = field.Attr
+			case util.FormLocation:
+				route.Params = append(route.Params, field)
+			case util.HeaderLocation:
+				route.Headers = append(route.Headers, field)
+			default:
+				route.Body = true
+			}
+		}
+
+		path, err := pathExpr(r.Path, paths)
+		if err != nil {
+			return route, err
+		}
+		route.Path = path
+	} else if util.HasPathParams(r.Path) {
+		return route, fmt.Errorf("route %s %s: path parameters require a request type", r.Method, r.Path)
+	}
+
+	if r.ResponseType != nil {
+		tp := util.UnwrapPointer(r.ResponseType)
+		hint, err := typeHint(tp)
+		if err != nil {
+			return route, fmt.Errorf("route %s %s: %w", r.Method, r.Path, err)
+		}
+
+		route.Response = hint
+		route.Decode = decodeExpr(tp, "data", 0)
+	}
+
+	return route, nil
+}
+
+// pathExpr returns the python expression of the path, the path parameters are
+// replaced with the escaped request fields.
+func pathExpr(path string, params map[string]string) (string, error) {
+	expr, err := util.ReplacePathParams(path, func(name string) (string, bool) {
+		attr, ok := params[name]
+		return fmt.Sprintf("{_path(req.%s)}", attr), ok
+	})
+	if err != nil {
+		return "", err
+	}
+	if expr == path {
+		return strconv.Quote(path), nil
+	}
+
+	return "f" + strconv.Quote(expr), nil
+}
+
+func typeHint(tp spec.Type) (string, error) {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		return primitiveHint(v.RawName), nil
+	case spec.DefineStruct:
+		return v.RawName, nil
+	case spec.InterfaceType:
+		return "Any", nil
+	case spec.PointerType:
+		return typeHint(util.UnwrapPointer(v))
+	case spec.ArrayType:
+		hint, err := typeHint(v.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("List[%s]", hint), nil
+	case spec.MapType:
+		hint, err := typeHint(v.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Dict[%s, %s]", primitiveHint(v.Key), hint), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", tp.Name())
+	}
+}
+
+func primitiveHint(name string) string {
+	switch name {
+	case "bool":
+		return "bool"
+	case "string":
+		return "str"
+	case "float32", "float64":
+		return "float"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64",
+		"byte", "rune", "uintptr":
+		return "int"
+	default:
+		return "Any"
+	}
+}
+
+func defaultValue(tp spec.Type) string {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		switch primitiveHint(v.RawName) {
+		case "bool":
+			return "False"
+		case "str":
+			return `""`
+		case "float":
+			return "0.0"
+		case "int":
+			return "0"
+		}
+	case spec.ArrayType:
+		return "field(default_factory=list)"
+	case spec.MapType:
+		return "field(default_factory=dict)"
+	}
+	return "None"
+}
+
+// decodeExpr returns the expression which decodes the json value in variable name
+func decodeExpr(tp spec.Type, name string, depth int) string {
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		return fmt.Sprintf("%s.from_dict(%s)", v.RawName, name)
+	case spec.PointerType:
+		return decodeExpr(util.UnwrapPointer(v), name, depth)
+	case spec.ArrayType:
+		item := fmt.Sprintf("v%d", depth)
+		expr := decodeExpr(v.Value, item, depth+1)
+		if expr == item {
+			return name
+		}
+		return fmt.Sprintf("[%s for %s in %s]", expr, item, name)
+	case spec.MapType:
+		key, item := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		expr := decodeExpr(v.Value, item, depth+1)
+		if expr == item {
+			return name
+		}
+		return fmt.Sprintf("{%s: %s for %s, %s in %s.items()}", key, expr, key, item, name)
+	default:
+		return name
+	}
+}
+
+func isPrimitive(tp spec.Type) bool {
+	switch tp.(type) {
+	case spec.PrimitiveType, spec.InterfaceType:
+		return true
+	}
+	return false
+}
+
+func attrName(name string) string {
+	attr := strcase.ToSnake(name)
+	if keywords[attr] {
+		return attr + "_"
+	}
+	return attr
+}
+
+func routeDocs(r spec.Route) []string {
+	if doc := strings.Trim(r.JoinedDoc(), `"`); len(doc) > 0 {
+		return []string{doc}
+	}
+	return nil
+}
+
+func trimComments(docs spec.Doc) []string {
+	var result []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(doc), "//"))
+		if len(doc) > 0 {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package rustgen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var (
+	// VarStringDir describes the directory of the rust module.
+	VarStringDir string
+	// VarStringAPI describes the api file.
+	VarStringAPI string
+	// VarBoolAxum describes whether to generate the axum route stubs.
+	VarBoolAxum bool
+)
+
+// RustCommand generates the rust types and client of the api file
+func RustCommand(_ *cobra.Command, _ []string) error {
+	apiFile := VarStringAPI
+	if apiFile == "" {
+		return errors.New("missing -api")
+	}
+	dir := VarStringDir
+	if dir == "" {
+		return errors.New("missing -dir")
+	}
+
+	api, err := parser.Parse(apiFile)
+	if err != nil {
+		return err
+	}
+
+	if err := api.Validate(); err != nil {
+		return err
+	}
+
+	if err := Generate(dir, api, VarBoolAxum); err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
@@ -0,0 +1,76 @@
+package rustgen
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+var (
+	//go:embed mod.tpl
+	modTemplate string
+	//go:embed types.tpl
+	typesTemplate string
+	//go:embed client.tpl
+	clientTemplate string
+	//go:embed server.tpl
+	serverTemplate string
+)
+
+// Generate generates the rust module of the api into dir, the module contains the serde
+// structs of the types, the reqwest client of the routes and the axum route stubs if axum
+// is true. The route stubs are meant to be edited, so they are never overwritten.
+func Generate(dir string, api *spec.ApiSpec, axum bool) error {
+	crate, err := buildCrate(api)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	mod := map[string]interface{}{
+		"Axum": axum,
+	}
+	if err := execute(filepath.Join(dir, "mod.rs"), modTemplate, mod); err != nil {
+		return err
+	}
+	if err := execute(filepath.Join(dir, "types.rs"), typesTemplate, crate); err != nil {
+		return err
+	}
+	if err := execute(filepath.Join(dir, "client.rs"), clientTemplate, crate); err != nil {
+		return err
+	}
+	if !axum {
+		return nil
+	}
+
+	server := filepath.Join(dir, "server.rs")
+	if pathx.FileExists(server) {
+		fmt.Printf("%s exists, ignored generation\n", server)
+		return nil
+	}
+
+	return execute(server, serverTemplate, crate)
+}
+
+func execute(filename, text string, data interface{}) error {
+	t, err := template.New(filepath.Base(filename)).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	fp, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	return t.Execute(fp, data)
+}
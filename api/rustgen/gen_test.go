@@ -0,0 +1,54 @@
+package rustgen
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	for _, api := range []string{"../parser/testdata/test.api", "testdata/example.api"} {
+		t.Run(filepath.Base(api), func(t *testing.T) {
+			spec, err := parser.Parse(api)
+			assert.Nil(t, err)
+
+			dir := t.TempDir()
+			assert.Nil(t, Generate(dir, spec, true))
+
+			name := filepath.Base(api)
+			for _, file := range []string{"mod.rs", "types.rs", "client.rs", "server.rs"} {
+				actual, err := ioutil.ReadFile(filepath.Join(dir, file))
+				assert.Nil(t, err)
+
+				golden := filepath.Join("testdata", "golden", name[:len(name)-len(".api")]+"."+file+".golden")
+				if *update {
+					assert.Nil(t, ioutil.WriteFile(golden, actual, 0o644))
+				}
+
+				expected, err := ioutil.ReadFile(golden)
+				assert.Nil(t, err)
+				assert.Equal(t, string(expected), string(actual), file)
+			}
+		})
+	}
+}
+
+func TestGenerateKeepsServer(t *testing.T) {
+	spec, err := parser.Parse("../parser/testdata/test.api")
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	server := filepath.Join(dir, "server.rs")
+	assert.Nil(t, ioutil.WriteFile(server, []byte("// edited"), 0o644))
+	assert.Nil(t, Generate(dir, spec, true))
+
+	content, err := ioutil.ReadFile(server)
+	assert.Nil(t, err)
+	assert.Equal(t, "// edited", string(content))
+}
@@ -0,0 +1,394 @@
+package rustgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+const (
+	authKey  = "jwt"
+	groupKey = "group"
+	// typesModule qualifies the types in the client and the server, the types may
+	// have the same names as the items of reqwest and axum.
+	typesModule = "types::"
+)
+
+var keywords = map[string]bool{
+	"as": true, "async": true, "await": true, "box": true, "break": true, "const": true,
+	"continue": true, "dyn": true, "else": true, "enum": true, "extern": true, "false": true,
+	"fn": true, "for": true, "if": true, "impl": true, "in": true, "let": true, "loop": true,
+	"match": true, "mod": true, "move": true, "mut": true, "pub": true, "ref": true, "return": true,
+	"static": true, "struct": true, "trait": true, "true": true, "type": true, "unsafe": true,
+	"use": true, "where": true, "while": true, "yield": true,
+}
+
+type (
+	rustCrate struct {
+		Service string
+		Structs []rustStruct
+		Groups  []*rustGroup
+		Routes  []rustPath
+		// HashMap is true if any field is a map
+		HashMap bool
+	}
+
+	rustStruct struct {
+		Name   string
+		Docs   []string
+		Fields []rustField
+	}
+
+	rustField struct {
+		Name     string
+		Wire     string
+		Location string
+		Type     string
+		// Attrs are the serde attributes of the field
+		Attrs   []string
+		Comment string
+		// Kind is option, vec or value, which decides how the field is added
+		// into the query and the headers.
+		Kind string
+	}
+
+	rustGroup struct {
+		// Module is the module name of the axum handlers
+		Module string
+		// Name is the struct name of the client of the group
+		Name      string
+		Functions []rustFunction
+	}
+
+	rustFunction struct {
+		Name   string
+		Method string
+		// Path is the format string of the path, the parameters are PathArgs
+		Path     string
+		PathArgs []string
+		Route    string
+		Docs     []string
+		Request  string
+		Response string
+		Query    []rustField
+		Headers  []rustField
+		Body     bool
+		Auth     bool
+	}
+
+	// rustPath is the axum route of a path with all the handlers of the methods
+	rustPath struct {
+		Path     string
+		Handlers []string
+	}
+)
+
+func buildCrate(api *spec.ApiSpec) (rustCrate, error) {
+	crate := rustCrate{Service: api.Service.Name}
+	types := util.DefinedStructs(api)
+	structs := make(map[string]rustStruct)
+	for _, tp := range api.Types {
+		ds, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		st, err := buildStruct(ds, types)
+		if err != nil {
+			return crate, err
+		}
+
+		for _, field := range st.Fields {
+			if strings.Contains(field.Type, "HashMap<") {
+				crate.HashMap = true
+			}
+		}
+		structs[ds.RawName] = st
+		crate.Structs = append(crate.Structs, st)
+	}
+
+	groups := make(map[string]*rustGroup)
+	paths := make(map[string]*rustPath)
+	var pathOrder []string
+	service := api.Service.JoinPrefix()
+	for _, g := range service.Groups {
+		module := moduleName(api.Service.Name, g.GetAnnotation(groupKey))
+		group, ok := groups[module]
+		if !ok {
+			group = &rustGroup{Module: module, Name: strcase.ToCamel(module) + "Api"}
+			groups[module] = group
+			crate.Groups = append(crate.Groups, group)
+		}
+
+		for _, r := range g.Routes {
+			fn, err := buildFunction(g, r, structs)
+			if err != nil {
+				return crate, err
+			}
+			group.Functions = append(group.Functions, fn)
+
+			path, ok := paths[r.Path]
+			if !ok {
+				path = &rustPath{Path: r.Path}
+				paths[r.Path] = path
+				pathOrder = append(pathOrder, r.Path)
+			}
+			path.Handlers = append(path.Handlers, fmt.Sprintf("%s(%s::%s)",
+				strings.ToLower(fn.Method), group.Module, fn.Name))
+		}
+	}
+
+	for _, each := range pathOrder {
+		crate.Routes = append(crate.Routes, *paths[each])
+	}
+
+	return crate, nil
+}
+
+func buildStruct(ds spec.DefineStruct, types map[string]spec.DefineStruct) (rustStruct, error) {
+	st := rustStruct{Name: ds.RawName, Docs: trimComments(ds.Docs)}
+	for _, member := range util.Fields(ds, types) {
+		tp := util.UnwrapPointer(member.Type)
+		name, err := typeName(tp, "")
+		if err != nil {
+			return st, fmt.Errorf("%s.%s: %w", ds.RawName, member.Name, err)
+		}
+
+		field := rustField{
+			Name:     fieldName(member.Name),
+			Wire:     member.WireName,
+			Location: member.Location,
+			Type:     name,
+			Comment:  strings.TrimSpace(strings.TrimPrefix(member.GetComment(), "//")),
+			Kind:     "value",
+		}
+
+		_, pointer := member.Type.(spec.PointerType)
+		if _, ok := tp.(spec.DefineStruct); ok && pointer {
+			// the pointers may refer to the struct itself
+			field.Type = fmt.Sprintf("Box<%s>", field.Type)
+		}
+
+		if field.Location != util.JSONLocation {
+			// the empty arrays are omitted like the optional values
+			if _, ok := tp.(spec.ArrayType); ok {
+				field.Kind = "vec"
+			} else if member.Optional {
+				field.Type = fmt.Sprintf("Option<%s>", field.Type)
+				field.Kind = "option"
+			}
+			field.Attrs = []string{"skip"}
+			st.Fields = append(st.Fields, field)
+			continue
+		}
+
+		if strings.TrimPrefix(field.Name, "r#") != field.Wire {
+			field.Attrs = append(field.Attrs, fmt.Sprintf("rename = %q", field.Wire))
+		}
+		omitEmpty := member.IsOmitEmpty() && !member.IsOptional() && !pointer
+		switch {
+		case member.Optional && !omitEmpty:
+			field.Type = fmt.Sprintf("Option<%s>", field.Type)
+			field.Kind = "option"
+			field.Attrs = append(field.Attrs, "default", `skip_serializing_if = "Option::is_none"`)
+		case isCollection(tp):
+			// encoding/json encodes the nil slices and maps as null
+			field.Attrs = append(field.Attrs, "default", `deserialize_with = "null_as_default"`)
+			if omitEmpty {
+				field.Attrs = append(field.Attrs, fmt.Sprintf("skip_serializing_if = %q",
+					strings.Split(field.Type, "<")[0]+"::is_empty"))
+			}
+		case omitEmpty:
+			field.Attrs = append(field.Attrs, "default")
+			if field.Type == "String" {
+				field.Attrs = append(field.Attrs, `skip_serializing_if = "String::is_empty"`)
+			}
+		}
+
+		st.Fields = append(st.Fields, field)
+	}
+
+	return st, nil
+}
+
+func buildFunction(g spec.Group, r spec.Route, structs map[string]rustStruct) (rustFunction, error) {
+	fn := rustFunction{
+		Name:   fieldName(strings.TrimSuffix(r.Handler, "Handler")),
+		Method: strings.ToUpper(r.Method),
+		Path:   r.Path,
+		Route:  r.Path,
+		Docs:   routeDocs(r),
+		Auth:   len(g.GetAnnotation(authKey)) > 0,
+	}
+
+	if r.RequestType != nil {
+		ds, ok := r.RequestType.(spec.DefineStruct)
+		if !ok {
+			return fn, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+				r.RequestType.Name())
+		}
+
+		st := structs[ds.RawName]
+		fn.Request = typesModule + st.Name
+		paths := make(map[string]string)
+		for _, field := range st.Fields {
+			switch field.Location {
+			case util.PathLocation:
+				paths[field.Wire] = field.Name
+			case util.FormLocation, util.HeaderLocation:
+				if strings.Contains(field.Type, "HashMap<") || strings.Contains(field.Type, "Value") ||
+					strings.Contains(field.Type, "Vec<Vec<") {
+					return fn, fmt.Errorf("route %s %s: %s of type %s can't be encoded in the %s",
+						r.Method, r.Path, field.Name, field.Type, field.Location)
+				}
+				if field.Location == util.FormLocation {
+					fn.Query = append(fn.Query, field)
+				} else {
+					fn.Headers = append(fn.Headers, field)
+				}
+			default:
+				fn.Body = true
+			}
+		}
+
+		path, err := util.ReplacePathParams(r.Path, func(name string) (string, bool) {
+			field, ok := paths[name]
+			fn.PathArgs = append(fn.PathArgs, fmt.Sprintf("path_segment(&req.%s)", field))
+			return "{}", ok
+		})
+		if err != nil {
+			return fn, err
+		}
+		fn.Path = path
+	} else if util.HasPathParams(r.Path) {
+		return fn, fmt.Errorf("route %s %s: path parameters require a request type", r.Method, r.Path)
+	}
+
+	if r.ResponseType != nil {
+		name, err := typeName(util.UnwrapPointer(r.ResponseType), typesModule)
+		if err != nil {
+			return fn, fmt.Errorf("route %s %s: %w", r.Method, r.Path, err)
+		}
+		fn.Response = name
+	}
+
+	return fn, nil
+}
+
+// typeName returns the rust type of tp, the structs are qualified with the prefix
+func typeName(tp spec.Type, prefix string) (string, error) {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		return primitiveName(v.RawName), nil
+	case spec.DefineStruct:
+		return prefix + v.RawName, nil
+	case spec.InterfaceType:
+		return "serde_json::Value", nil
+	case spec.PointerType:
+		return typeName(util.UnwrapPointer(v), prefix)
+	case spec.ArrayType:
+		name, err := typeName(v.Value, prefix)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Vec<%s>", name), nil
+	case spec.MapType:
+		name, err := typeName(v.Value, prefix)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("HashMap<%s, %s>", primitiveName(v.Key), name), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", tp.Name())
+	}
+}
+
+func primitiveName(name string) string {
+	switch name {
+	case "bool":
+		return "bool"
+	case "string":
+		return "String"
+	case "float32":
+		return "f32"
+	case "float64":
+		return "f64"
+	case "int", "int64":
+		return "i64"
+	case "int8", "int16", "int32":
+		return "i" + strings.TrimPrefix(name, "int")
+	case "uint", "uint64", "uintptr":
+		return "u64"
+	case "uint8", "uint16", "uint32":
+		return "u" + strings.TrimPrefix(name, "uint")
+	case "byte":
+		return "u8"
+	case "rune":
+		return "i32"
+	default:
+		return "serde_json::Value"
+	}
+}
+
+func isCollection(tp spec.Type) bool {
+	switch tp.(type) {
+	case spec.ArrayType, spec.MapType:
+		return true
+	}
+	return false
+}
+
+func fieldName(name string) string {
+	field := strcase.ToSnake(name)
+	if keywords[field] {
+		return "r#" + field
+	}
+	return field
+}
+
+func moduleName(service, group string) string {
+	name := strings.TrimSuffix(service, "-api")
+	if len(group) > 0 {
+		name = strings.NewReplacer("/", "_", "-", "_").Replace(group)
+	}
+	return fieldName(name)
+}
+
+// HandlerImports returns the axum routing functions used by the routes
+func (c rustCrate) HandlerImports() string {
+	methods := make(map[string]bool)
+	for _, group := range c.Groups {
+		for _, fn := range group.Functions {
+			methods[strings.ToLower(fn.Method)] = true
+		}
+	}
+
+	var result []string
+	for method := range methods {
+		result = append(result, method)
+	}
+	sort.Strings(result)
+	return strings.Join(result, ", ")
+}
+
+func routeDocs(r spec.Route) []string {
+	if doc := strings.Trim(r.JoinedDoc(), `"`); len(doc) > 0 {
+		return []string{doc}
+	}
+	return nil
+}
+
+func trimComments(docs spec.Doc) []string {
+	var result []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(doc), "//"))
+		if len(doc) > 0 {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
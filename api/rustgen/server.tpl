@@ -0,0 +1,40 @@
+// Route stubs of the {{.Service}} service, fill the handlers in.
+#![allow(unused_variables)]
+
+use axum::routing::{ {{- .HandlerImports -}} };
+use axum::Router;
+
+pub fn router() -> Router {
+    Router::new()
+{{- range .Routes}}
+        .route("{{.Path}}", {{range $i, $h := .Handlers}}{{if $i}}.{{end}}{{$h}}{{end}})
+{{- end}}
+}
+{{- range .Groups}}
+
+pub mod {{.Module}} {
+    use std::collections::HashMap;
+
+    use axum::extract::{Path, Query};
+    use axum::http::{HeaderMap, StatusCode};
+    use axum::Json;
+
+    #[allow(unused_imports)]
+    use super::super::types;
+{{- range .Functions}}
+{{range .Docs}}
+    /// {{.}}
+{{- end}}
+    pub async fn {{.Name}}(
+        Path(path): Path<HashMap<String, String>>,
+        Query(query): Query<Vec<(String, String)>>,
+        headers: HeaderMap,
+{{- if .Body}}
+        Json(req): Json<{{.Request}}>,
+{{- end}}
+    ) -> {{if .Response}}Result<Json<{{.Response}}>, StatusCode>{{else}}StatusCode{{end}} {
+        {{if .Response}}Err(StatusCode::NOT_IMPLEMENTED){{else}}StatusCode::NOT_IMPLEMENTED{{end}}
+    }
+{{- end}}
+}
+{{- end}}
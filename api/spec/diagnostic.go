@@ -0,0 +1,109 @@
+package spec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// SeverityError marks a diagnostic which makes the api unusable for generation.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a diagnostic which does not block generation.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo marks an informational diagnostic.
+	SeverityInfo Severity = "info"
+)
+
+// Diagnostic codes reported by the api parser and validator.
+const (
+	CodeSyntax           = "syntax"
+	CodeImport           = "import"
+	CodeUndefinedType    = "undefined-type"
+	CodeDuplicateType    = "duplicate-type"
+	CodeDuplicateKey     = "duplicate-key"
+	CodeDuplicateHandler = "duplicate-handler"
+	CodeDuplicateRoute   = "duplicate-route"
+	CodeMissingHandler   = "missing-handler"
+	CodeInvalidHandler   = "invalid-handler"
+	CodeInvalidTag       = "invalid-tag"
+	CodeInvalidPrefix    = "invalid-prefix"
+	CodeMissingService   = "missing-service"
+	CodeMultipleService  = "multiple-service"
+)
+
+type (
+	// Severity describes the level of a Diagnostic
+	Severity string
+
+	// Diagnostic describes a problem found in an api file
+	Diagnostic struct {
+		File     string   `json:"file"`
+		Line     int      `json:"line"`
+		Column   int      `json:"column"`
+		Severity Severity `json:"severity"`
+		Code     string   `json:"code"`
+		Message  string   `json:"message"`
+	}
+
+	// Diagnostics is a list of Diagnostic, it implements error
+	Diagnostics []Diagnostic
+)
+
+// NewDiagnostic creates an error Diagnostic at the specified position
+func NewDiagnostic(file string, line, column int, code, format string, args ...interface{}) Diagnostic {
+	return Diagnostic{
+		File:     file,
+		Line:     line,
+		Column:   column,
+		Severity: SeverityError,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// String returns the diagnostic in the form of file line l:c [code] message
+func (d Diagnostic) String() string {
+	var b strings.Builder
+	if len(d.File) > 0 {
+		b.WriteString(d.File)
+		b.WriteString(" ")
+	}
+	if d.Line > 0 {
+		b.WriteString(fmt.Sprintf("line %d:%d ", d.Line, d.Column))
+	}
+	b.WriteString(fmt.Sprintf("%s [%s] %s", d.Severity, d.Code, d.Message))
+	return b.String()
+}
+
+// Error implements error, it joins all diagnostics with a new line
+func (d Diagnostics) Error() string {
+	var list []string
+	for _, each := range d {
+		list = append(list, each.String())
+	}
+	return strings.Join(list, "\n")
+}
+
+// HasError returns true if any of the diagnostics is an error
+func (d Diagnostics) HasError() bool {
+	for _, each := range d {
+		if each.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort sorts the diagnostics by file, line and column
+func (d Diagnostics) Sort() {
+	sort.SliceStable(d, func(i, j int) bool {
+		if d[i].File != d[j].File {
+			return d[i].File < d[j].File
+		}
+		if d[i].Line != d[j].Line {
+			return d[i].Line < d[j].Line
+		}
+		return d[i].Column < d[j].Column
+	})
+}
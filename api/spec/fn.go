@@ -13,6 +13,7 @@ const (
 	bodyTagKey        = "json"
 	formTagKey        = "form"
 	pathTagKey        = "path"
+	headerTagKey      = "header"
 	defaultSummaryKey = "summary"
 )
 
@@ -71,6 +72,32 @@ func (m Member) IsOptional() bool {
 	return false
 }
 
+// Location returns the tag key which decides where the member is carried in the http
+// request, one of json, form, path and header, the name in the tag, and whether the member
+// is optional. The key is empty if the member has none of the tags.
+func (m Member) Location() (key, name string, optional bool) {
+	tags, err := Parse(m.Tag)
+	if err != nil {
+		return "", m.Name, false
+	}
+
+	for _, key := range []string{bodyTagKey, formTagKey, pathTagKey, headerTagKey} {
+		tag, err := tags.Get(key)
+		if err != nil {
+			continue
+		}
+
+		for _, option := range tag.Options {
+			if option == "optional" || option == "omitempty" || strings.HasPrefix(option, "default=") {
+				optional = true
+			}
+		}
+		return key, tag.Name, optional
+	}
+
+	return "", m.Name, false
+}
+
 // IsOmitEmpty returns true if tag contains omitempty
 func (m Member) IsOmitEmpty() bool {
 	if !m.IsBodyMember() {
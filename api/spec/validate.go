@@ -1,8 +1,26 @@
 package spec
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+)
 
-var ErrMissingService = errors.New("missing service")
+const groupKey = "group"
+
+var (
+	ErrMissingService = errors.New("missing service")
+
+	errEmptyPrefix   = errors.New("prefix must not be empty")
+	errPrefixSlash   = errors.New("prefix must start with '/' and must not end with '/'")
+	errPrefixSpace   = errors.New("prefix must not contain white space")
+	errPrefixDouble  = errors.New("prefix must not contain '//'")
+	errEmptyTagName  = errors.New("tag name must not be empty")
+	errEmptyHandler  = errors.New("missing handler")
+	errInvalidHandle = errors.New("handler name should only contains letter or digit")
+)
 
 // Validate validates Validate the integrity of the spec.
 func (s *ApiSpec) Validate() error {
@@ -14,3 +32,135 @@ func (s *ApiSpec) Validate() error {
 	}
 	return nil
 }
+
+// Diagnose collects all the semantic problems of the spec instead of returning the first one,
+// the diagnostics have no position since the spec doesn't record it.
+func (s *ApiSpec) Diagnose() Diagnostics {
+	var result Diagnostics
+	if err := s.Validate(); err != nil {
+		result = append(result, NewDiagnostic("", 0, 0, CodeMissingService, "%v", err))
+	}
+
+	for _, tp := range s.Types {
+		ds, ok := tp.(DefineStruct)
+		if !ok {
+			continue
+		}
+
+		for _, m := range ds.Members {
+			if m.IsInline {
+				continue
+			}
+			if err := ValidateTag(m.Tag); err != nil {
+				result = append(result, NewDiagnostic("", 0, 0, CodeInvalidTag,
+					"type %s member %s has invalid tag %s: %v", ds.RawName, m.Name, m.Tag, err))
+			}
+		}
+	}
+
+	handlers := make(map[string]struct{})
+	routes := make(map[string]struct{})
+	for _, g := range s.Service.Groups {
+		prefix := g.GetAnnotation(RoutePrefixKey)
+		if _, ok := g.Annotation.Properties[RoutePrefixKey]; ok {
+			if err := ValidatePrefix(prefix); err != nil {
+				result = append(result, NewDiagnostic("", 0, 0, CodeInvalidPrefix,
+					"invalid prefix %q: %v", prefix, err))
+			}
+		}
+
+		group := g.GetAnnotation(groupKey)
+		for _, r := range g.Routes {
+			if err := ValidateHandler(r.Handler); err != nil {
+				code := CodeInvalidHandler
+				if errors.Is(err, errEmptyHandler) {
+					code = CodeMissingHandler
+				}
+				result = append(result, NewDiagnostic("", 0, 0, code,
+					"route %s %s: %v", r.Method, r.Path, err))
+			}
+
+			handlerKey := HandlerKey(group, r.Handler)
+			if _, ok := handlers[handlerKey]; ok {
+				result = append(result, NewDiagnostic("", 0, 0, CodeDuplicateHandler,
+					"duplicate handler '%s'", handlerKey))
+			}
+			handlers[handlerKey] = struct{}{}
+
+			routeKey := RouteKey(r.Method, prefix, r.Path)
+			if _, ok := routes[routeKey]; ok {
+				result = append(result, NewDiagnostic("", 0, 0, CodeDuplicateRoute,
+					"duplicate route '%s'", routeKey))
+			}
+			routes[routeKey] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// HandlerKey returns the unique key of a handler in the service, handlers are
+// allowed to be duplicated in different groups.
+func HandlerKey(group, handler string) string {
+	if len(group) > 0 {
+		return fmt.Sprintf("%s/%s", group, handler)
+	}
+
+	return handler
+}
+
+// RouteKey returns the unique key of a route in the service, such as: get /api/user/:id
+func RouteKey(method, prefix, route string) string {
+	return fmt.Sprintf("%s %s", strings.ToLower(method), path.Join("/", prefix, route))
+}
+
+// ValidatePrefix checks whether the value of @server(prefix: ...) is a valid path prefix
+func ValidatePrefix(prefix string) error {
+	prefix = strings.Trim(prefix, `"`)
+	switch {
+	case len(prefix) == 0:
+		return errEmptyPrefix
+	case strings.IndexFunc(prefix, unicode.IsSpace) >= 0:
+		return errPrefixSpace
+	case strings.Contains(prefix, "//"):
+		return errPrefixDouble
+	case !strings.HasPrefix(prefix, "/") || (len(prefix) > 1 && strings.HasSuffix(prefix, "/")):
+		return errPrefixSlash
+	}
+
+	return nil
+}
+
+// ValidateTag checks whether the tag of a member is well-formed, tag is the raw text
+// with backquotes, such as `json:"name"`
+func ValidateTag(tag string) error {
+	tags, err := Parse(tag)
+	if err != nil {
+		return err
+	}
+
+	for _, each := range tags.Tags() {
+		for _, key := range definedKeys {
+			if each.Key == key && len(each.Name) == 0 {
+				return errEmptyTagName
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateHandler checks whether the handler name is valid
+func ValidateHandler(handler string) error {
+	if len(handler) == 0 {
+		return errEmptyHandler
+	}
+
+	for _, char := range handler {
+		if !unicode.IsDigit(char) && !unicode.IsLetter(char) {
+			return errInvalidHandle
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package swiftgen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var (
+	// VarStringDir describes the directory of the swift sources.
+	VarStringDir string
+	// VarStringAPI describes the api file.
+	VarStringAPI string
+)
+
+// SwiftCommand generates the swift client of the api file
+func SwiftCommand(_ *cobra.Command, _ []string) error {
+	apiFile := VarStringAPI
+	if apiFile == "" {
+		return errors.New("missing -api")
+	}
+	dir := VarStringDir
+	if dir == "" {
+		return errors.New("missing -dir")
+	}
+
+	api, err := parser.Parse(apiFile)
+	if err != nil {
+		return err
+	}
+
+	if err := api.Validate(); err != nil {
+		return err
+	}
+
+	if err := Generate(dir, api); err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
@@ -0,0 +1,60 @@
+package swiftgen
+
+import (
+	_ "embed"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+var (
+	//go:embed models.tpl
+	modelsTemplate string
+	//go:embed client.tpl
+	clientTemplate string
+)
+
+// Generate generates the swift sources of the api into dir, the sources contain
+// the Codable structs of the types and the async/await client of the routes.
+func Generate(dir string, api *spec.ApiSpec) error {
+	pkg, err := buildPackage(api)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name     string
+		template string
+	}{
+		{"Models.swift", modelsTemplate},
+		{"Client.swift", clientTemplate},
+	}
+	for _, file := range files {
+		if err := execute(filepath.Join(dir, file.name), file.template, pkg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func execute(filename, text string, data interface{}) error {
+	t, err := template.New(filepath.Base(filename)).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	fp, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	return t.Execute(fp, data)
+}
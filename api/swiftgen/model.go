@@ -0,0 +1,339 @@
+package swiftgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+const (
+	authKey  = "jwt"
+	groupKey = "group"
+)
+
+var keywords = map[string]bool{
+	"Any": true, "Self": true, "as": true, "associatedtype": true, "break": true, "case": true,
+	"catch": true, "class": true, "continue": true, "default": true, "defer": true, "deinit": true,
+	"do": true, "else": true, "enum": true, "extension": true, "fallthrough": true, "false": true,
+	"fileprivate": true, "for": true, "func": true, "guard": true, "if": true, "import": true,
+	"in": true, "init": true, "inout": true, "internal": true, "is": true, "let": true, "nil": true,
+	"open": true, "operator": true, "private": true, "protocol": true, "public": true,
+	"repeat": true, "rethrows": true, "return": true, "self": true, "static": true, "struct": true,
+	"subscript": true, "super": true, "switch": true, "throw": true, "throws": true, "true": true,
+	"try": true, "typealias": true, "var": true, "where": true, "while": true,
+}
+
+type (
+	swiftPackage struct {
+		Service string
+		Structs []swiftStruct
+		Routes  []swiftRoute
+		// JSONValue is true if any field is an interface{}, which is decoded as JSONValue
+		JSONValue bool
+	}
+
+	swiftStruct struct {
+		Name   string
+		Docs   []string
+		Fields []swiftField
+	}
+
+	swiftField struct {
+		Name     string
+		Wire     string
+		Location string
+		Type     string
+		// RawValue is the name in the json body if it differs from the property name
+		RawValue string
+		// Default is the initial value of the fields not carried in the json body,
+		// the synthesized Decodable requires them.
+		Default  string
+		Optional bool
+		Comment  string
+	}
+
+	swiftRoute struct {
+		Func     string
+		Method   string
+		Path     string
+		Docs     []string
+		Request  string
+		Response string
+		Query    []swiftField
+		Headers  []swiftField
+		Body     bool
+		Auth     bool
+	}
+)
+
+// JSONFields returns the fields carried in the json body
+func (s swiftStruct) JSONFields() []swiftField {
+	var fields []swiftField
+	for _, each := range s.Fields {
+		if each.Location == util.JSONLocation {
+			fields = append(fields, each)
+		}
+	}
+	return fields
+}
+
+func buildPackage(api *spec.ApiSpec) (swiftPackage, error) {
+	pkg := swiftPackage{Service: api.Service.Name}
+	types := util.DefinedStructs(api)
+	structs := make(map[string]swiftStruct)
+	for _, tp := range api.Types {
+		ds, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		st, err := buildStruct(ds, types)
+		if err != nil {
+			return pkg, err
+		}
+
+		for _, field := range st.Fields {
+			if strings.Contains(field.Type, "JSONValue") {
+				pkg.JSONValue = true
+			}
+		}
+		structs[st.Name] = st
+		pkg.Structs = append(pkg.Structs, st)
+	}
+
+	names := make(map[string]int)
+	service := api.Service.JoinPrefix()
+	for _, g := range service.Groups {
+		for _, r := range g.Routes {
+			route, err := buildRoute(g, r, structs)
+			if err != nil {
+				return pkg, err
+			}
+
+			// the handlers of different groups may have the same name
+			names[route.Func]++
+			if names[route.Func] > 1 {
+				route.Func = propertyName(g.GetAnnotation(groupKey) + "_" + strings.Trim(route.Func, "`"))
+			}
+			pkg.Routes = append(pkg.Routes, route)
+		}
+	}
+
+	return pkg, nil
+}
+
+func buildStruct(ds spec.DefineStruct, types map[string]spec.DefineStruct) (swiftStruct, error) {
+	st := swiftStruct{Name: ds.RawName, Docs: trimComments(ds.Docs)}
+	for _, member := range util.Fields(ds, types) {
+		tp := util.UnwrapPointer(member.Type)
+		name, err := typeName(tp)
+		if err != nil {
+			return st, fmt.Errorf("%s.%s: %w", ds.RawName, member.Name, err)
+		}
+
+		field := swiftField{
+			Name:     propertyName(member.Name),
+			Wire:     member.WireName,
+			Location: member.Location,
+			Type:     name,
+			Optional: member.Optional,
+			Comment:  strings.TrimSpace(strings.TrimPrefix(member.GetComment(), "//")),
+		}
+		if zeroValue(tp, false) == "nil" {
+			field.Optional = true
+		}
+		if field.Optional {
+			field.Type += "?"
+		}
+		if strings.Trim(field.Name, "`") != field.Wire {
+			field.RawValue = field.Wire
+		}
+		if field.Location != util.JSONLocation {
+			field.Default = zeroValue(tp, field.Optional)
+		}
+
+		st.Fields = append(st.Fields, field)
+	}
+
+	return st, nil
+}
+
+func buildRoute(g spec.Group, r spec.Route, structs map[string]swiftStruct) (swiftRoute, error) {
+	route := swiftRoute{
+		Func:   propertyName(strings.TrimSuffix(r.Handler, "Handler")),
+		Method: strings.ToUpper(r.Method),
+		Path:   strconv.Quote(r.Path),
+		Docs:   routeDocs(r),
+		Auth:   len(g.GetAnnotation(authKey)) > 0,
+	}
+
+	if r.RequestType != nil {
+		ds, ok := r.RequestType.(spec.DefineStruct)
+		if !ok {
+			return route, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+				r.RequestType.Name())
+		}
+
+		st := structs[ds.RawName]
+		route.Request = st.Name
+		paths := make(map[string]string)
+		for _, field := range st.Fields {
+			switch field.Location {
+			case util.PathLocation:
+				paths[field.Wire] = field.Name
+			case util.FormLocation:
+				route.Query = append(route.Query, field)
+			case util.HeaderLocation:
+				route.Headers = append(route.Headers, field)
+			default:
+				route.Body = true
+			}
+		}
+
+		path, err := pathExpr(r.Path, paths)
+		if err != nil {
+			return route, err
+		}
+		route.Path = path
+	} else if util.HasPathParams(r.Path) {
+		return route, fmt.Errorf("route %s %s: path parameters require a request type", r.Method, r.Path)
+	}
+
+	if r.ResponseType != nil {
+		name, err := typeName(util.UnwrapPointer(r.ResponseType))
+		if err != nil {
+			return route, fmt.Errorf("route %s %s: %w", r.Method, r.Path, err)
+		}
+		route.Response = name
+	}
+
+	return route, nil
+}
+
+// pathExpr returns the swift string literal of the path, the path parameters are
+// interpolated with the escaped request fields.
+func pathExpr(path string, params map[string]string) (string, error) {
+	expr, err := util.ReplacePathParams(path, func(name string) (string, bool) {
+		property, ok := params[name]
+		return fmt.Sprintf(`\(escapePath(req.%s))`, property), ok
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return `"` + expr + `"`, nil
+}
+
+func typeName(tp spec.Type) (string, error) {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		return primitiveName(v.RawName), nil
+	case spec.DefineStruct:
+		return v.RawName, nil
+	case spec.InterfaceType:
+		return "JSONValue", nil
+	case spec.PointerType:
+		return typeName(util.UnwrapPointer(v))
+	case spec.ArrayType:
+		name, err := typeName(v.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[%s]", name), nil
+	case spec.MapType:
+		name, err := typeName(v.Value)
+		if err != nil {
+			return "", err
+		}
+		// JSONEncoder encodes the dictionaries with non string keys as arrays,
+		// so the keys are always decoded as strings like encoding/json does.
+		return fmt.Sprintf("[String: %s]", name), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", tp.Name())
+	}
+}
+
+func primitiveName(name string) string {
+	switch name {
+	case "bool":
+		return "Bool"
+	case "string":
+		return "String"
+	case "float32":
+		return "Float"
+	case "float64":
+		return "Double"
+	case "int":
+		return "Int"
+	case "int8", "int16", "int32", "int64":
+		return "Int" + strings.TrimPrefix(name, "int")
+	case "uint":
+		return "UInt"
+	case "uint8", "uint16", "uint32", "uint64":
+		return "UInt" + strings.TrimPrefix(name, "uint")
+	case "byte":
+		return "UInt8"
+	case "rune":
+		return "Int32"
+	case "uintptr":
+		return "UInt"
+	default:
+		return "JSONValue"
+	}
+}
+
+func zeroValue(tp spec.Type, optional bool) string {
+	if optional {
+		return "nil"
+	}
+
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		switch primitiveName(v.RawName) {
+		case "Bool":
+			return "false"
+		case "String":
+			return `""`
+		case "JSONValue":
+			return "nil"
+		default:
+			return "0"
+		}
+	case spec.ArrayType:
+		return "[]"
+	case spec.MapType:
+		return "[:]"
+	default:
+		return "nil"
+	}
+}
+
+func propertyName(name string) string {
+	property := strcase.ToLowerCamel(name)
+	if keywords[property] {
+		return "`" + property + "`"
+	}
+	return property
+}
+
+func routeDocs(r spec.Route) []string {
+	if doc := strings.Trim(r.JoinedDoc(), `"`); len(doc) > 0 {
+		return []string{doc}
+	}
+	return nil
+}
+
+func trimComments(docs spec.Doc) []string {
+	var result []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(doc), "//"))
+		if len(doc) > 0 {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
@@ -0,0 +1,396 @@
+package tsgen
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	apiutil "github.com/yeyudekuangxiang/goctl/api/util"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+const (
+	adapterFetch = "fetch"
+	adapterAxios = "axios"
+	authKey      = "jwt"
+	groupKey     = "group"
+)
+
+var (
+	//go:embed runtime-fetch.tpl
+	runtimeFetchTemplate string
+	//go:embed runtime-axios.tpl
+	runtimeAxiosTemplate string
+	//go:embed types.tpl
+	typesTemplate string
+	//go:embed schemas.tpl
+	schemasTemplate string
+	//go:embed module.tpl
+	moduleTemplate string
+	//go:embed index.tpl
+	indexTemplate string
+
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	reservedModules = map[string]bool{"index": true, "runtime": true, "types": true, "schemas": true}
+)
+
+type (
+	tsPackage struct {
+		Service    string
+		Interfaces []tsInterface
+		Modules    []*tsModule
+		Zod        bool
+	}
+
+	tsInterface struct {
+		Name   string
+		Docs   []string
+		Fields []tsField
+	}
+
+	tsField struct {
+		// Key is the property key, quoted if the name is not an identifier
+		Key      string
+		Wire     string
+		Location string
+		Type     string
+		Schema   string
+		Optional bool
+		Comment  string
+	}
+
+	tsModule struct {
+		Name      string
+		Functions []tsFunction
+		// Types are the interfaces imported from types.ts
+		Types []string
+		// Schemas are the schemas of the responses imported from schemas.ts
+		Schemas []string
+		// Zod is true if the module uses z to validate a non struct response
+		Zod bool
+	}
+
+	tsFile struct {
+		name     string
+		template string
+		data     interface{}
+	}
+
+	tsFunction struct {
+		Name     string
+		Method   string
+		Path     string
+		Docs     []string
+		Request  string
+		Response string
+		Schema   string
+		Query    []tsField
+		Headers  []tsField
+		Body     []tsField
+		Auth     bool
+	}
+)
+
+// Accessor returns the expression which reads the field from req
+func (f tsField) Accessor() string {
+	if identifierRegex.MatchString(f.Wire) {
+		return "req." + f.Wire
+	}
+	return fmt.Sprintf("req[%s]", strconv.Quote(f.Wire))
+}
+
+// genClient generates the typescript client with the adapter, one module per group
+func genClient(dir, adapter string, zod bool, api *spec.ApiSpec) error {
+	var runtime string
+	switch adapter {
+	case adapterFetch:
+		runtime = runtimeFetchTemplate
+	case adapterAxios:
+		runtime = runtimeAxiosTemplate
+	default:
+		return fmt.Errorf("unsupported adapter %q, expected fetch or axios", adapter)
+	}
+
+	pkg, err := buildTsPackage(api, zod)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := []tsFile{
+		{"runtime.ts", runtime, pkg},
+		{"types.ts", typesTemplate, pkg},
+		{"index.ts", indexTemplate, pkg},
+	}
+	if zod {
+		files = append(files, tsFile{"schemas.ts", schemasTemplate, pkg})
+	}
+	for _, module := range pkg.Modules {
+		files = append(files, tsFile{module.Name + ".ts", moduleTemplate, module})
+	}
+
+	for _, file := range files {
+		if err := executeTs(filepath.Join(dir, file.name), file.template, file.data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func executeTs(filename, text string, data interface{}) error {
+	t, err := template.New(filepath.Base(filename)).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	fp, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	return t.Execute(fp, data)
+}
+
+func buildTsPackage(api *spec.ApiSpec, zod bool) (tsPackage, error) {
+	pkg := tsPackage{Service: api.Service.Name, Zod: zod}
+	types := apiutil.DefinedStructs(api)
+	interfaces := make(map[string]tsInterface)
+	for _, tp := range api.Types {
+		ds, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		item, err := buildTsInterface(ds, types)
+		if err != nil {
+			return pkg, err
+		}
+
+		interfaces[ds.RawName] = item
+		pkg.Interfaces = append(pkg.Interfaces, item)
+	}
+
+	modules := make(map[string]*tsModule)
+	for _, g := range api.Service.Groups {
+		name := moduleName(api.Service.Name, g.GetAnnotation(groupKey))
+		module, ok := modules[name]
+		if !ok {
+			module = &tsModule{Name: name}
+			modules[name] = module
+			pkg.Modules = append(pkg.Modules, module)
+		}
+
+		for _, r := range g.Routes {
+			fn, err := buildTsFunction(g, r, interfaces)
+			if err != nil {
+				return pkg, err
+			}
+
+			if len(fn.Request) > 0 {
+				module.Types = appendUnique(module.Types, fn.Request)
+			}
+			if r.ResponseType != nil {
+				names := structNames(r.ResponseType)
+				module.Types = appendUnique(module.Types, names...)
+				if zod {
+					fn.Schema = schemaExpr(apiutil.UnwrapPointer(r.ResponseType))
+					if _, ok := apiutil.UnwrapPointer(r.ResponseType).(spec.DefineStruct); !ok {
+						module.Zod = true
+					}
+					for _, name := range names {
+						module.Schemas = appendUnique(module.Schemas, name+"Schema")
+					}
+				}
+			}
+			module.Functions = append(module.Functions, fn)
+		}
+	}
+
+	return pkg, nil
+}
+
+func appendUnique(list []string, items ...string) []string {
+	for _, item := range items {
+		if !stringx.Contains(list, item) {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func buildTsInterface(ds spec.DefineStruct, types map[string]spec.DefineStruct) (tsInterface, error) {
+	item := tsInterface{Name: util.Title(ds.RawName), Docs: docComments(ds.Docs)}
+	for _, member := range apiutil.Fields(ds, types) {
+		tp, err := goTypeToTs(member.Type, false)
+		if err != nil {
+			return item, apiutil.WrapErr(err, " type "+ds.RawName)
+		}
+
+		key := member.WireName
+		if !identifierRegex.MatchString(key) {
+			key = strconv.Quote(key)
+		}
+		schema := schemaExpr(apiutil.UnwrapPointer(member.Type))
+		if member.Optional {
+			schema += ".optional()"
+		}
+		item.Fields = append(item.Fields, tsField{
+			Key:      key,
+			Wire:     member.WireName,
+			Location: member.Location,
+			Type:     tp,
+			Schema:   schema,
+			Optional: member.Optional,
+			Comment:  strings.TrimSpace(strings.TrimPrefix(member.GetComment(), "//")),
+		})
+	}
+
+	return item, nil
+}
+
+func buildTsFunction(g spec.Group, r spec.Route, interfaces map[string]tsInterface) (tsFunction, error) {
+	if len(r.Handler) == 0 {
+		return tsFunction{}, fmt.Errorf("missing handler annotation for route %q", r.Path)
+	}
+
+	fn := tsFunction{
+		Name:     strcase.ToLowerCamel(strings.TrimSuffix(r.Handler, "Handler")),
+		Method:   strings.ToUpper(r.Method),
+		Path:     strconv.Quote(r.Path),
+		Docs:     routeDocs(r),
+		Response: "void",
+		Auth:     len(g.GetAnnotation(authKey)) > 0,
+	}
+
+	if r.RequestType != nil {
+		ds, ok := r.RequestType.(spec.DefineStruct)
+		if !ok {
+			return fn, fmt.Errorf("route %s %s: request type %s is not a struct", r.Method, r.Path,
+				r.RequestType.Name())
+		}
+
+		item := interfaces[ds.RawName]
+		fn.Request = item.Name
+		paths := make(map[string]tsField)
+		for _, field := range item.Fields {
+			switch field.Location {
+			case apiutil.PathLocation:
+				paths[field.Wire] = field
+			case apiutil.FormLocation:
+				fn.Query = append(fn.Query, field)
+			case apiutil.HeaderLocation:
+				fn.Headers = append(fn.Headers, field)
+			default:
+				fn.Body = append(fn.Body, field)
+			}
+		}
+
+		path, err := apiutil.ReplacePathParams(r.Path, func(name string) (string, bool) {
+			field, ok := paths[name]
+			return fmt.Sprintf("${encodeURIComponent(String(%s))}", field.Accessor()), ok
+		})
+		if err != nil {
+			return fn, err
+		}
+		if path != r.Path {
+			fn.Path = "`" + path + "`"
+		}
+	} else if apiutil.HasPathParams(r.Path) {
+		return fn, fmt.Errorf("route %s %s: path parameters require a request type", r.Method, r.Path)
+	}
+
+	if r.ResponseType != nil {
+		tp, err := goTypeToTs(r.ResponseType, false)
+		if err != nil {
+			return fn, err
+		}
+		fn.Response = tp
+	}
+
+	return fn, nil
+}
+
+// schemaExpr returns the zod schema expression of the type, the structs refer to
+// the schemas declared in schemas.ts.
+func schemaExpr(tp spec.Type) string {
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		return util.Title(v.RawName) + "Schema"
+	case spec.PrimitiveType:
+		ts, _ := primitiveType(v.RawName)
+		switch ts {
+		case "string":
+			return "z.string()"
+		case "number":
+			return "z.number()"
+		case "boolean":
+			return "z.boolean()"
+		}
+		return "z.any()"
+	case spec.PointerType:
+		return schemaExpr(apiutil.UnwrapPointer(v))
+	case spec.ArrayType:
+		return fmt.Sprintf("z.array(%s)", schemaExpr(v.Value))
+	case spec.MapType:
+		return fmt.Sprintf("z.record(%s)", schemaExpr(v.Value))
+	default:
+		return "z.any()"
+	}
+}
+
+// structNames returns the names of the structs the type refers to
+func structNames(tp spec.Type) []string {
+	switch v := apiutil.UnwrapPointer(tp).(type) {
+	case spec.DefineStruct:
+		return []string{util.Title(v.RawName)}
+	case spec.ArrayType:
+		return structNames(v.Value)
+	case spec.MapType:
+		return structNames(v.Value)
+	}
+	return nil
+}
+
+func moduleName(service, group string) string {
+	name := strings.TrimSuffix(service, "-api")
+	if len(group) > 0 {
+		name = strings.NewReplacer("/", "_", "-", "_").Replace(group)
+	}
+
+	name = strcase.ToLowerCamel(name)
+	if reservedModules[name] {
+		name += "Api"
+	}
+	return name
+}
+
+func routeDocs(r spec.Route) []string {
+	if doc := strings.Trim(r.JoinedDoc(), `"`); len(doc) > 0 {
+		return []string{doc}
+	}
+	return nil
+}
+
+func docComments(docs spec.Doc) []string {
+	var result []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(doc), "//"))
+		if len(doc) > 0 {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package tsgen
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenClient(t *testing.T) {
+	api, err := parser.Parse("testdata/example.api")
+	assert.Nil(t, err)
+	api.Service = api.Service.JoinPrefix()
+
+	dir := t.TempDir()
+	assert.Nil(t, genClient(dir, adapterFetch, true, api))
+	assertGolden(t, dir, "runtime.ts", "runtime-fetch.ts")
+	for _, name := range []string{"types.ts", "schemas.ts", "index.ts", "user.ts"} {
+		assertGolden(t, dir, name, name)
+	}
+
+	dir = t.TempDir()
+	assert.Nil(t, genClient(dir, adapterAxios, false, api))
+	assertGolden(t, dir, "runtime.ts", "runtime-axios.ts")
+	assertGolden(t, dir, "user.ts", "user-no-zod.ts")
+
+	assert.Error(t, genClient(t.TempDir(), "jquery", false, api))
+}
+
+func TestModuleName(t *testing.T) {
+	assert.Equal(t, "user", moduleName("user-api", ""))
+	assert.Equal(t, "adminUser", moduleName("user-api", "admin/user"))
+	assert.Equal(t, "typesApi", moduleName("user-api", "types"))
+}
+
+func assertGolden(t *testing.T, dir, name, golden string) {
+	actual, err := ioutil.ReadFile(filepath.Join(dir, name))
+	assert.Nil(t, err)
+
+	golden = filepath.Join("testdata", "golden", golden+".golden")
+	if *update {
+		assert.Nil(t, ioutil.WriteFile(golden, actual, 0o644))
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), string(actual), name)
+}
@@ -22,6 +22,10 @@ var (
 	VarStringCaller string
 	// VarBoolUnWrap describes whether wrap or not.
 	VarBoolUnWrap bool
+	// VarStringAdapter describes the http adapter of the generated client, fetch or axios.
+	VarStringAdapter string
+	// VarBoolZod describes whether to generate zod schemas to validate the responses.
+	VarBoolZod bool
 )
 
 // TsCommand provides the entry to generate typescript codes
@@ -50,6 +54,19 @@ func TsCommand(_ *cobra.Command, _ []string) error {
 	}
 
 	api.Service = api.Service.JoinPrefix()
+	if len(VarStringAdapter) > 0 {
+		if err := genClient(dir, VarStringAdapter, VarBoolZod, api); err != nil {
+			return err
+		}
+
+		fmt.Println(aurora.Green("Done."))
+		return nil
+	}
+
+	if VarBoolZod {
+		return errors.New("--zod requires --adapter")
+	}
+
 	logx.Must(pathx.MkdirIfNotExist(dir))
 	logx.Must(genHandler(dir, webAPI, caller, api, unwrapAPI))
 	logx.Must(genComponents(dir, api))
@@ -0,0 +1,177 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+type (
+	// Example generates the example values of the types in the json bodies, the structs are
+	// walked by their json fields, and the self referencing types end with nil or no items.
+	Example struct {
+		// Types resolves the structs by the names.
+		Types map[string]spec.DefineStruct
+		// Rule returns the value of the primitive field by the rule of its tag like default=1,
+		// ok is false if there is no rule.
+		Rule func(field Field, tp string) (interface{}, bool)
+		// Primitive returns the value of the primitive type, the name is the wire name of the field.
+		Primitive func(tp, name string) interface{}
+		// Items returns the number of the items of the arrays and the maps, there is no item if nil.
+		Items func() int
+		// Required skips the optional fields of the structs.
+		Required bool
+		// Ordered returns the structs as Object which keeps the order of the fields, they're
+		// returned as map[string]interface{} otherwise.
+		Ordered bool
+
+		visiting map[string]bool
+	}
+
+	// Object is a json object which keeps the order of the properties.
+	Object []Property
+
+	// Property is a property of Object.
+	Property struct {
+		Key   string
+		Value interface{}
+	}
+)
+
+// Field returns the example value of the field, the rule of the tag is preferred.
+func (e *Example) Field(field Field) interface{} {
+	if tp, ok := UnwrapPointer(field.Type).(spec.PrimitiveType); ok && e.Rule != nil {
+		if value, ok := e.Rule(field, tp.RawName); ok {
+			return value
+		}
+	}
+
+	return e.Value(field.Type, field.WireName)
+}
+
+// Value returns the example value of the type, the name is the wire name of the field.
+func (e *Example) Value(tp spec.Type, name string) interface{} {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		return e.Primitive(v.RawName, name)
+	case spec.PointerType:
+		return e.Value(UnwrapPointer(v), name)
+	case spec.ArrayType:
+		values := []interface{}{}
+		for i := e.items(v.Value); i > 0; i-- {
+			values = append(values, e.Value(v.Value, name))
+		}
+		return values
+	case spec.MapType:
+		values := make(map[string]interface{})
+		for i := e.items(v.Value); i > 0; i-- {
+			values[fmt.Sprintf("key%d", i)] = e.Value(v.Value, name)
+		}
+		return values
+	case spec.DefineStruct:
+		return e.structValue(v)
+	case spec.InterfaceType:
+		return map[string]interface{}{}
+	default:
+		return nil
+	}
+}
+
+func (e *Example) structValue(ds spec.DefineStruct) interface{} {
+	if e.visiting[ds.RawName] {
+		return nil
+	}
+	if declared, ok := e.Types[ds.RawName]; ok {
+		ds = declared
+	}
+
+	if e.visiting == nil {
+		e.visiting = make(map[string]bool)
+	}
+	e.visiting[ds.RawName] = true
+	defer delete(e.visiting, ds.RawName)
+
+	var object Object
+	for _, field := range Fields(ds, e.Types) {
+		if field.Location != JSONLocation || e.Required && field.Optional {
+			continue
+		}
+
+		object = append(object, Property{Key: field.WireName, Value: e.Field(field)})
+	}
+	if e.Ordered {
+		if object == nil {
+			return Object{}
+		}
+		return object
+	}
+
+	values := make(map[string]interface{}, len(object))
+	for _, each := range object {
+		values[each.Key] = each.Value
+	}
+	return values
+}
+
+// items returns the number of the items of the arrays and the maps, the self referencing
+// items are skipped.
+func (e *Example) items(tp spec.Type) int {
+	if ds, ok := UnwrapPointer(tp).(spec.DefineStruct); ok && e.visiting[ds.RawName] {
+		return 0
+	}
+	if e.Items == nil {
+		return 0
+	}
+	return e.Items()
+}
+
+// PrimitiveValue returns the json value of the primitive type parsed from the value, it's the
+// zero value if the value is empty or invalid.
+func PrimitiveValue(tp, value string) interface{} {
+	switch tp {
+	case "bool":
+		b, _ := strconv.ParseBool(value)
+		return b
+	case "string":
+		return value
+	case "float32", "float64":
+		v, _ := strconv.ParseFloat(value, 64)
+		return v
+	default:
+		if strings.HasPrefix(tp, "int") || strings.HasPrefix(tp, "uint") || tp == "byte" || tp == "rune" {
+			v, _ := strconv.ParseInt(value, 10, 64)
+			return v
+		}
+		return nil
+	}
+}
+
+// MarshalJSON implements json.Marshaler
+func (o Object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, each := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(each.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(each.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,116 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+const (
+	// JSONLocation means the field is carried in the json body.
+	JSONLocation = "json"
+	// FormLocation means the field is carried in the query string or the form body.
+	FormLocation = "form"
+	// PathLocation means the field is carried in the path of the route.
+	PathLocation = "path"
+	// HeaderLocation means the field is carried in the http header.
+	HeaderLocation = "header"
+)
+
+var pathParamRegex = regexp.MustCompile(`/:([^/]+)`)
+
+// Field is a member of a struct with the location where it is carried in the http request.
+type Field struct {
+	spec.Member
+	// Location is one of json, form, path and header.
+	Location string
+	// WireName is the name of the field in the json body, the form, the path or the header.
+	WireName string
+	// Optional is true if the member is a pointer or its tag has optional, omitempty or default.
+	Optional bool
+}
+
+// DefinedStructs returns the structs declared in the api by name.
+func DefinedStructs(api *spec.ApiSpec) map[string]spec.DefineStruct {
+	types := make(map[string]spec.DefineStruct)
+	for _, tp := range api.Types {
+		if ds, ok := tp.(spec.DefineStruct); ok {
+			types[ds.RawName] = ds
+		}
+	}
+	return types
+}
+
+// Fields returns the fields of the struct with the inline members flattened, the members
+// without tags are carried in the json body, and the members tagged with "-" are skipped.
+func Fields(ds spec.DefineStruct, types map[string]spec.DefineStruct) []Field {
+	var fields []Field
+	for _, member := range ds.Members {
+		if member.IsInline {
+			inline, ok := UnwrapPointer(member.Type).(spec.DefineStruct)
+			if !ok {
+				continue
+			}
+			if declared, ok := types[inline.RawName]; ok {
+				inline = declared
+			}
+			fields = append(fields, Fields(inline, types)...)
+			continue
+		}
+
+		location, name, optional := member.Location()
+		if name == "-" {
+			continue
+		}
+		if len(location) == 0 {
+			location = JSONLocation
+		}
+		if _, ok := member.Type.(spec.PointerType); ok {
+			optional = true
+		}
+
+		fields = append(fields, Field{
+			Member:   member,
+			Location: location,
+			WireName: name,
+			Optional: optional,
+		})
+	}
+	return fields
+}
+
+// UnwrapPointer returns the type the pointer points to, or the type itself if it's not a pointer.
+func UnwrapPointer(tp spec.Type) spec.Type {
+	if pointer, ok := tp.(spec.PointerType); ok {
+		return UnwrapPointer(pointer.Type)
+	}
+	return tp
+}
+
+// HasPathParams returns true if the path has parameters like /users/:id.
+func HasPathParams(path string) bool {
+	return pathParamRegex.MatchString(path)
+}
+
+// ReplacePathParams replaces the parameters in the path with the result of replace, which
+// is called with the parameter name and returns false if the parameter is unknown.
+func ReplacePathParams(path string, replace func(name string) (string, bool)) (string, error) {
+	var err error
+	result := pathParamRegex.ReplaceAllStringFunc(path, func(segment string) string {
+		name := strings.TrimPrefix(segment, "/:")
+		value, ok := replace(name)
+		if !ok {
+			err = fmt.Errorf("path parameter %s of %s is not declared in the request", name, path)
+			return segment
+		}
+
+		return "/" + value
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
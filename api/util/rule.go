@@ -0,0 +1,90 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultOption = "default="
+	optionsOption = "options="
+	rangeOption   = "range="
+)
+
+type (
+	// Rule is the constraint declared in the tag of the field like default=1, options=a|b
+	// and range=[1:10].
+	Rule struct {
+		Default    string
+		HasDefault bool
+		Options    []string
+		Range      *Range
+	}
+
+	// Range is the range of a number, the bounds are exclusive if the side is open.
+	Range struct {
+		Min, Max            float64
+		LeftOpen, RightOpen bool
+	}
+)
+
+// Rule returns the constraint declared in the tag of the location of the field.
+func (f Field) Rule() Rule {
+	var r Rule
+	for _, tag := range f.Tags() {
+		if tag.Key != f.Location {
+			continue
+		}
+
+		for _, option := range tag.Options {
+			switch {
+			case strings.HasPrefix(option, defaultOption):
+				r.Default = strings.TrimPrefix(option, defaultOption)
+				r.HasDefault = true
+			case strings.HasPrefix(option, optionsOption):
+				r.Options = strings.Split(strings.TrimPrefix(option, optionsOption), "|")
+			case strings.HasPrefix(option, rangeOption):
+				r.Range = parseRange(strings.TrimPrefix(option, rangeOption))
+			}
+		}
+	}
+
+	return r
+}
+
+// Contains returns true if the value is in the range.
+func (r Range) Contains(v float64) bool {
+	if v < r.Min || v > r.Max {
+		return false
+	}
+	if r.LeftOpen && v == r.Min {
+		return false
+	}
+	return !r.RightOpen || v != r.Max
+}
+
+// parseRange parses the range like [1:10] or (0:1], it returns nil if the range is invalid.
+func parseRange(s string) *Range {
+	if len(s) < 2 {
+		return nil
+	}
+
+	r := Range{
+		LeftOpen:  s[0] == '(',
+		RightOpen: s[len(s)-1] == ')',
+	}
+	bounds := strings.Split(strings.Trim(s, "[]()"), ":")
+	if len(bounds) != 2 {
+		return nil
+	}
+
+	var err error
+	if r.Min, err = strconv.ParseFloat(bounds[0], 64); err != nil {
+		return nil
+	}
+	if r.Max, err = strconv.ParseFloat(bounds[1], 64); err != nil || r.Max < r.Min {
+		return nil
+	}
+
+	return &r
+}
@@ -0,0 +1,160 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/internal/version"
+)
+
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatSarif = "sarif"
+
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type (
+	sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name    string      `json:"name"`
+		Version string      `json:"version"`
+		Rules   []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID string `json:"id"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations,omitempty"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+	}
+)
+
+func printText(w io.Writer, diagnostics []spec.Diagnostic) error {
+	for _, each := range diagnostics {
+		if _, err := fmt.Fprintln(w, each.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJSON(w io.Writer, diagnostics []spec.Diagnostic) error {
+	if diagnostics == nil {
+		diagnostics = []spec.Diagnostic{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(diagnostics)
+}
+
+func printSarif(w io.Writer, diagnostics []spec.Diagnostic) error {
+	rules := make(map[string]struct{})
+	results := []sarifResult{}
+	for _, each := range diagnostics {
+		rules[each.Code] = struct{}{}
+		result := sarifResult{
+			RuleID:  each.Code,
+			Level:   sarifLevel(each.Severity),
+			Message: sarifMessage{Text: each.Message},
+		}
+		if len(each.File) > 0 {
+			location := sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: each.File},
+				},
+			}
+			// sarif regions are 1-based, the column of antlr starts from 0.
+			if each.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{
+					StartLine:   each.Line,
+					StartColumn: each.Column + 1,
+				}
+			}
+			result.Locations = append(result.Locations, location)
+		}
+		results = append(results, result)
+	}
+
+	driver := sarifDriver{
+		Name:    "goctl",
+		Version: version.BuildVersion,
+		Rules:   []sarifRule{},
+	}
+	for id := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{ID: id})
+	}
+	sort.Slice(driver.Rules, func(i, j int) bool {
+		return driver.Rules[i].ID < driver.Rules[j].ID
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool:    sarifTool{Driver: driver},
+				Results: results,
+			},
+		},
+	})
+}
+
+func sarifLevel(severity spec.Severity) string {
+	switch severity {
+	case spec.SeverityWarning:
+		return "warning"
+	case spec.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
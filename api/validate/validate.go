@@ -3,31 +3,56 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
 	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
 )
 
-// VarStringAPI describes an API.
-var VarStringAPI string
+var (
+	// VarStringAPI describes an API.
+	VarStringAPI string
+	// VarStringFormat describes the output format of diagnostics, text, json or sarif.
+	VarStringFormat string
+)
 
 // GoValidateApi verifies whether the api has a syntax error
-func GoValidateApi(_ *cobra.Command, _ []string) error {
+func GoValidateApi(cmd *cobra.Command, _ []string) error {
 	apiFile := VarStringAPI
 
 	if len(apiFile) == 0 {
 		return errors.New("missing -api")
 	}
 
-	spec, err := parser.Parse(apiFile)
+	_, diagnostics := parser.Diagnose(apiFile)
+	err := Print(cmd.OutOrStdout(), VarStringFormat, diagnostics)
 	if err != nil {
 		return err
 	}
 
-	err = spec.Validate()
-	if err == nil {
-		fmt.Println(aurora.Green("api format ok"))
+	if diagnostics.HasError() {
+		return fmt.Errorf("%s: %d problem(s) found", apiFile, len(diagnostics))
+	}
+
+	if VarStringFormat == "" || VarStringFormat == formatText {
+		fmt.Fprintln(cmd.OutOrStdout(), aurora.Green("api format ok"))
+	}
+	return nil
+}
+
+// Print writes the diagnostics to w in the specified format
+func Print(w io.Writer, format string, diagnostics []spec.Diagnostic) error {
+	switch format {
+	case "", formatText:
+		return printText(w, diagnostics)
+	case formatJSON:
+		return printJSON(w, diagnostics)
+	case formatSarif:
+		return printSarif(w, diagnostics)
+	default:
+		return fmt.Errorf("unsupported format %q, expected %s, %s or %s", format, formatText,
+			formatJSON, formatSarif)
 	}
-	return err
 }
@@ -0,0 +1,261 @@
+// Package bench generates the load of the routes of an api file or the methods of a proto
+// file against a running service, and reports the latencies and the errors.
+package bench
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	kindTimeout  = "timeout"
+	kindCanceled = "canceled"
+	kindRefused  = "connection refused"
+	kindUnknown  = "error"
+)
+
+type (
+	// Config describes the load of the benchmark
+	Config struct {
+		// Concurrency is the number of the workers which send the requests
+		Concurrency int
+		// RPS is the target requests per second of all the workers, zero means no limit
+		RPS float64
+		// Duration stops the benchmark after the duration, zero means no limit
+		Duration time.Duration
+		// Requests stops the benchmark after the number of requests, zero means no limit
+		Requests int64
+		// Timeout is the timeout of each request
+		Timeout time.Duration
+		// Seed is the seed of the weighted choices and the fake values
+		Seed int64
+	}
+
+	// Target is a route or a method which the requests are sent to
+	Target struct {
+		Name   string
+		Weight int
+		// Call sends a request, the seq is the sequence number of the request in the benchmark
+		Call func(ctx context.Context, r *rand.Rand, seq int64) error
+	}
+
+	// Error is the error of a call with the kind which it's counted by in the report
+	Error struct {
+		Kind string
+		Err  error
+	}
+
+	worker struct {
+		rand    *rand.Rand
+		targets []Target
+		weights []int
+		total   int
+		stats   []*Stats
+	}
+)
+
+// Error implements error
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Kind
+	}
+	return e.Kind + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Run sends the requests to the targets by their weights until the duration or the number
+// of requests is reached, or the ctx is done.
+func Run(ctx context.Context, c Config, targets []Target) (*Report, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("no target to benchmark")
+	}
+	if c.Duration <= 0 && c.Requests <= 0 {
+		return nil, errors.New("either the duration or the number of requests is required")
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = 1
+	}
+
+	var weights []int
+	var total int
+	for _, each := range targets {
+		if each.Weight < 0 {
+			return nil, errors.New("the weight of " + each.Name + " is negative")
+		}
+		total += each.Weight
+		weights = append(weights, total)
+	}
+	if total == 0 {
+		return nil, errors.New("the weights of the targets are all zero")
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if c.Duration > 0 {
+		runCtx, cancel = context.WithTimeout(runCtx, c.Duration)
+		defer cancel()
+	}
+
+	var (
+		tokens  chan struct{}
+		dropped int64
+		paced   = make(chan struct{})
+	)
+	if c.RPS > 0 {
+		tokens = make(chan struct{})
+		go func() {
+			defer close(paced)
+			pace(runCtx, tokens, c.RPS, &dropped)
+		}()
+	} else {
+		close(paced)
+	}
+
+	var (
+		seq     int64
+		wg      sync.WaitGroup
+		workers = make([]*worker, c.Concurrency)
+		start   = time.Now()
+	)
+	for i := range workers {
+		w := &worker{
+			rand:    rand.New(rand.NewSource(c.Seed + int64(i))),
+			targets: targets,
+			weights: weights,
+			total:   total,
+			stats:   make([]*Stats, len(targets)),
+		}
+		for j, each := range targets {
+			w.stats[j] = newStats(each.Name)
+		}
+		workers[i] = w
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if tokens != nil {
+					select {
+					case <-runCtx.Done():
+						return
+					case <-tokens:
+					}
+				} else if runCtx.Err() != nil {
+					return
+				}
+
+				n := atomic.AddInt64(&seq, 1)
+				if c.Requests > 0 && n > c.Requests {
+					return
+				}
+
+				// the requests in flight are not canceled at the end of the benchmark
+				w.call(ctx, c.Timeout, n)
+			}
+		}()
+	}
+	wg.Wait()
+	duration := time.Since(start)
+	// the ticks after the workers are done are not dropped ones
+	cancel()
+	<-paced
+
+	report := &Report{Duration: duration, Dropped: atomic.LoadInt64(&dropped)}
+	for i, each := range targets {
+		stats := newStats(each.Name)
+		for _, w := range workers {
+			stats.merge(w.stats[i])
+		}
+		report.Targets = append(report.Targets, stats)
+	}
+	return report, nil
+}
+
+func (w *worker) call(ctx context.Context, timeout time.Duration, seq int64) {
+	i := w.pick()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := w.targets[i].Call(ctx, w.rand, seq)
+	latency := time.Since(start)
+	// the requests interrupted by the parent ctx are not counted
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		return
+	}
+	w.stats[i].add(latency, err)
+}
+
+// pick chooses a target by the weights
+func (w *worker) pick() int {
+	n := w.rand.Intn(w.total)
+	for i, each := range w.weights {
+		if n < each {
+			return i
+		}
+	}
+	return len(w.weights) - 1
+}
+
+// pace sends a token to the workers at the rate, the tokens are dropped and counted if the
+// workers are busy.
+func pace(ctx context.Context, tokens chan<- struct{}, rps float64, dropped *int64) {
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rps))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			select {
+			case tokens <- struct{}{}:
+			default:
+				atomic.AddInt64(dropped, 1)
+			}
+		}
+	}
+}
+
+// kindOf classifies the error of a call
+func kindOf(err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return kindTimeout
+	case errors.Is(err, context.Canceled):
+		return kindCanceled
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return kindTimeout
+	}
+	if strings.Contains(err.Error(), "connection refused") {
+		return kindRefused
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err.Error()
+	}
+	return kindUnknown
+}
@@ -0,0 +1,158 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	ok := func(_ context.Context, _ *rand.Rand, _ int64) error {
+		return nil
+	}
+	fail := func(_ context.Context, _ *rand.Rand, _ int64) error {
+		return &Error{Kind: "boom", Err: errors.New("failed")}
+	}
+
+	report, err := Run(context.Background(), Config{Concurrency: 4, Requests: 400, Seed: 1}, []Target{
+		{Name: "ok", Weight: 3, Call: ok},
+		{Name: "fail", Weight: 1, Call: fail},
+	})
+	assert.Nil(t, err)
+
+	total := report.Total()
+	assert.Equal(t, int64(400), total.Requests)
+	assert.Equal(t, report.Targets[1].Requests, total.Errors)
+	assert.Equal(t, map[string]int64{"boom": total.Errors}, total.Kinds)
+	// the requests are distributed by the weights
+	assert.InDelta(t, 300, report.Targets[0].Requests, 60)
+
+	summary := total.Summary(report.Duration)
+	var count int64
+	for _, each := range summary.Histogram {
+		count += each.Count
+	}
+	assert.Equal(t, int64(400), count)
+	assert.Equal(t, "+Inf", summary.Histogram[len(summary.Histogram)-1].Le)
+	assert.True(t, summary.Latency.P50 <= summary.Latency.P99)
+	assert.True(t, summary.Latency.P99 <= summary.Latency.Max)
+}
+
+func TestRunRPS(t *testing.T) {
+	call := func(_ context.Context, _ *rand.Rand, _ int64) error {
+		return nil
+	}
+	report, err := Run(context.Background(), Config{Concurrency: 4, RPS: 50, Duration: 300 * time.Millisecond},
+		[]Target{{Name: "ok", Weight: 1, Call: call}})
+	assert.Nil(t, err)
+	assert.True(t, report.Total().Requests > 0)
+	assert.True(t, report.Total().Requests <= 16)
+	assert.Equal(t, int64(0), report.Dropped)
+
+	slow := func(_ context.Context, _ *rand.Rand, _ int64) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}
+	report, err = Run(context.Background(), Config{Concurrency: 1, RPS: 100, Duration: 300 * time.Millisecond},
+		[]Target{{Name: "slow", Weight: 1, Call: slow}})
+	assert.Nil(t, err)
+	// the ticks are dropped while the worker is busy
+	assert.True(t, report.Dropped > 0)
+}
+
+func TestRunTimeout(t *testing.T) {
+	call := func(ctx context.Context, _ *rand.Rand, _ int64) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	report, err := Run(context.Background(), Config{Requests: 2, Timeout: 10 * time.Millisecond},
+		[]Target{{Name: "slow", Weight: 1, Call: call}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{kindTimeout: 2}, report.Total().Kinds)
+}
+
+func TestRunInvalid(t *testing.T) {
+	_, err := Run(context.Background(), Config{Requests: 1}, nil)
+	assert.NotNil(t, err)
+	_, err = Run(context.Background(), Config{}, []Target{{Name: "a", Weight: 1}})
+	assert.NotNil(t, err)
+	_, err = Run(context.Background(), Config{Requests: 1}, []Target{{Name: "a"}})
+	assert.NotNil(t, err)
+}
+
+func TestReportPrint(t *testing.T) {
+	stats := newStats("GET /ping")
+	for i := 1; i <= 100; i++ {
+		var err error
+		if i%10 == 0 {
+			err = &Error{Kind: "http 500"}
+		}
+		stats.add(time.Duration(i)*time.Millisecond, err)
+	}
+	report := &Report{Duration: 2 * time.Second, Targets: []*Stats{stats}}
+
+	var buf bytes.Buffer
+	assert.Nil(t, report.Print(&buf, FormatJSON))
+	var result struct {
+		Duration float64   `json:"duration"`
+		Total    Summary   `json:"total"`
+		Targets  []Summary `json:"targets"`
+	}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Equal(t, float64(2), result.Duration)
+	assert.Equal(t, float64(50), result.Total.RPS)
+	assert.Equal(t, Latency{Min: 1, Mean: 50.5, P50: 50, P90: 90, P95: 95, P99: 99, Max: 100},
+		result.Total.Latency)
+	assert.Equal(t, map[string]int64{"http 500": 10}, result.Targets[0].Kinds)
+	assert.Equal(t, Bucket{Le: "100ms", Count: 50}, result.Total.Histogram[6])
+
+	buf.Reset()
+	assert.Nil(t, report.Print(&buf, FormatText))
+	text := buf.String()
+	assert.Contains(t, text, "100 requests, 10 errors, 50.00 rps")
+	assert.Contains(t, text, "<= 100ms")
+	assert.NotContains(t, text, "<= 200ms")
+	assert.Regexp(t, `GET /ping\s+http 500\s+10`, text)
+
+	assert.NotContains(t, text, "dropped")
+
+	report.Dropped = 3
+	buf.Reset()
+	assert.Nil(t, report.Print(&buf, FormatText))
+	assert.Contains(t, buf.String(), "3 requests dropped")
+
+	assert.NotNil(t, report.Print(&buf, "xml"))
+}
+
+func TestStatsReservoir(t *testing.T) {
+	a, b := newStats("a"), newStats("b")
+	for i := 1; i <= 3*reservoirSize; i++ {
+		a.add(time.Duration(i)*10*time.Microsecond, nil)
+		b.add(time.Duration(i)*10*time.Microsecond+time.Second, nil)
+	}
+	assert.Len(t, a.samples, reservoirSize)
+
+	summary := a.Summary(time.Second)
+	assert.Equal(t, 0.01, summary.Latency.Min)
+	assert.Equal(t, float64(300), summary.Latency.Max)
+	assert.InDelta(t, 150, summary.Latency.P50, 10)
+	assert.InDelta(t, 270, summary.Latency.P90, 10)
+
+	total := newStats("total")
+	total.merge(a)
+	total.merge(b)
+	assert.Len(t, total.samples, reservoirSize)
+	assert.Equal(t, int64(6*reservoirSize), total.Requests)
+	summary = total.Summary(time.Second)
+	assert.Equal(t, 0.01, summary.Latency.Min)
+	assert.Equal(t, float64(1300), summary.Latency.Max)
+	// the samples of both are kept in proportion to their requests
+	assert.InDelta(t, 1240, summary.Latency.P90, 20)
+	assert.Equal(t, int64(3*reservoirSize), summary.Histogram[len(summary.Histogram)-3].Count)
+}
@@ -0,0 +1,146 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	varStringAPI         string
+	varStringProto       string
+	varStringSliceIPath  []string
+	varStringTarget      string
+	varIntConcurrency    int
+	varFloatRPS          float64
+	varDurationDuration  time.Duration
+	varInt64Requests     int64
+	varDurationTimeout   time.Duration
+	varStringScenario    string
+	varStringSliceHeader []string
+	varStringFormat      string
+	varInt64Seed         int64
+
+	// Cmd describes a bench command.
+	Cmd = &cobra.Command{
+		Use:   "bench",
+		Short: "Benchmark a running service by the routes of an api file or the methods of a proto file",
+		Args:  cobra.NoArgs,
+		RunE:  benchCommand,
+	}
+)
+
+func init() {
+	Cmd.Flags().StringVar(&varStringAPI, "api", "", "The api file, the routes are benchmarked over http")
+	Cmd.Flags().StringVar(&varStringProto, "proto", "", "The proto file, the unary methods are benchmarked over grpc")
+	Cmd.Flags().StringSliceVarP(&varStringSliceIPath, "proto_path", "I", nil, "The import paths of the proto file, default the directory of the proto file")
+	Cmd.Flags().StringVar(&varStringTarget, "target", "", "The base url of the http service, e.g. http://127.0.0.1:8888, or the address of the grpc service, e.g. 127.0.0.1:8080")
+	Cmd.Flags().IntVarP(&varIntConcurrency, "concurrency", "c", 10, "The number of the concurrent workers")
+	Cmd.Flags().Float64Var(&varFloatRPS, "rps", 0, "The target requests per second, default no limit")
+	Cmd.Flags().DurationVarP(&varDurationDuration, "duration", "d", 10*time.Second, "The duration of the benchmark, 0 means until the number of requests is reached")
+	Cmd.Flags().Int64VarP(&varInt64Requests, "requests", "n", 0, "The total number of requests, default no limit")
+	Cmd.Flags().DurationVar(&varDurationTimeout, "timeout", 5*time.Second, "The timeout of each request")
+	Cmd.Flags().StringVar(&varStringScenario, "scenario", "", "The yaml file which selects the targets with their weights, params, headers and body")
+	Cmd.Flags().StringSliceVar(&varStringSliceHeader, "header", nil, "The headers of the requests like \"Authorization: Bearer xxx\", it's the metadata for grpc")
+	Cmd.Flags().StringVar(&varStringFormat, "format", FormatText, "The format of the report, text or json")
+	Cmd.Flags().Int64Var(&varInt64Seed, "seed", 0, "The seed of the fake requests, default the current time")
+}
+
+func benchCommand(_ *cobra.Command, _ []string) error {
+	if (len(varStringAPI) == 0) == (len(varStringProto) == 0) {
+		return errors.New("either --api or --proto is required")
+	}
+	if len(varStringTarget) == 0 {
+		return errors.New("missing --target")
+	}
+	if varStringFormat != FormatText && varStringFormat != FormatJSON {
+		return fmt.Errorf("unsupported format: %s", varStringFormat)
+	}
+
+	var scenario *Scenario
+	if len(varStringScenario) > 0 {
+		var err error
+		if scenario, err = LoadScenario(varStringScenario); err != nil {
+			return err
+		}
+	}
+
+	headers := make(http.Header)
+	for _, each := range varStringSliceHeader {
+		i := strings.Index(each, ":")
+		if i <= 0 {
+			return fmt.Errorf("invalid header %q, expected \"key: value\"", each)
+		}
+		headers.Add(strings.TrimSpace(each[:i]), strings.TrimSpace(each[i+1:]))
+	}
+
+	var targets []Target
+	if len(varStringAPI) > 0 {
+		api, err := parser.Parse(varStringAPI)
+		if err != nil {
+			return err
+		}
+		if err := api.Validate(); err != nil {
+			return err
+		}
+
+		target := varStringTarget
+		if !strings.Contains(target, "://") {
+			target = "http://" + target
+		}
+		client := &http.Client{Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			MaxIdleConnsPerHost: varIntConcurrency,
+		}}
+		if targets, err = HTTPTargets(api, target, client, headers, scenario); err != nil {
+			return err
+		}
+	} else {
+		conn, err := grpc.Dial(varStringTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		md := metadata.MD{}
+		for k, values := range headers {
+			md.Append(k, values...)
+		}
+		if targets, err = GRPCTargets(conn, varStringProto, varStringSliceIPath, md, scenario); err != nil {
+			return err
+		}
+	}
+
+	seed := varInt64Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	// the report of the requests so far is printed on interrupt
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	report, err := Run(ctx, Config{
+		Concurrency: varIntConcurrency,
+		RPS:         varFloatRPS,
+		Duration:    varDurationDuration,
+		Requests:    varInt64Requests,
+		Timeout:     varDurationTimeout,
+		Seed:        seed,
+	}, targets)
+	if err != nil {
+		return err
+	}
+
+	return report.Print(os.Stdout, varStringFormat)
+}
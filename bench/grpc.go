@@ -0,0 +1,242 @@
+package bench
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/pkg/protocompile"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// maxDepth stops faking the nested messages
+const maxDepth = 3
+
+var words = []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel"}
+
+type grpcMethod struct {
+	conn   grpc.ClientConnInterface
+	method protoreflect.MethodDescriptor
+	name   string
+	md     metadata.MD
+	entry  *Entry
+}
+
+// GRPCTargets returns the targets of the unary methods of the services in the proto file, the
+// requests are dynamic messages which are faked unless the body is given by the scenario, and
+// the md is sent as the metadata of the requests.
+func GRPCTargets(conn grpc.ClientConnInterface, filename string, protoPaths []string, md metadata.MD,
+	scenario *Scenario) ([]Target, error) {
+	if len(protoPaths) == 0 {
+		protoPaths = []string{filepath.Dir(filename)}
+	}
+
+	compiler := protocompile.Compiler{ImportPaths: protoPaths}
+	result, err := compiler.Compile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: result.Files})
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := files.FindFileByPath(result.Generate[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []protoreflect.MethodDescriptor
+	var names []string
+	services := file.Services()
+	for i := 0; i < services.Len(); i++ {
+		list := services.Get(i).Methods()
+		for j := 0; j < list.Len(); j++ {
+			method := list.Get(j)
+			methods = append(methods, method)
+			names = append(names, string(method.Parent().FullName())+"/"+string(method.Name()))
+		}
+	}
+
+	indexes, entries, err := scenario.entries(names, func(name string, i int) bool {
+		return matchMethod(name, methods[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []Target
+	for i, index := range indexes {
+		method := methods[index]
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			// the streaming methods are skipped unless they're selected by the scenario
+			if scenario == nil || len(scenario.Targets) == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("%s: streaming method is not supported", names[index])
+		}
+
+		m := &grpcMethod{
+			conn:   conn,
+			method: method,
+			name:   "/" + names[index],
+			md:     md,
+			entry:  entries[i],
+		}
+		targets = append(targets, Target{
+			Name:   names[index],
+			Weight: entries[i].Weight,
+			Call:   m.call,
+		})
+	}
+	return targets, nil
+}
+
+func (g *grpcMethod) call(ctx context.Context, r *rand.Rand, seq int64) error {
+	v := Values{Seq: seq, rand: r}
+	req := dynamicpb.NewMessage(g.method.Input())
+	body, ok, err := g.entry.renderBody(v)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if err := protojson.Unmarshal([]byte(body), req); err != nil {
+			return err
+		}
+	} else {
+		fakeMessage(r, req, 0)
+	}
+
+	headers, err := g.entry.renderHeaders(v)
+	if err != nil {
+		return err
+	}
+	if len(g.md) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, g.md)
+	}
+	for k, each := range headers {
+		ctx = metadata.AppendToOutgoingContext(ctx, k, each)
+	}
+
+	resp := dynamicpb.NewMessage(g.method.Output())
+	if err := g.conn.Invoke(ctx, g.name, req, resp); err != nil {
+		if s, ok := status.FromError(err); ok {
+			return &Error{Kind: "grpc " + s.Code().String(), Err: err}
+		}
+		return err
+	}
+	return nil
+}
+
+// matchMethod reports whether the name of the scenario is the method like "greet.Greet/SayHello",
+// "Greet/SayHello" or "SayHello".
+func matchMethod(name string, method protoreflect.MethodDescriptor) bool {
+	name = strings.TrimPrefix(name, "/")
+	service := method.Parent().(protoreflect.ServiceDescriptor)
+	switch name {
+	case string(service.FullName()) + "/" + string(method.Name()),
+		string(service.Name()) + "/" + string(method.Name()),
+		string(method.Name()):
+		return true
+	default:
+		return false
+	}
+}
+
+// fakeMessage fills the fields of the message with the fake values, only the first field of
+// each oneof is set.
+func fakeMessage(r *rand.Rand, msg protoreflect.Message, depth int) {
+	fields := msg.Descriptor().Fields()
+	oneofs := make(map[protoreflect.FullName]bool)
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil {
+			if oneofs[oneof.FullName()] {
+				continue
+			}
+			oneofs[oneof.FullName()] = true
+		}
+		if field.Message() != nil && !field.IsMap() && depth >= maxDepth {
+			continue
+		}
+
+		switch {
+		case field.IsList():
+			list := msg.Mutable(field).List()
+			for n := r.Intn(3) + 1; n > 0; n-- {
+				if field.Message() != nil {
+					item := list.NewElement()
+					fakeMessage(r, item.Message(), depth+1)
+					list.Append(item)
+				} else {
+					list.Append(fakeScalar(r, field))
+				}
+			}
+		case field.IsMap():
+			m := msg.Mutable(field).Map()
+			key := fakeScalar(r, field.MapKey()).MapKey()
+			if value := field.MapValue(); value.Message() != nil {
+				if depth >= maxDepth {
+					continue
+				}
+				item := m.NewValue()
+				fakeMessage(r, item.Message(), depth+1)
+				m.Set(key, item)
+			} else {
+				m.Set(key, fakeScalar(r, value))
+			}
+		case field.Message() != nil:
+			fakeMessage(r, msg.Mutable(field).Message(), depth+1)
+		default:
+			msg.Set(field, fakeScalar(r, field))
+		}
+	}
+}
+
+// fakeScalar returns the fake value of the scalar field, the strings are guessed by the name
+func fakeScalar(r *rand.Rand, field protoreflect.FieldDescriptor) protoreflect.Value {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(r.Intn(2) == 1)
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(r.Intn(values.Len())).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(r.Intn(1000) + 1))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(r.Intn(1000) + 1))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(r.Intn(1000) + 1))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(r.Intn(1000) + 1))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(r.Intn(100000)) / 100)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(r.Intn(100000)) / 100)
+	case protoreflect.BytesKind:
+		b := make([]byte, 8)
+		r.Read(b)
+		return protoreflect.ValueOfBytes(b)
+	default:
+		name := strings.ToLower(string(field.Name()))
+		word := words[r.Intn(len(words))]
+		switch {
+		case strings.Contains(name, "email"):
+			return protoreflect.ValueOfString(word + "@example.com")
+		case strings.Contains(name, "url"):
+			return protoreflect.ValueOfString("https://example.com/" + word)
+		default:
+			return protoreflect.ValueOfString(fmt.Sprintf("%s-%d", word, r.Intn(1000)))
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package bench
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestGRPCTargets(t *testing.T) {
+	var lock sync.Mutex
+	tokens := make(map[string]int)
+	listener := bufconn.Listen(1 << 20)
+	svr := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		// the unknown fields of the requests are kept by the empty message
+		var req emptypb.Empty
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+
+		method, _ := grpc.MethodFromServerStream(stream)
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		lock.Lock()
+		tokens[method+" "+first(md.Get("authorization"))]++
+		lock.Unlock()
+		if method == "/greet.Greet/Fail" {
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		return stream.SendMsg(&emptypb.Empty{})
+	}))
+	go svr.Serve(listener)
+	defer svr.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	targets, err := GRPCTargets(conn, "testdata/greet.proto", nil, metadata.Pairs("authorization", "x"), nil)
+	assert.Nil(t, err)
+	// the streaming method is skipped
+	assert.Len(t, targets, 2)
+	assert.Equal(t, "greet.Greet/SayHello", targets[0].Name)
+
+	report, err := Run(context.Background(), Config{Concurrency: 4, Requests: 40, Seed: 1}, targets)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), report.Targets[0].Errors)
+	assert.Equal(t, map[string]int64{"grpc Unavailable": report.Targets[1].Requests}, report.Targets[1].Kinds)
+	assert.Equal(t, map[string]int{
+		"/greet.Greet/SayHello x": int(report.Targets[0].Requests),
+		"/greet.Greet/Fail x":     int(report.Targets[1].Requests),
+	}, tokens)
+
+	scenario := &Scenario{Targets: []Entry{{Name: "Greet/SayHello", Body: `{"name": "{{.Seq}}", "kind": "KIND_FRIEND"}`}}}
+	assert.Nil(t, scenario.compile())
+	targets, err = GRPCTargets(conn, "testdata/greet.proto", nil, nil, scenario)
+	assert.Nil(t, err)
+	assert.Len(t, targets, 1)
+	report, err = Run(context.Background(), Config{Requests: 5}, targets)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), report.Total().Requests)
+	assert.Equal(t, int64(0), report.Total().Errors)
+
+	// the streaming method can't be selected
+	scenario = &Scenario{Targets: []Entry{{Name: "Watch"}}}
+	assert.Nil(t, scenario.compile())
+	_, err = GRPCTargets(conn, "testdata/greet.proto", nil, nil, scenario)
+	assert.NotNil(t, err)
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
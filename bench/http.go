@@ -0,0 +1,218 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/mock"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/util"
+)
+
+type httpRoute struct {
+	client  *http.Client
+	baseURL string
+	method  string
+	path    string
+	fields  []util.Field
+	types   map[string]spec.DefineStruct
+	headers http.Header
+	entry   *Entry
+}
+
+// HTTPTargets returns the targets of the routes in the api, the requests are sent to the base url
+// with the headers, and the values of the requests are faked unless they're given by the scenario.
+func HTTPTargets(api *spec.ApiSpec, baseURL string, client *http.Client, headers http.Header,
+	scenario *Scenario) ([]Target, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	types := util.DefinedStructs(api)
+	var routes []spec.Route
+	var names []string
+	for _, g := range api.Service.JoinPrefix().Groups {
+		for _, r := range g.Routes {
+			routes = append(routes, r)
+			names = append(names, strings.ToUpper(r.Method)+" "+r.Path)
+		}
+	}
+
+	indexes, entries, err := scenario.entries(names, func(name string, i int) bool {
+		return matchRoute(name, names[i], routes[i].Handler)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []Target
+	for i, index := range indexes {
+		r := routes[index]
+		route := &httpRoute{
+			client:  client,
+			baseURL: strings.TrimSuffix(baseURL, "/"),
+			method:  strings.ToUpper(r.Method),
+			path:    r.Path,
+			types:   types,
+			headers: headers,
+			entry:   entries[i],
+		}
+		if r.RequestType != nil {
+			ds, ok := r.RequestType.(spec.DefineStruct)
+			if !ok {
+				return nil, fmt.Errorf("route %s: request type %s is not a struct", names[index],
+					r.RequestType.Name())
+			}
+			if declared, ok := types[ds.RawName]; ok {
+				ds = declared
+			}
+			route.fields = util.Fields(ds, types)
+		}
+
+		targets = append(targets, Target{
+			Name:   names[index],
+			Weight: entries[i].Weight,
+			Call:   route.call,
+		})
+	}
+	return targets, nil
+}
+
+func (h *httpRoute) call(ctx context.Context, r *rand.Rand, seq int64) error {
+	req, err := h.request(ctx, r, seq)
+	if err != nil {
+		return err
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return &Error{Kind: fmt.Sprintf("http %d", resp.StatusCode)}
+	}
+	return nil
+}
+
+// request builds the request of the route, the params of the scenario take precedence over
+// the fake values.
+func (h *httpRoute) request(ctx context.Context, r *rand.Rand, seq int64) (*http.Request, error) {
+	v := Values{Seq: seq, rand: r}
+	faker := mock.NewFaker(r.Int63(), h.types)
+	params := make(map[string]string)
+	query := url.Values{}
+	header := http.Header{}
+	body := make(map[string]interface{})
+	for _, field := range h.fields {
+		value, ok, err := h.entry.param(field.WireName, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if field.Location == util.JSONLocation {
+			// the params which aren't json, e.g. {{.String 8}}, are taken as strings
+			if ok && json.Valid([]byte(value)) {
+				body[field.WireName] = json.RawMessage(value)
+			} else if ok {
+				body[field.WireName] = value
+			} else if !field.Optional {
+				body[field.WireName] = faker.Field(field)
+			}
+			continue
+		}
+
+		if !ok {
+			if field.Optional && field.Location != util.PathLocation {
+				continue
+			}
+			value = fmt.Sprint(faker.Field(field))
+		}
+		switch field.Location {
+		case util.PathLocation:
+			params[field.WireName] = url.PathEscape(value)
+		case util.FormLocation:
+			query.Set(field.WireName, value)
+		case util.HeaderLocation:
+			header.Set(field.WireName, value)
+		}
+	}
+
+	path, err := util.ReplacePathParams(h.path, func(name string) (string, bool) {
+		value, ok := params[name]
+		return value, ok
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	target := h.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	text, ok, err := h.entry.renderBody(v)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		reader = strings.NewReader(text)
+	} else if len(body) > 0 {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, h.method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, values := range h.headers {
+		for _, each := range values {
+			req.Header.Add(k, each)
+		}
+	}
+	for k, values := range header {
+		req.Header[k] = values
+	}
+	headers, err := h.entry.renderHeaders(v)
+	if err != nil {
+		return nil, err
+	}
+	for k, each := range headers {
+		req.Header.Set(k, each)
+	}
+	return req, nil
+}
+
+// matchRoute reports whether the name of the scenario is the route like "GET /users/:id",
+// or the handler of the route with or without the Handler suffix.
+func matchRoute(name, route, handler string) bool {
+	fields := strings.Fields(name)
+	if len(fields) == 2 && strings.ToUpper(fields[0])+" "+fields[1] == route {
+		return true
+	}
+	if len(handler) == 0 {
+		return false
+	}
+	handler = strings.TrimSuffix(handler, "Handler")
+	return strings.EqualFold(strings.TrimSuffix(name, "Handler"), handler)
+}
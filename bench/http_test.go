@@ -0,0 +1,116 @@
+package bench
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/mock"
+	"github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+const scenarioYaml = `targets:
+  - name: GET /v1/users/:id
+    weight: 3
+    params:
+      id: "{{.Int 1 9}}"
+    headers:
+      X-Seq: "{{.Seq}}"
+  - name: CreateUser
+    body: '{"name": "{{.String 6}}", "email": "{{.Pick "a@b.com" "c@d.com"}}"}'
+    params:
+      notify: "true"
+`
+
+func TestHTTPTargets(t *testing.T) {
+	api, err := parser.Parse("testdata/bench.api")
+	assert.Nil(t, err)
+	h, err := mock.NewMock(api).Handler()
+	assert.Nil(t, err)
+
+	var lock sync.Mutex
+	var requests []*http.Request
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, r)
+		lock.Unlock()
+		if r.URL.Path == "/v1/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		h.ServeHTTP(w, r)
+	}))
+	defer svr.Close()
+
+	targets, err := HTTPTargets(api, svr.URL, svr.Client(), http.Header{"Authorization": {"Bearer x"}}, nil)
+	assert.Nil(t, err)
+	assert.Len(t, targets, 3)
+	assert.Equal(t, "GET /v1/users/:id", targets[0].Name)
+
+	report, err := Run(context.Background(), Config{Concurrency: 4, Requests: 60, Seed: 1}, targets)
+	assert.Nil(t, err)
+	// the fake requests are valid against the mock, only the failing route has errors
+	assert.Equal(t, int64(0), report.Targets[0].Errors)
+	assert.Equal(t, int64(0), report.Targets[1].Errors)
+	assert.Equal(t, report.Targets[2].Requests, report.Targets[2].Errors)
+	assert.Equal(t, map[string]int64{"http 500": report.Targets[2].Errors}, report.Targets[2].Kinds)
+	for _, each := range requests {
+		assert.Equal(t, "Bearer x", each.Header.Get("Authorization"))
+	}
+}
+
+func TestHTTPScenario(t *testing.T) {
+	api, err := parser.Parse("testdata/bench.api")
+	assert.Nil(t, err)
+
+	filename := filepath.Join(t.TempDir(), "scenario.yaml")
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(scenarioYaml), os.ModePerm))
+	scenario, err := LoadScenario(filename)
+	assert.Nil(t, err)
+
+	var lock sync.Mutex
+	paths := make(map[string]int)
+	var bodies []string
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.Method == http.MethodPost {
+			data, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(data))
+			assert.Equal(t, "true", r.URL.Query().Get("notify"))
+			paths[r.URL.Path]++
+			return
+		}
+		assert.NotEmpty(t, r.Header.Get("X-Seq"))
+		assert.NotEmpty(t, r.Header.Get("X-Tenant"))
+		paths["user"]++
+		assert.Regexp(t, `^/v1/users/[1-9]$`, r.URL.Path)
+	}))
+	defer svr.Close()
+
+	targets, err := HTTPTargets(api, svr.URL, svr.Client(), nil, scenario)
+	assert.Nil(t, err)
+	assert.Len(t, targets, 2)
+	assert.Equal(t, 3, targets[0].Weight)
+	assert.Equal(t, "POST /v1/users", targets[1].Name)
+
+	report, err := Run(context.Background(), Config{Concurrency: 2, Requests: 80, Seed: 1}, targets)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), report.Total().Errors)
+	assert.Equal(t, 80, paths["user"]+paths["/v1/users"])
+	assert.True(t, paths["user"] > paths["/v1/users"])
+	for _, each := range bodies {
+		assert.Regexp(t, `^\{"name": "[a-z0-9]{6}", "email": "(a@b|c@d)\.com"\}$`, each)
+	}
+
+	_, err = HTTPTargets(api, svr.URL, nil, nil, &Scenario{Targets: []Entry{{Name: "DELETE /v1/users"}}})
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not found"))
+}
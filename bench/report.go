@@ -0,0 +1,326 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// FormatText prints the report as tables
+	FormatText = "text"
+	// FormatJSON prints the report as json
+	FormatJSON = "json"
+)
+
+// reservoirSize is the max number of the latencies sampled for the percentiles of a target
+const reservoirSize = 10000
+
+// bounds are the upper bounds of the latency histogram in milliseconds
+var bounds = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, math.Inf(1)}
+
+type (
+	// Report is the result of a benchmark
+	Report struct {
+		Duration time.Duration
+		Targets  []*Stats
+		// Dropped is the number of the requests not sent in time for the rps as the workers were busy
+		Dropped int64
+	}
+
+	// Stats collects the latencies and the errors of a target, the latencies are counted by the
+	// buckets of the histogram, and the percentiles are of a uniform sample of them.
+	Stats struct {
+		Name     string
+		Requests int64
+		Errors   int64
+		Kinds    map[string]int64
+		counts   []int64
+		sum      time.Duration
+		min      time.Duration
+		max      time.Duration
+		samples  []time.Duration
+		rand     *rand.Rand
+	}
+
+	// Summary is the summary of the stats in the report
+	Summary struct {
+		Name      string           `json:"name"`
+		Requests  int64            `json:"requests"`
+		Errors    int64            `json:"errors"`
+		RPS       float64          `json:"rps"`
+		Latency   Latency          `json:"latency"`
+		Histogram []Bucket         `json:"histogram"`
+		Kinds     map[string]int64 `json:"errorKinds,omitempty"`
+	}
+
+	// Latency is the latency distribution in milliseconds
+	Latency struct {
+		Min  float64 `json:"min"`
+		Mean float64 `json:"mean"`
+		P50  float64 `json:"p50"`
+		P90  float64 `json:"p90"`
+		P95  float64 `json:"p95"`
+		P99  float64 `json:"p99"`
+		Max  float64 `json:"max"`
+	}
+
+	// Bucket is a bucket of the latency histogram, Le is the upper bound of the bucket
+	Bucket struct {
+		Le    string `json:"le"`
+		Count int64  `json:"count"`
+	}
+
+	jsonReport struct {
+		Duration float64   `json:"duration"`
+		Dropped  int64     `json:"dropped"`
+		Total    Summary   `json:"total"`
+		Targets  []Summary `json:"targets"`
+	}
+)
+
+func newStats(name string) *Stats {
+	return &Stats{
+		Name:   name,
+		Kinds:  make(map[string]int64),
+		counts: make([]int64, len(bounds)),
+		rand:   rand.New(rand.NewSource(1)),
+	}
+}
+
+func (s *Stats) add(latency time.Duration, err error) {
+	s.Requests++
+	s.counts[sort.SearchFloat64s(bounds, milliseconds(latency))]++
+	s.sum += latency
+	if s.Requests == 1 || latency < s.min {
+		s.min = latency
+	}
+	if latency > s.max {
+		s.max = latency
+	}
+
+	// the reservoir sampling keeps each latency in the samples with the same probability
+	if len(s.samples) < reservoirSize {
+		s.samples = append(s.samples, latency)
+	} else if i := s.rand.Int63n(s.Requests); i < reservoirSize {
+		s.samples[i] = latency
+	}
+
+	if err != nil {
+		s.Errors++
+		s.Kinds[kindOf(err)]++
+	}
+}
+
+func (s *Stats) merge(other *Stats) {
+	if other.Requests == 0 {
+		return
+	}
+
+	if s.Requests == 0 || other.min < s.min {
+		s.min = other.min
+	}
+	if other.max > s.max {
+		s.max = other.max
+	}
+	s.samples = s.mergeSamples(other)
+	s.Requests += other.Requests
+	s.Errors += other.Errors
+	s.sum += other.sum
+	for i, each := range other.counts {
+		s.counts[i] += each
+	}
+	for k, v := range other.Kinds {
+		s.Kinds[k] += v
+	}
+}
+
+// mergeSamples merges the samples of the other stats, the samples of both are drawn in proportion
+// to the requests which they stand for if they exceed the reservoir.
+func (s *Stats) mergeSamples(other *Stats) []time.Duration {
+	if len(s.samples)+len(other.samples) <= reservoirSize {
+		return append(s.samples, other.samples...)
+	}
+
+	mine := append([]time.Duration(nil), s.samples...)
+	theirs := append([]time.Duration(nil), other.samples...)
+	s.rand.Shuffle(len(mine), func(i, j int) { mine[i], mine[j] = mine[j], mine[i] })
+	s.rand.Shuffle(len(theirs), func(i, j int) { theirs[i], theirs[j] = theirs[j], theirs[i] })
+
+	ret := make([]time.Duration, 0, reservoirSize)
+	for len(ret) < reservoirSize && (len(mine) > 0 || len(theirs) > 0) {
+		if len(theirs) == 0 || len(mine) > 0 && s.rand.Int63n(s.Requests+other.Requests) < s.Requests {
+			ret, mine = append(ret, mine[0]), mine[1:]
+		} else {
+			ret, theirs = append(ret, theirs[0]), theirs[1:]
+		}
+	}
+	return ret
+}
+
+// Total returns the stats of all the targets
+func (r *Report) Total() *Stats {
+	total := newStats("total")
+	for _, each := range r.Targets {
+		total.merge(each)
+	}
+	return total
+}
+
+// Summary returns the summary of the stats in the duration
+func (s *Stats) Summary(duration time.Duration) Summary {
+	ret := Summary{
+		Name:     s.Name,
+		Requests: s.Requests,
+		Errors:   s.Errors,
+		Kinds:    s.Kinds,
+	}
+	if duration > 0 {
+		ret.RPS = round(float64(s.Requests) / duration.Seconds())
+	}
+
+	for i, each := range bounds {
+		ret.Histogram = append(ret.Histogram, Bucket{Le: bucketName(each), Count: s.counts[i]})
+	}
+
+	if s.Requests == 0 {
+		return ret
+	}
+
+	samples := make([]float64, len(s.samples))
+	for i, each := range s.samples {
+		samples[i] = milliseconds(each)
+	}
+	sort.Float64s(samples)
+	ret.Latency = Latency{
+		Min:  round(milliseconds(s.min)),
+		Mean: round(milliseconds(s.sum) / float64(s.Requests)),
+		P50:  percentile(samples, 0.5),
+		P90:  percentile(samples, 0.9),
+		P95:  percentile(samples, 0.95),
+		P99:  percentile(samples, 0.99),
+		Max:  round(milliseconds(s.max)),
+	}
+	return ret
+}
+
+// Print prints the report in the format, text or json
+func (r *Report) Print(w io.Writer, format string) error {
+	total := r.Total().Summary(r.Duration)
+	var targets []Summary
+	for _, each := range r.Targets {
+		targets = append(targets, each.Summary(r.Duration))
+	}
+
+	switch format {
+	case FormatJSON:
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		return e.Encode(jsonReport{
+			Duration: round(r.Duration.Seconds()),
+			Dropped:  r.Dropped,
+			Total:    total,
+			Targets:  targets,
+		})
+	case FormatText, "":
+		return printText(w, r.Duration, r.Dropped, total, targets)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func printText(w io.Writer, duration time.Duration, dropped int64, total Summary, targets []Summary) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "duration %.2fs, %d requests, %d errors, %.2f rps\n",
+		duration.Seconds(), total.Requests, total.Errors, total.RPS)
+	if dropped > 0 {
+		fmt.Fprintf(tw, "%d requests dropped, the workers were too busy to reach the rps\n", dropped)
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "target\trequests\terrors\trps\tmin\tmean\tp50\tp90\tp95\tp99\tmax")
+	for _, each := range append(targets, total) {
+		l := each.Latency
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.2f\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", each.Name, each.Requests,
+			each.Errors, each.RPS, ms(l.Min), ms(l.Mean), ms(l.P50), ms(l.P90), ms(l.P95),
+			ms(l.P99), ms(l.Max))
+	}
+
+	fmt.Fprintln(tw, "\nlatency histogram")
+	last := -1
+	for i, each := range total.Histogram {
+		if each.Count > 0 {
+			last = i
+		}
+	}
+	for _, each := range total.Histogram[:last+1] {
+		var bar string
+		if total.Requests > 0 {
+			bar = strings.Repeat("#", int(each.Count*40/total.Requests))
+		}
+		fmt.Fprintf(tw, "  <= %s\t%d\t%s\n", each.Le, each.Count, bar)
+	}
+
+	if total.Errors > 0 {
+		fmt.Fprintln(tw, "\nerrors")
+		for _, each := range targets {
+			for _, kind := range sortedKinds(each.Kinds) {
+				fmt.Fprintf(tw, "  %s\t%s\t%d\n", each.Name, kind, each.Kinds[kind])
+			}
+		}
+	}
+	return tw.Flush()
+}
+
+// sortedKinds returns the error kinds by the counts in descending order
+func sortedKinds(kinds map[string]int64) []string {
+	var ret []string
+	for k := range kinds {
+		ret = append(ret, k)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if kinds[ret[i]] != kinds[ret[j]] {
+			return kinds[ret[i]] > kinds[ret[j]]
+		}
+		return ret[i] < ret[j]
+	})
+	return ret
+}
+
+// percentile returns the nearest rank percentile of the sorted values
+func percentile(sorted []float64, p float64) float64 {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return round(sorted[i])
+}
+
+func bucketName(bound float64) string {
+	switch {
+	case math.IsInf(bound, 1):
+		return "+Inf"
+	case bound >= 1000:
+		return fmt.Sprintf("%gs", bound/1000)
+	default:
+		return fmt.Sprintf("%gms", bound)
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func ms(v float64) string {
+	return fmt.Sprintf("%.2fms", v)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
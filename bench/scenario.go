@@ -0,0 +1,207 @@
+package bench
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+type (
+	// Scenario selects the targets of the benchmark with their weights and request values,
+	// all the targets are benchmarked with the same weight if no scenario is given.
+	Scenario struct {
+		Targets []Entry `yaml:"targets"`
+	}
+
+	// Entry is a target of the scenario, the name is a route like "GET /users/:id" or the
+	// handler of the api, or a method like "Greet/SayHello" of the proto. The values of the
+	// params, the headers and the body are templates of Values.
+	Entry struct {
+		Name    string            `yaml:"name"`
+		Weight  int               `yaml:"weight"`
+		Params  map[string]string `yaml:"params"`
+		Headers map[string]string `yaml:"headers"`
+		Body    string            `yaml:"body"`
+
+		params  map[string]*template.Template
+		headers map[string]*template.Template
+		body    *template.Template
+	}
+
+	// Values are the values of the templates in the scenario, e.g. {{.Seq}} or {{.Int 1 100}}
+	Values struct {
+		// Seq is the sequence number of the request
+		Seq  int64
+		rand *rand.Rand
+	}
+)
+
+// LoadScenario loads the scenario from the yaml file
+func LoadScenario(filename string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var s Scenario
+	if err := yaml.UnmarshalStrict(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	if err := s.compile(); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return &s, nil
+}
+
+func (s *Scenario) compile() error {
+	for i := range s.Targets {
+		e := &s.Targets[i]
+		if len(e.Name) == 0 {
+			return fmt.Errorf("the name of target %d is required", i+1)
+		}
+		if e.Weight < 0 {
+			return fmt.Errorf("%s: negative weight %d", e.Name, e.Weight)
+		}
+		if e.Weight == 0 {
+			e.Weight = 1
+		}
+
+		var err error
+		if e.params, err = compileAll(e.Name, e.Params); err != nil {
+			return err
+		}
+		if e.headers, err = compileAll(e.Name, e.Headers); err != nil {
+			return err
+		}
+		if len(e.Body) > 0 {
+			if e.body, err = template.New(e.Name).Parse(e.Body); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// entries returns the entries of the targets by the match, all the targets are returned
+// with the default entry if the scenario is nil.
+func (s *Scenario) entries(names []string, match func(name string, i int) bool) ([]int, []*Entry, error) {
+	var indexes []int
+	var entries []*Entry
+	if s == nil || len(s.Targets) == 0 {
+		for i := range names {
+			indexes = append(indexes, i)
+			entries = append(entries, &Entry{Name: names[i], Weight: 1})
+		}
+		return indexes, entries, nil
+	}
+
+	for i := range s.Targets {
+		e := &s.Targets[i]
+		index := -1
+		for j := range names {
+			if match(e.Name, j) {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			return nil, nil, fmt.Errorf("target %q not found", e.Name)
+		}
+		indexes = append(indexes, index)
+		entries = append(entries, e)
+	}
+	return indexes, entries, nil
+}
+
+// param returns the rendered value of the param, ok is false if the param is not given
+func (e *Entry) param(name string, v Values) (string, bool, error) {
+	t, ok := e.params[name]
+	if !ok {
+		return "", false, nil
+	}
+	s, err := render(t, v)
+	return s, true, err
+}
+
+// renderHeaders returns the rendered headers
+func (e *Entry) renderHeaders(v Values) (map[string]string, error) {
+	ret := make(map[string]string, len(e.headers))
+	for k, t := range e.headers {
+		s, err := render(t, v)
+		if err != nil {
+			return nil, err
+		}
+		ret[k] = s
+	}
+	return ret, nil
+}
+
+// renderBody returns the rendered body, ok is false if the body is not given
+func (e *Entry) renderBody(v Values) (string, bool, error) {
+	if e.body == nil {
+		return "", false, nil
+	}
+	s, err := render(e.body, v)
+	return s, true, err
+}
+
+// Int returns a random integer in [min, max]
+func (v Values) Int(min, max int) int {
+	if max <= min {
+		return min
+	}
+	return min + v.rand.Intn(max-min+1)
+}
+
+// String returns a random string of the length
+func (v Values) String(n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteByte(letters[v.rand.Intn(len(letters))])
+	}
+	return b.String()
+}
+
+// Pick returns one of the items randomly
+func (v Values) Pick(items ...string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	return items[v.rand.Intn(len(items))]
+}
+
+// UUID returns a random uuid
+func (v Values) UUID() string {
+	b := make([]byte, 16)
+	v.rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func compileAll(name string, values map[string]string) (map[string]*template.Template, error) {
+	ret := make(map[string]*template.Template, len(values))
+	for k, v := range values {
+		t, err := template.New(name + "." + k).Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		ret[k] = t
+	}
+	return ret, nil
+}
+
+func render(t *template.Template, v Values) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, v); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
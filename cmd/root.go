@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/withfig/autocomplete-tools/integrations/cobra"
 	"github.com/yeyudekuangxiang/goctl/api"
+	"github.com/yeyudekuangxiang/goctl/bench"
 	"github.com/yeyudekuangxiang/goctl/bug"
 	"github.com/yeyudekuangxiang/goctl/docker"
 	"github.com/yeyudekuangxiang/goctl/env"
@@ -49,7 +50,8 @@ var (
 func Execute() {
 	os.Args = supportGoStdFlag(os.Args)
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(aurora.Red(err.Error()))
+		// the reports in json or sarif are written to stdout, so the error can't be mixed with them
+		fmt.Fprintln(os.Stderr, aurora.Red(err.Error()))
 		os.Exit(codeFailure)
 	}
 }
@@ -118,6 +120,7 @@ func init() {
 
 	rootCmd.SetUsageTemplate(usageTpl)
 	rootCmd.AddCommand(api.Cmd)
+	rootCmd.AddCommand(bench.Cmd)
 	rootCmd.AddCommand(bug.Cmd)
 	rootCmd.AddCommand(docker.Cmd)
 	rootCmd.AddCommand(kube.Cmd)
@@ -11,7 +11,9 @@ import (
 	"github.com/yeyudekuangxiang/goctl/model/sql/command/migrationnotes"
 	"github.com/yeyudekuangxiang/goctl/model/sql/gen"
 	"github.com/yeyudekuangxiang/goctl/model/sql/model"
+	"github.com/yeyudekuangxiang/goctl/model/sql/parser"
 	"github.com/yeyudekuangxiang/goctl/model/sql/util"
+	"github.com/yeyudekuangxiang/goctl/plugin"
 	file "github.com/yeyudekuangxiang/goctl/util"
 	"github.com/yeyudekuangxiang/goctl/util/console"
 	"github.com/yeyudekuangxiang/goctl/util/pathx"
@@ -49,6 +51,8 @@ var (
 	VarStringBranch string
 	// VarAppName 应用名称
 	VarAppName string
+	// VarStringSlicePlugin describes the plugins invoked with the tables.
+	VarStringSlicePlugin []string
 )
 
 var errNotMatched = errors.New("sql not matched")
@@ -209,14 +213,24 @@ func fromDDL(appName string, src, dir string, cfg *config.Config, cache, idea bo
 		return err
 	}
 
+	var tables []*parser.Table
 	for _, file := range files {
 		err = generator.StartFromDDL(file, cache, database)
 		if err != nil {
 			return err
 		}
+
+		if len(VarStringSlicePlugin) > 0 {
+			list, err := parser.Parse(file, database)
+			if err != nil {
+				return err
+			}
+
+			tables = append(tables, list...)
+		}
 	}
 
-	return nil
+	return runPlugins(dir, cfg, tables)
 }
 
 func fromMysqlDataSource(appName string, url, dir string, tablePat pattern, cfg *config.Config, cache, idea bool) error {
@@ -274,7 +288,12 @@ func fromMysqlDataSource(appName string, url, dir string, tablePat pattern, cfg
 		return err
 	}
 
-	return generator.StartFromInformationSchema(matchTables, cache)
+	err = generator.StartFromInformationSchema(matchTables, cache)
+	if err != nil {
+		return err
+	}
+
+	return runPluginsWithSchema(dir, cfg, matchTables)
 }
 
 func fromPostgreSqlDataSource(appName, url, pattern, dir, schema string, cfg *config.Config, cache, idea bool) error {
@@ -329,5 +348,53 @@ func fromPostgreSqlDataSource(appName, url, pattern, dir, schema string, cfg *co
 		return err
 	}
 
-	return generator.StartFromInformationSchema(matchTables, cache)
+	err = generator.StartFromInformationSchema(matchTables, cache)
+	if err != nil {
+		return err
+	}
+
+	return runPluginsWithSchema(dir, cfg, matchTables)
+}
+
+func runPluginsWithSchema(dir string, cfg *config.Config, matchTables map[string]*model.Table) error {
+	if len(VarStringSlicePlugin) == 0 {
+		return nil
+	}
+
+	var tables []*parser.Table
+	for _, each := range matchTables {
+		table, err := parser.ConvertDataType(each)
+		if err != nil {
+			return err
+		}
+
+		tables = append(tables, table)
+	}
+
+	return runPlugins(dir, cfg, tables)
+}
+
+func runPlugins(dir string, cfg *config.Config, tables []*parser.Table) error {
+	if len(VarStringSlicePlugin) == 0 {
+		return nil
+	}
+
+	dirAbs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, each := range VarStringSlicePlugin {
+		err = plugin.Run(each, plugin.Request{
+			Kind:   plugin.KindModel,
+			Dir:    dirAbs,
+			Style:  cfg.NamingFormat,
+			Tables: plugin.NewTables(tables),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,133 @@
+package compat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/util"
+)
+
+const (
+	// Breaking marks a change which breaks the clients built on the old version.
+	Breaking Kind = "breaking"
+	// Compatible marks a change which is backward compatible.
+	Compatible Kind = "compatible"
+
+	formatText = "text"
+	formatJSON = "json"
+)
+
+type (
+	// Kind describes whether a change is breaking
+	Kind string
+
+	// Change describes a difference between two versions of a spec
+	Change struct {
+		Kind Kind `json:"kind"`
+		// Code identifies the type of the change, such as removed-route
+		Code string `json:"code"`
+		// Path is the path of the changed element, such as: get /user response.name
+		Path    string `json:"path"`
+		Message string `json:"message"`
+	}
+
+	// Report is a list of Change
+	Report []Change
+)
+
+// Add appends a change to the report
+func (r *Report) Add(kind Kind, code, path, format string, args ...interface{}) {
+	*r = append(*r, Change{
+		Kind:    kind,
+		Code:    code,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Breaking returns true if the report contains any breaking change
+func (r Report) Breaking() bool {
+	for _, each := range r {
+		if each.Kind == Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes the report in the specified format, text or json
+func (r Report) Print(w io.Writer, format string) error {
+	switch format {
+	case "", formatText:
+		if len(r) == 0 {
+			_, err := fmt.Fprintln(w, "no changes")
+			return err
+		}
+		for _, each := range r {
+			_, err := fmt.Fprintf(w, "%-10s %-22s %s: %s\n", each.Kind, each.Code, each.Path, each.Message)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case formatJSON:
+		changes := r
+		if changes == nil {
+			changes = Report{}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			Breaking bool   `json:"breaking"`
+			Changes  Report `json:"changes"`
+		}{
+			Breaking: r.Breaking(),
+			Changes:  changes,
+		})
+	default:
+		return fmt.Errorf("unsupported format %q, expected %s or %s", format, formatText, formatJSON)
+	}
+}
+
+// Resolve resolves the source into a local file, the source is either a file path
+// or a git revision in the form of <ref>:<path>, such as main:api/user.api, the
+// path of which is relative to the root of the git repository. The returned
+// cleanup function removes the files exported from git.
+func Resolve(source string) (string, func(), error) {
+	nop := func() {}
+	if _, err := os.Stat(source); err == nil {
+		return source, nop, nil
+	}
+
+	index := strings.Index(source, ":")
+	if index <= 0 {
+		return "", nop, fmt.Errorf("%s: no such file or git revision", source)
+	}
+
+	ref, path := source[:index], source[index+1:]
+	dir, err := ioutil.TempDir("", "goctl-compat-")
+	if err != nil {
+		return "", nop, err
+	}
+
+	cleanup := func() {
+		_ = os.RemoveAll(dir)
+	}
+	if err = util.ExportGitRef(ref, dir); err != nil {
+		cleanup()
+		return "", nop, err
+	}
+
+	file := filepath.Join(dir, filepath.FromSlash(path))
+	if _, err = os.Stat(file); err != nil {
+		cleanup()
+		return "", nop, fmt.Errorf("%s: not found in git revision %s", path, ref)
+	}
+
+	return file, cleanup, nil
+}
@@ -1,23 +1,131 @@
 package downloader
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
-func Download(url, filename string) error {
-	resp, err := http.Get(url)
+const (
+	defaultTimeout = 5 * time.Minute
+	defaultMaxSize = 512 << 20
+)
+
+var (
+	// ErrTooLarge means the file exceeds the max size.
+	ErrTooLarge = errors.New("downloader: file too large")
+	// ErrChecksumMismatch means the sha256 of the file is not the expected one.
+	ErrChecksumMismatch = errors.New("downloader: sha256 mismatch")
+)
+
+type (
+	// Option customizes the download.
+	Option func(*options)
+
+	options struct {
+		timeout time.Duration
+		maxSize int64
+		sha256  string
+	}
+)
+
+// WithTimeout limits the duration of the whole download.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithMaxSize limits the size of the file in bytes.
+func WithMaxSize(size int64) Option {
+	return func(o *options) {
+		o.maxSize = size
+	}
+}
+
+// WithSha256 verifies the file with the hex encoded sha256, the file is not
+// created if it mismatches.
+func WithSha256(sum string) Option {
+	return func(o *options) {
+		o.sha256 = strings.ToLower(sum)
+	}
+}
+
+// Download downloads url into filename, the file is created after the download
+// succeeds, so it never leaves a partial file.
+func Download(url, filename string, opts ...Option) error {
+	o := options{
+		timeout: defaultTimeout,
+		maxSize: defaultMaxSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	client := http.Client{Timeout: o.timeout}
+	resp, err := client.Get(url)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	f, err := os.Create(filename)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloader: %s: unexpected status %s", url, resp.Status)
+	}
+	if resp.ContentLength > o.maxSize {
+		return ErrTooLarge
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(resp.Body, o.maxSize+1))
 	if err != nil {
 		return err
 	}
+	if n > o.maxSize {
+		return ErrTooLarge
+	}
+
+	if len(o.sha256) > 0 {
+		if sum := hex.EncodeToString(h.Sum(nil)); sum != o.sha256 {
+			return fmt.Errorf("%w: %s, expected %s, got %s", ErrChecksumMismatch, url, o.sha256, sum)
+		}
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filename)
+}
+
+// Sha256 returns the hex encoded sha256 of the file.
+func Sha256(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
 	defer f.Close()
-	_, err = io.Copy(f, resp.Body)
-	return err
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
@@ -0,0 +1,56 @@
+package downloader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+			_, _ = w.Write([]byte("slow"))
+		default:
+			_, _ = w.Write([]byte("content"))
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	sum := sha256.Sum256([]byte("content"))
+	filename := filepath.Join(dir, "file")
+
+	assert.Nil(t, Download(server.URL+"/file", filename, WithSha256(strings.ToUpper(hex.EncodeToString(sum[:])))))
+	data, err := os.ReadFile(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "content", string(data))
+
+	actual, err := Sha256(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), actual)
+
+	err = Download(server.URL+"/file", filepath.Join(dir, "mismatch"), WithSha256(hex.EncodeToString(make([]byte, 32))))
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	err = Download(server.URL+"/file", filepath.Join(dir, "large"), WithMaxSize(3))
+	assert.Equal(t, ErrTooLarge, err)
+	assert.NotNil(t, Download(server.URL+"/missing", filepath.Join(dir, "missing")))
+	assert.NotNil(t, Download(server.URL+"/slow", filepath.Join(dir, "slow"), WithTimeout(50*time.Millisecond)))
+
+	// only the downloaded file is left, the failed downloads don't leave partial files
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
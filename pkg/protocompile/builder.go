@@ -0,0 +1,737 @@
+package protocompile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// maxFieldNumber is the exclusive end of the field numbers
+	maxFieldNumber = 536870912
+	maxEnumNumber  = 2147483647
+)
+
+// the field numbers of the descriptors which make up the paths of the source locations
+const (
+	filePackageTag   = 2
+	fileImportTag    = 3
+	fileMessageTag   = 4
+	fileEnumTag      = 5
+	fileServiceTag   = 6
+	fileExtensionTag = 7
+	fileSyntaxTag    = 12
+
+	messageFieldTag     = 2
+	messageNestedTag    = 3
+	messageEnumTag      = 4
+	messageExtensionTag = 6
+	messageOneofTag     = 8
+
+	enumValueTag     = 2
+	serviceMethodTag = 2
+)
+
+var scalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double":   descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+type (
+	// builder builds the descriptor of a parsed file like protoc does, the type names and
+	// the options are left to the linker since they need the imports.
+	builder struct {
+		name      string
+		proto3    bool
+		decls     *declarations
+		fd        *descriptorpb.FileDescriptorProto
+		refs      []reference
+		options   []pendingOptions
+		locations []*descriptorpb.SourceCodeInfo_Location
+		errs      []string
+	}
+
+	// reference is a type name to resolve in the scope, set is called with the fully
+	// qualified name of the resolved symbol.
+	reference struct {
+		pos   scanner.Position
+		name  string
+		scope string
+		// types reports whether the reference must be a message or an enum
+		types bool
+		set   func(name string, kind symbolKind) error
+	}
+
+	// pendingOptions are the options of a descriptor, the options message is attached to
+	// the descriptor once the options are interpreted.
+	pendingOptions struct {
+		scope   string
+		list    []*proto.Option
+		message protobuf.Message
+		attach  func()
+	}
+)
+
+func newBuilder(name string, src []byte) *builder {
+	return &builder{
+		name:  name,
+		decls: scanDeclarations(src),
+		fd:    &descriptorpb.FileDescriptorProto{Name: protobuf.String(name)},
+	}
+}
+
+func (b *builder) errorf(pos scanner.Position, format string, args ...interface{}) {
+	b.errs = append(b.errs, fmt.Sprintf("%s:%d:%d: %s", b.name, pos.Line, pos.Column,
+		fmt.Sprintf(format, args...)))
+}
+
+func (b *builder) locate(pos scanner.Position, path []int32) {
+	loc := b.decls.location(position{line: pos.Line, column: pos.Column}, path)
+	if loc != nil {
+		b.locations = append(b.locations, loc)
+	}
+}
+
+func (b *builder) build(file *proto.Proto) *descriptorpb.FileDescriptorProto {
+	fd := b.fd
+	for _, element := range file.Elements {
+		if syntax, ok := element.(*proto.Syntax); ok && syntax.Value == "proto3" {
+			b.proto3 = true
+			fd.Syntax = protobuf.String("proto3")
+		}
+	}
+
+	var fileOptions []*proto.Option
+	for _, element := range file.Elements {
+		switch e := element.(type) {
+		case *proto.Syntax:
+			b.locate(e.Position, []int32{fileSyntaxTag})
+		case *proto.Package:
+			fd.Package = protobuf.String(e.Name)
+			b.locate(e.Position, []int32{filePackageTag})
+		case *proto.Import:
+			index := int32(len(fd.Dependency))
+			switch e.Kind {
+			case "public":
+				fd.PublicDependency = append(fd.PublicDependency, index)
+			case "weak":
+				fd.WeakDependency = append(fd.WeakDependency, index)
+			}
+			fd.Dependency = append(fd.Dependency, e.Filename)
+			b.locate(e.Position, []int32{fileImportTag, index})
+		case *proto.Option:
+			fileOptions = append(fileOptions, e)
+		case *proto.Message:
+			if e.IsExtend {
+				b.extend(e, fd.GetPackage(), &fd.Extension, []int32{fileExtensionTag},
+					&fd.MessageType, []int32{fileMessageTag})
+				continue
+			}
+			path := []int32{fileMessageTag, int32(len(fd.MessageType))}
+			fd.MessageType = append(fd.MessageType, b.message(e, fd.GetPackage(), path))
+		case *proto.Enum:
+			path := []int32{fileEnumTag, int32(len(fd.EnumType))}
+			fd.EnumType = append(fd.EnumType, b.enum(e, fd.GetPackage(), path))
+		case *proto.Service:
+			path := []int32{fileServiceTag, int32(len(fd.Service))}
+			fd.Service = append(fd.Service, b.service(e, fd.GetPackage(), path))
+		}
+	}
+
+	opts := &descriptorpb.FileOptions{}
+	b.addOptions(fd.GetPackage(), fileOptions, opts, func() {
+		fd.Options = opts
+	})
+
+	whole := &descriptorpb.SourceCodeInfo_Location{Span: span(b.decls.start, b.decls.end)}
+	fd.SourceCodeInfo = &descriptorpb.SourceCodeInfo{
+		Location: append([]*descriptorpb.SourceCodeInfo_Location{whole}, b.locations...),
+	}
+	return fd
+}
+
+func (b *builder) addOptions(scope string, list []*proto.Option, message protobuf.Message, attach func()) {
+	if len(list) == 0 {
+		return
+	}
+
+	b.options = append(b.options, pendingOptions{
+		scope:   scope,
+		list:    list,
+		message: message,
+		attach:  attach,
+	})
+}
+
+func (b *builder) message(m *proto.Message, scope string, path []int32) *descriptorpb.DescriptorProto {
+	md := &descriptorpb.DescriptorProto{Name: protobuf.String(m.Name)}
+	b.locate(m.Position, path)
+	b.messageBody(md, join(scope, m.Name), m.Elements, path)
+	return md
+}
+
+func (b *builder) messageBody(md *descriptorpb.DescriptorProto, fullName string, elements []proto.Visitee,
+	path []int32) {
+	var (
+		options   []*proto.Option
+		optionals []*descriptorpb.FieldDescriptorProto
+	)
+	addField := func(fp *descriptorpb.FieldDescriptorProto, pos scanner.Position) {
+		b.locate(pos, appendPath(path, messageFieldTag, len(md.Field)))
+		md.Field = append(md.Field, fp)
+	}
+	addNested := func(nested *descriptorpb.DescriptorProto) {
+		md.NestedType = append(md.NestedType, nested)
+	}
+
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *proto.NormalField:
+			fp := b.field(e.Field, fullName, label(e, b.proto3))
+			b.setType(fp, e.Type, fullName, e.Position)
+			if e.Optional && b.proto3 {
+				fp.Proto3Optional = protobuf.Bool(true)
+				optionals = append(optionals, fp)
+			}
+			addField(fp, e.Position)
+		case *proto.MapField:
+			entry := b.mapEntry(e, fullName)
+			fp := b.field(e.Field, fullName, descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+			fp.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fp.TypeName = protobuf.String("." + join(fullName, entry.GetName()))
+			addNested(entry)
+			addField(fp, e.Position)
+		case *proto.Group:
+			nestedPath := appendPath(path, messageNestedTag, len(md.NestedType))
+			nested := b.group(e, fullName, nestedPath)
+			addNested(nested)
+			addField(b.groupField(e, fullName, nested), e.Position)
+		case *proto.Oneof:
+			index := int32(len(md.OneofDecl))
+			od := &descriptorpb.OneofDescriptorProto{Name: protobuf.String(e.Name)}
+			b.locate(e.Position, appendPath(path, messageOneofTag, int(index)))
+			md.OneofDecl = append(md.OneofDecl, od)
+			var oneofOptions []*proto.Option
+			for _, each := range e.Elements {
+				switch field := each.(type) {
+				case *proto.OneOfField:
+					fp := b.field(field.Field, fullName, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL)
+					b.setType(fp, field.Type, fullName, field.Position)
+					fp.OneofIndex = protobuf.Int32(index)
+					addField(fp, field.Position)
+				case *proto.Group:
+					nestedPath := appendPath(path, messageNestedTag, len(md.NestedType))
+					nested := b.group(field, fullName, nestedPath)
+					addNested(nested)
+					fp := b.groupField(field, fullName, nested)
+					fp.Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+					fp.OneofIndex = protobuf.Int32(index)
+					addField(fp, field.Position)
+				case *proto.Option:
+					oneofOptions = append(oneofOptions, field)
+				}
+			}
+			opts := &descriptorpb.OneofOptions{}
+			b.addOptions(fullName, oneofOptions, opts, func() {
+				od.Options = opts
+			})
+		case *proto.Message:
+			if e.IsExtend {
+				b.extend(e, fullName, &md.Extension, appendPath(path, messageExtensionTag),
+					&md.NestedType, appendPath(path, messageNestedTag))
+				continue
+			}
+			nestedPath := appendPath(path, messageNestedTag, len(md.NestedType))
+			addNested(b.message(e, fullName, nestedPath))
+		case *proto.Enum:
+			md.EnumType = append(md.EnumType, b.enum(e, fullName, appendPath(path, messageEnumTag, len(md.EnumType))))
+		case *proto.Reserved:
+			for _, r := range e.Ranges {
+				end := int32(r.To) + 1
+				if r.Max {
+					end = maxFieldNumber
+				}
+				md.ReservedRange = append(md.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{
+					Start: protobuf.Int32(int32(r.From)),
+					End:   protobuf.Int32(end),
+				})
+			}
+			md.ReservedName = append(md.ReservedName, e.FieldNames...)
+		case *proto.Extensions:
+			for _, r := range e.Ranges {
+				end := int32(r.To) + 1
+				if r.Max {
+					end = maxFieldNumber
+				}
+				md.ExtensionRange = append(md.ExtensionRange, &descriptorpb.DescriptorProto_ExtensionRange{
+					Start: protobuf.Int32(int32(r.From)),
+					End:   protobuf.Int32(end),
+				})
+			}
+		case *proto.Option:
+			options = append(options, e)
+		}
+	}
+
+	// the proto3 optional fields are in the synthetic oneofs which follow the real ones
+	for _, fp := range optionals {
+		fp.OneofIndex = protobuf.Int32(int32(len(md.OneofDecl)))
+		md.OneofDecl = append(md.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: protobuf.String(syntheticOneofName(md, fp.GetName())),
+		})
+	}
+
+	// the option names of a message are resolved in the scope of the message
+	opts := &descriptorpb.MessageOptions{}
+	b.addOptions(parentScope(fullName), options, opts, func() {
+		md.Options = opts
+	})
+}
+
+func label(f *proto.NormalField, proto3 bool) descriptorpb.FieldDescriptorProto_Label {
+	switch {
+	case f.Repeated:
+		return descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	case f.Required && !proto3:
+		return descriptorpb.FieldDescriptorProto_LABEL_REQUIRED
+	default:
+		return descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	}
+}
+
+// field builds the field in the scope without the type
+func (b *builder) field(f *proto.Field, scope string, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+	fp := &descriptorpb.FieldDescriptorProto{
+		Name:     protobuf.String(f.Name),
+		Number:   protobuf.Int32(int32(f.Sequence)),
+		Label:    label.Enum(),
+		JsonName: protobuf.String(jsonName(f.Name)),
+	}
+	var options []*proto.Option
+	for _, option := range f.Options {
+		switch option.Name {
+		case "json_name":
+			fp.JsonName = protobuf.String(option.Constant.Source)
+		case "default":
+			value, err := defaultValue(f.Type, option.Constant)
+			if err != nil {
+				b.errorf(option.Position, "%v", err)
+				continue
+			}
+			fp.DefaultValue = protobuf.String(value)
+		default:
+			options = append(options, option)
+		}
+	}
+
+	opts := &descriptorpb.FieldOptions{}
+	b.addOptions(scope, options, opts, func() {
+		fp.Options = opts
+	})
+	return fp
+}
+
+func (b *builder) setType(fp *descriptorpb.FieldDescriptorProto, tp, scope string, pos scanner.Position) {
+	if scalar, ok := scalarTypes[tp]; ok {
+		fp.Type = scalar.Enum()
+		return
+	}
+
+	b.refs = append(b.refs, reference{
+		pos:   pos,
+		name:  tp,
+		scope: scope,
+		types: true,
+		set: func(name string, kind symbolKind) error {
+			fp.TypeName = protobuf.String("." + name)
+			if fp.Type == nil {
+				if kind == symbolEnum {
+					fp.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+				} else {
+					fp.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// mapEntry builds the nested entry type of the map field
+func (b *builder) mapEntry(f *proto.MapField, scope string) *descriptorpb.DescriptorProto {
+	name := mapEntryName(f.Name)
+	entryScope := join(scope, name)
+	key := &descriptorpb.FieldDescriptorProto{
+		Name:     protobuf.String("key"),
+		Number:   protobuf.Int32(1),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		JsonName: protobuf.String("key"),
+	}
+	b.setType(key, f.KeyType, entryScope, f.Position)
+	value := &descriptorpb.FieldDescriptorProto{
+		Name:     protobuf.String("value"),
+		Number:   protobuf.Int32(2),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		JsonName: protobuf.String("value"),
+	}
+	b.setType(value, f.Type, entryScope, f.Position)
+
+	return &descriptorpb.DescriptorProto{
+		Name:    protobuf.String(name),
+		Field:   []*descriptorpb.FieldDescriptorProto{key, value},
+		Options: &descriptorpb.MessageOptions{MapEntry: protobuf.Bool(true)},
+	}
+}
+
+func (b *builder) group(g *proto.Group, scope string, path []int32) *descriptorpb.DescriptorProto {
+	md := &descriptorpb.DescriptorProto{Name: protobuf.String(g.Name)}
+	b.messageBody(md, join(scope, g.Name), g.Elements, path)
+	return md
+}
+
+func (b *builder) groupField(g *proto.Group, scope string, nested *descriptorpb.DescriptorProto) *descriptorpb.FieldDescriptorProto {
+	name := strings.ToLower(g.Name)
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	switch {
+	case g.Repeated:
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	case g.Required:
+		label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED
+	}
+
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     protobuf.String(name),
+		Number:   protobuf.Int32(int32(g.Sequence)),
+		Label:    label.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_GROUP.Enum(),
+		TypeName: protobuf.String("." + join(scope, nested.GetName())),
+		JsonName: protobuf.String(jsonName(name)),
+	}
+}
+
+// extend builds the extensions of the extend block, the path is the path of the extensions,
+// the types of the groups are added to the messages of the scope.
+func (b *builder) extend(m *proto.Message, scope string, extensions *[]*descriptorpb.FieldDescriptorProto,
+	path []int32, messages *[]*descriptorpb.DescriptorProto, messagesPath []int32) {
+	for _, element := range m.Elements {
+		var fp *descriptorpb.FieldDescriptorProto
+		var pos scanner.Position
+		switch e := element.(type) {
+		case *proto.NormalField:
+			fp = b.field(e.Field, scope, label(e, b.proto3))
+			b.setType(fp, e.Type, scope, e.Position)
+			if e.Optional && b.proto3 {
+				fp.Proto3Optional = protobuf.Bool(true)
+			}
+			pos = e.Position
+		case *proto.Group:
+			nested := b.group(e, scope, appendPath(messagesPath, len(*messages)))
+			*messages = append(*messages, nested)
+			fp = b.groupField(e, scope, nested)
+			pos = e.Position
+		default:
+			continue
+		}
+
+		b.refs = append(b.refs, reference{
+			pos:   m.Position,
+			name:  m.Name,
+			scope: scope,
+			types: true,
+			set: func(name string, kind symbolKind) error {
+				if kind != symbolMessage {
+					return fmt.Errorf("%q is not a message type", name)
+				}
+				fp.Extendee = protobuf.String("." + name)
+				return nil
+			},
+		})
+		b.locate(pos, appendPath(path, len(*extensions)))
+		*extensions = append(*extensions, fp)
+	}
+}
+
+func (b *builder) enum(e *proto.Enum, scope string, path []int32) *descriptorpb.EnumDescriptorProto {
+	ed := &descriptorpb.EnumDescriptorProto{Name: protobuf.String(e.Name)}
+	b.locate(e.Position, path)
+	// the enum values are in the scope of the enum
+	var options []*proto.Option
+	for _, element := range e.Elements {
+		switch v := element.(type) {
+		case *proto.EnumField:
+			vd := &descriptorpb.EnumValueDescriptorProto{
+				Name:   protobuf.String(v.Name),
+				Number: protobuf.Int32(int32(v.Integer)),
+			}
+			b.locate(v.Position, appendPath(path, enumValueTag, len(ed.Value)))
+			ed.Value = append(ed.Value, vd)
+			var valueOptions []*proto.Option
+			for _, each := range v.Elements {
+				if option, ok := each.(*proto.Option); ok {
+					valueOptions = append(valueOptions, option)
+				}
+			}
+			opts := &descriptorpb.EnumValueOptions{}
+			b.addOptions(scope, valueOptions, opts, func() {
+				vd.Options = opts
+			})
+		case *proto.Reserved:
+			for _, r := range v.Ranges {
+				end := int32(r.To)
+				if r.Max {
+					end = maxEnumNumber
+				}
+				ed.ReservedRange = append(ed.ReservedRange, &descriptorpb.EnumDescriptorProto_EnumReservedRange{
+					Start: protobuf.Int32(int32(r.From)),
+					End:   protobuf.Int32(end),
+				})
+			}
+			ed.ReservedName = append(ed.ReservedName, v.FieldNames...)
+		case *proto.Option:
+			options = append(options, v)
+		}
+	}
+
+	opts := &descriptorpb.EnumOptions{}
+	b.addOptions(scope, options, opts, func() {
+		ed.Options = opts
+	})
+	return ed
+}
+
+func (b *builder) service(s *proto.Service, scope string, path []int32) *descriptorpb.ServiceDescriptorProto {
+	sd := &descriptorpb.ServiceDescriptorProto{Name: protobuf.String(s.Name)}
+	b.locate(s.Position, path)
+	fullName := join(scope, s.Name)
+	var options []*proto.Option
+	for _, element := range s.Elements {
+		switch e := element.(type) {
+		case *proto.RPC:
+			md := b.method(e, fullName)
+			b.locate(e.Position, appendPath(path, serviceMethodTag, len(sd.Method)))
+			sd.Method = append(sd.Method, md)
+		case *proto.Option:
+			options = append(options, e)
+		}
+	}
+
+	opts := &descriptorpb.ServiceOptions{}
+	b.addOptions(scope, options, opts, func() {
+		sd.Options = opts
+	})
+	return sd
+}
+
+func (b *builder) method(r *proto.RPC, scope string) *descriptorpb.MethodDescriptorProto {
+	md := &descriptorpb.MethodDescriptorProto{Name: protobuf.String(r.Name)}
+	if r.StreamsRequest {
+		md.ClientStreaming = protobuf.Bool(true)
+	}
+	if r.StreamsReturns {
+		md.ServerStreaming = protobuf.Bool(true)
+	}
+
+	messageRef := func(name string, set func(string)) reference {
+		return reference{
+			pos:   r.Position,
+			name:  name,
+			scope: scope,
+			types: true,
+			set: func(name string, kind symbolKind) error {
+				if kind != symbolMessage {
+					return fmt.Errorf("%q is not a message type", name)
+				}
+				set("." + name)
+				return nil
+			},
+		}
+	}
+	b.refs = append(b.refs, messageRef(r.RequestType, func(name string) {
+		md.InputType = protobuf.String(name)
+	}), messageRef(r.ReturnsType, func(name string) {
+		md.OutputType = protobuf.String(name)
+	}))
+
+	var options []*proto.Option
+	for _, element := range r.Elements {
+		if option, ok := element.(*proto.Option); ok {
+			options = append(options, option)
+		}
+	}
+	opts := &descriptorpb.MethodOptions{}
+	b.addOptions(scope, options, opts, func() {
+		md.Options = opts
+	})
+	return md
+}
+
+// syntheticOneofName returns the name of the synthetic oneof of the proto3 optional field,
+// the name is prefixed with X until it doesn't conflict like protoc does.
+func syntheticOneofName(md *descriptorpb.DescriptorProto, field string) string {
+	names := make(map[string]bool)
+	for _, each := range md.Field {
+		names[each.GetName()] = true
+	}
+	for _, each := range md.OneofDecl {
+		names[each.GetName()] = true
+	}
+	for _, each := range md.NestedType {
+		names[each.GetName()] = true
+	}
+	for _, each := range md.EnumType {
+		names[each.GetName()] = true
+	}
+
+	name := field
+	if !strings.HasPrefix(name, "_") {
+		name = "_" + name
+	}
+	for names[name] {
+		name = "X" + name
+	}
+	return name
+}
+
+// jsonName is the ToJsonName of protoc
+func jsonName(name string) string {
+	var (
+		sb         strings.Builder
+		capitalize bool
+	)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			capitalize = true
+		case capitalize:
+			sb.WriteString(strings.ToUpper(string(c)))
+			capitalize = false
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// mapEntryName is the MapEntryName of protoc
+func mapEntryName(field string) string {
+	var (
+		sb         strings.Builder
+		capitalize = true
+	)
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		switch {
+		case c == '_':
+			capitalize = true
+		case capitalize:
+			if c >= 'a' && c <= 'z' {
+				c = c - 'a' + 'A'
+			}
+			sb.WriteByte(c)
+			capitalize = false
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String() + "Entry"
+}
+
+// defaultValue returns the default value of the field in the form of the descriptor
+func defaultValue(tp string, lit proto.Literal) (string, error) {
+	source := lit.Source
+	switch tp {
+	case "string":
+		value, err := unescape(source)
+		return string(value), err
+	case "bytes":
+		value, err := unescape(source)
+		return escape(value), err
+	case "bool":
+		if source != "true" && source != "false" {
+			return "", fmt.Errorf("expected \"true\" or \"false\" as the default of bool, got %q", source)
+		}
+		return source, nil
+	case "float", "double":
+		switch strings.ToLower(source) {
+		case "inf", "+inf":
+			return "inf", nil
+		case "-inf":
+			return "-inf", nil
+		case "nan", "-nan":
+			return "nan", nil
+		}
+		v, err := strconv.ParseFloat(source, 64)
+		if err != nil {
+			return "", err
+		}
+		if tp == "float" {
+			return formatFloat(float64(float32(v)), 6, 9, 32), nil
+		}
+		return formatFloat(v, 15, 17, 64), nil
+	case "int32", "int64", "sint32", "sint64", "sfixed32", "sfixed64":
+		v, err := strconv.ParseInt(source, 0, 64)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(v, 10), nil
+	case "uint32", "uint64", "fixed32", "fixed64":
+		v, err := strconv.ParseUint(source, 0, 64)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(v, 10), nil
+	default:
+		// the default of an enum is the name of the value
+		return source, nil
+	}
+}
+
+// formatFloat is the SimpleDtoa and SimpleFtoa of protoc, the short precision is used if
+// it round trips.
+func formatFloat(v float64, short, long, bitSize int) string {
+	s := strconv.FormatFloat(v, 'g', short, bitSize)
+	if parsed, err := strconv.ParseFloat(s, bitSize); err != nil || parsed != v {
+		s = strconv.FormatFloat(v, 'g', long, bitSize)
+	}
+	return s
+}
+
+func join(scope, name string) string {
+	if len(scope) == 0 {
+		return name
+	}
+	return scope + "." + name
+}
+
+func parentScope(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+func appendPath(path []int32, elements ...int) []int32 {
+	ret := append([]int32(nil), path...)
+	for _, each := range elements {
+		ret = append(ret, int32(each))
+	}
+	return ret
+}
@@ -0,0 +1,153 @@
+package protocompile
+
+import "google.golang.org/protobuf/types/descriptorpb"
+
+type (
+	// declaration is a statement of the proto file which ends with ";" or a block, the
+	// comments are attached to it like protoc does.
+	declaration struct {
+		start    token
+		end      token
+		leading  string
+		trailing string
+		detached []string
+	}
+
+	// position is the one based line and column of a token like the emicklei/proto parser
+	// reports.
+	position struct {
+		line   int
+		column int
+	}
+
+	// declarations is the declarations of a proto file indexed by the positions of their
+	// tokens, so an element is found by whichever token the parser reports it at.
+	declarations struct {
+		byPosition map[position]*declaration
+		start      token
+		end        token
+	}
+)
+
+// scanDeclarations scans the declarations of the file, the tokens in the options and the
+// aggregate values are a part of the declaration which they are in.
+func scanDeclarations(src []byte) *declarations {
+	var (
+		t               = newTokenizer(src)
+		ds              = &declarations{byPosition: make(map[position]*declaration)}
+		upcomingDoc     string
+		upcomingDetach  []string
+		blocks          []*declaration
+		current         *declaration
+		previousIsEqual bool
+	)
+
+	t.nextWithComments(nil, &upcomingDetach, &upcomingDoc)
+	ds.start = t.current
+	terminate := func(d *declaration, located bool, text string) {
+		var (
+			leading, trailing string
+			detached          []string
+		)
+		t.nextWithComments(&trailing, &detached, &leading)
+		leading, upcomingDoc = upcomingDoc, leading
+		switch {
+		case located:
+			d.leading, d.trailing, d.detached = leading, trailing, upcomingDetach
+			upcomingDetach = detached
+		case text == "}":
+			upcomingDetach = detached
+		default:
+			upcomingDetach = append(upcomingDetach, detached...)
+		}
+	}
+
+	for t.current.typ != tokenEnd {
+		tok := t.current
+		if current == nil {
+			current = &declaration{start: tok}
+		}
+		ds.byPosition[position{line: tok.line + 1, column: tok.runeCol}] = current
+		isEqual := previousIsEqual
+		previousIsEqual = tok.typ == tokenSymbol && tok.text == "="
+		ds.end = tok
+
+		if tok.typ != tokenSymbol {
+			t.next()
+			continue
+		}
+
+		switch {
+		case tok.text == "[" || tok.text == "{" && isEqual:
+			// the options and the aggregate values are skipped as a whole
+			t.next()
+			depth := 1
+			for depth > 0 && t.current.typ != tokenEnd {
+				ds.byPosition[position{line: t.current.line + 1, column: t.current.runeCol}] = current
+				if t.current.typ == tokenSymbol {
+					switch t.current.text {
+					case "[", "{":
+						depth++
+					case "]", "}":
+						depth--
+					}
+				}
+				ds.end = t.current
+				t.next()
+			}
+		case tok.text == ";":
+			current.end = tok
+			terminate(current, current.start != tok, tok.text)
+			current = nil
+		case tok.text == "{":
+			current.end = tok
+			terminate(current, true, tok.text)
+			blocks = append(blocks, current)
+			current = nil
+		case tok.text == "}":
+			if len(blocks) > 0 {
+				blocks[len(blocks)-1].end = tok
+				blocks = blocks[:len(blocks)-1]
+			}
+			terminate(current, false, tok.text)
+			current = nil
+		default:
+			t.next()
+		}
+	}
+
+	return ds
+}
+
+// location returns the source location of the element at the position with the path,
+// nil is returned if there is no declaration at the position.
+func (ds *declarations) location(pos position, path []int32) *descriptorpb.SourceCodeInfo_Location {
+	d, ok := ds.byPosition[pos]
+	if !ok {
+		return nil
+	}
+
+	loc := &descriptorpb.SourceCodeInfo_Location{
+		Path: append([]int32(nil), path...),
+		Span: span(d.start, d.end),
+	}
+	if len(d.leading) > 0 {
+		loc.LeadingComments = stringPtr(d.leading)
+	}
+	if len(d.trailing) > 0 {
+		loc.TrailingComments = stringPtr(d.trailing)
+	}
+	loc.LeadingDetachedComments = d.detached
+	return loc
+}
+
+func span(start, end token) []int32 {
+	if start.line == end.line {
+		return []int32{int32(start.line), int32(start.column), int32(end.endColumn)}
+	}
+	return []int32{int32(start.line), int32(start.column), int32(end.line), int32(end.endColumn)}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
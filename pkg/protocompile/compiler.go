@@ -0,0 +1,252 @@
+// Package protocompile compiles the proto files in process without protoc, the files are
+// parsed and linked into the descriptors which protoc sends to the plugins.
+package protocompile
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	// the well known types are built in
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/apipb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/sourcecontextpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/typepb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+	_ "google.golang.org/protobuf/types/pluginpb"
+)
+
+const wellKnownPrefix = "google/protobuf/"
+
+type (
+	// Compiler compiles the proto files like protoc does, the imports are searched in the
+	// import paths and the well known types are built in.
+	Compiler struct {
+		// ImportPaths are the --proto_path of protoc, the current directory is used if it's empty
+		ImportPaths []string
+	}
+
+	// Result is the compiled files
+	Result struct {
+		// Files are the descriptors of the compiled files and their imports in topological order
+		Files []*descriptorpb.FileDescriptorProto
+		// Generate are the names of the files to generate relative to the import paths
+		Generate []string
+	}
+
+	loader struct {
+		importPaths []string
+		registry    *protoregistry.Files
+		compiled    map[string]*descriptorpb.FileDescriptorProto
+		loading     []string
+		ordered     []*descriptorpb.FileDescriptorProto
+	}
+)
+
+// Compile parses and links the files and their imports
+func (c *Compiler) Compile(files ...string) (*Result, error) {
+	importPaths := c.ImportPaths
+	if len(importPaths) == 0 {
+		importPaths = []string{"."}
+	}
+
+	l := &loader{
+		importPaths: importPaths,
+		registry:    new(protoregistry.Files),
+		compiled:    make(map[string]*descriptorpb.FileDescriptorProto),
+	}
+	var result Result
+	for _, file := range files {
+		name, err := l.virtualName(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.load(name); err != nil {
+			return nil, err
+		}
+		result.Generate = append(result.Generate, name)
+	}
+
+	result.Files = l.ordered
+	return &result, nil
+}
+
+// virtualName returns the name of the file relative to the import path which contains it
+func (l *loader) virtualName(file string) (string, error) {
+	if _, err := os.Stat(file); err != nil {
+		// the name may be relative to an import path
+		for _, each := range l.importPaths {
+			if _, err := os.Stat(filepath.Join(each, file)); err == nil {
+				return filepath.ToSlash(filepath.Clean(file)), nil
+			}
+		}
+		return "", fmt.Errorf("%s: No such file or directory", file)
+	}
+
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+	for _, each := range l.importPaths {
+		dir, err := filepath.Abs(each)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(dir, abs)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: File does not reside within any path specified using --proto_path (or -I)", file)
+}
+
+func (l *loader) load(name string) error {
+	if _, ok := l.compiled[name]; ok {
+		return nil
+	}
+	for i, each := range l.loading {
+		if each == name {
+			return fmt.Errorf("file recursively imports itself: %s -> %s",
+				strings.Join(l.loading[i:], " -> "), name)
+		}
+	}
+
+	l.loading = append(l.loading, name)
+	defer func() {
+		l.loading = l.loading[:len(l.loading)-1]
+	}()
+
+	src, err := l.read(name)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		return l.loadWellKnown(name)
+	}
+
+	parser := proto.NewParser(bytes.NewReader(src))
+	parser.Filename(name)
+	ast, err := parser.Parse()
+	if err != nil {
+		return err
+	}
+
+	b := newBuilder(name, src)
+	fd := b.build(ast)
+	if len(b.errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(b.errs, "\n"))
+	}
+	for _, dep := range fd.Dependency {
+		if err := l.load(dep); err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("%s: import %q was not found", name, dep)
+			}
+			return err
+		}
+	}
+
+	if err := l.link(b); err != nil {
+		return err
+	}
+
+	desc, err := protodesc.NewFile(fd, l.registry)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return l.register(desc, fd)
+}
+
+func (l *loader) read(name string) ([]byte, error) {
+	for _, each := range l.importPaths {
+		data, err := os.ReadFile(filepath.Join(each, filepath.FromSlash(name)))
+		if err == nil {
+			return data, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	return nil, os.ErrNotExist
+}
+
+func (l *loader) loadWellKnown(name string) error {
+	if !strings.HasPrefix(name, wellKnownPrefix) {
+		return os.ErrNotExist
+	}
+	desc, err := protoregistry.GlobalFiles.FindFileByPath(name)
+	if err != nil {
+		return os.ErrNotExist
+	}
+
+	imports := desc.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if err := l.load(imports.Get(i).Path()); err != nil {
+			return err
+		}
+	}
+	return l.register(desc, protodesc.ToFileDescriptorProto(desc))
+}
+
+func (l *loader) register(desc protoreflect.FileDescriptor, fd *descriptorpb.FileDescriptorProto) error {
+	if err := l.registry.RegisterFile(desc); err != nil {
+		return err
+	}
+
+	l.compiled[fd.GetName()] = fd
+	l.ordered = append(l.ordered, fd)
+	return nil
+}
+
+// link resolves the type names and interprets the options of the file
+func (l *loader) link(b *builder) error {
+	syms := make(symbols)
+	syms.addFile(b.fd)
+	for _, dep := range b.fd.Dependency {
+		l.addVisible(syms, dep, make(map[string]bool))
+	}
+
+	for _, ref := range b.refs {
+		name, kind, ok := syms.lookup(ref.name, ref.scope, ref.types)
+		if !ok {
+			return fmt.Errorf("%s:%d:%d: %q is not defined", b.name, ref.pos.Line, ref.pos.Column, ref.name)
+		}
+		if ref.types && kind != symbolMessage && kind != symbolEnum {
+			return fmt.Errorf("%s:%d:%d: %q is not a type", b.name, ref.pos.Line, ref.pos.Column, ref.name)
+		}
+		if err := ref.set(name, kind); err != nil {
+			return fmt.Errorf("%s:%d:%d: %v", b.name, ref.pos.Line, ref.pos.Column, err)
+		}
+	}
+
+	i := interpreter{b: b, symbols: syms, files: l.registry}
+	return i.interpret()
+}
+
+// addVisible adds the symbols of the imported file and the files it imports publicly
+func (l *loader) addVisible(syms symbols, name string, visited map[string]bool) {
+	fd, ok := l.compiled[name]
+	if !ok || visited[name] {
+		return
+	}
+
+	visited[name] = true
+	syms.addFile(fd)
+	for _, index := range fd.PublicDependency {
+		l.addVisible(syms, fd.Dependency[index], visited)
+	}
+}
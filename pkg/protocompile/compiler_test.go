@@ -0,0 +1,249 @@
+package protocompile
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "google.golang.org/grpc/profiling/proto"
+	_ "google.golang.org/grpc/reflection/grpc_testing"
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// the testdata of gengo are generated by protoc and protoc-gen-go without the version markers
+func TestGenerateGo(t *testing.T) {
+	gengo.GenerateVersionMarkers = false
+	defer func() {
+		gengo.GenerateVersionMarkers = true
+	}()
+
+	root := filepath.Join("testdata", "gengo")
+	var files []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err == nil && filepath.Ext(path) == ".proto" {
+			files = append(files, path)
+		}
+		return err
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			out := t.TempDir()
+			c := Compiler{ImportPaths: []string{root}}
+			err := c.Generate(GenerateOptions{
+				GoOut: out,
+				GoOpts: []string{
+					"module=google.golang.org/protobuf",
+					"Mcmd/protoc-gen-go/testdata/nopackage/nopackage.proto=google.golang.org/protobuf/cmd/protoc-gen-go/testdata/nopackage",
+				},
+			}, file)
+			assert.Nil(t, err)
+
+			rel, err := filepath.Rel(root, strings.TrimSuffix(file, ".proto")+".pb.go")
+			assert.Nil(t, err)
+			expected, err := os.ReadFile(filepath.Join(root, rel))
+			assert.Nil(t, err)
+			actual, err := os.ReadFile(filepath.Join(out, rel))
+			assert.Nil(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+// the testdata of grpc are generated by protoc and protoc-gen-go-grpc v1.2.0
+func TestGenerateGrpc(t *testing.T) {
+	version := regexp.MustCompile(`// - protoc +.*\n`)
+	root := filepath.Join("testdata", "grpc")
+	tests := []struct {
+		file string
+		opts []string
+	}{
+		{file: "reflection/grpc_testing/test.proto"},
+		{file: "stress/grpc_testing/metrics.proto"},
+		{file: "profiling/proto/service.proto", opts: []string{"require_unimplemented_servers=false"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			out := t.TempDir()
+			c := Compiler{ImportPaths: []string{root}}
+			err := c.Generate(GenerateOptions{
+				GrpcOut:  "module=google.golang.org/grpc:" + out,
+				GrpcOpts: test.opts,
+			}, filepath.Join(root, test.file))
+			assert.Nil(t, err)
+
+			name := strings.TrimSuffix(test.file, ".proto") + "_grpc.pb.go"
+			expected, err := os.ReadFile(filepath.Join(root, name))
+			assert.Nil(t, err)
+			actual, err := os.ReadFile(filepath.Join(out, name))
+			assert.Nil(t, err)
+			assert.Equal(t, version.ReplaceAllString(string(expected), ""),
+				version.ReplaceAllString(string(actual), ""))
+		})
+	}
+}
+
+func TestCompileDescriptor(t *testing.T) {
+	root := filepath.Join("testdata", "grpc")
+	for _, file := range []string{
+		"reflection/grpc_testing/test.proto",
+		"profiling/proto/service.proto",
+	} {
+		t.Run(file, func(t *testing.T) {
+			c := Compiler{ImportPaths: []string{root}}
+			result, err := c.Compile(filepath.Join(root, file))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{file}, result.Generate)
+
+			actual := result.Files[len(result.Files)-1]
+			actual.SourceCodeInfo = nil
+			desc, err := protoregistry.GlobalFiles.FindFileByPath(file)
+			assert.Nil(t, err)
+			expected := protodesc.ToFileDescriptorProto(desc)
+			assert.True(t, protobuf.Equal(expected, actual), "%v\n%v", expected, actual)
+		})
+	}
+}
+
+func TestCompileOptions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "options.proto", `syntax = "proto3";
+
+package test;
+
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.MessageOptions {
+  Rule rule = 50000;
+}
+
+extend google.protobuf.FieldOptions {
+  repeated string tags = 50001;
+}
+
+message Rule {
+  string name = 1;
+  int32 max = 2;
+}
+
+message Request {
+  option (rule) = { name: "request" max: 10 };
+  option deprecated = true;
+
+  string id = 1 [(tags) = "a", (tags) = "b", json_name = "ID"];
+}
+`)
+
+	c := Compiler{ImportPaths: []string{dir}}
+	result, err := c.Compile(filepath.Join(dir, "options.proto"))
+	assert.Nil(t, err)
+
+	fd := result.Files[len(result.Files)-1]
+	assert.Equal(t, "options.proto", fd.GetName())
+	assert.Equal(t, []string{"google/protobuf/descriptor.proto"}, fd.Dependency)
+
+	msg := fd.MessageType[1]
+	assert.True(t, msg.GetOptions().GetDeprecated())
+	var rule []byte
+	rule = protowire.AppendTag(rule, 1, protowire.BytesType)
+	rule = protowire.AppendString(rule, "request")
+	rule = protowire.AppendTag(rule, 2, protowire.VarintType)
+	rule = protowire.AppendVarint(rule, 10)
+	var expected []byte
+	expected = protowire.AppendTag(expected, 50000, protowire.BytesType)
+	expected = protowire.AppendBytes(expected, rule)
+	assert.Equal(t, expected, []byte(msg.GetOptions().ProtoReflect().GetUnknown()))
+
+	field := msg.Field[0]
+	assert.Equal(t, "ID", field.GetJsonName())
+	expected = nil
+	for _, tag := range []string{"a", "b"} {
+		expected = protowire.AppendTag(expected, 50001, protowire.BytesType)
+		expected = protowire.AppendString(expected, tag)
+	}
+	assert.Equal(t, expected, []byte(field.GetOptions().ProtoReflect().GetUnknown()))
+}
+
+func TestCompileExtendGroup(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "group.proto", `syntax = "proto2";
+
+package test;
+
+message Base {
+  extensions 100 to max;
+}
+
+extend Base {
+  optional group Extra = 100 {
+    optional string value = 1;
+  }
+}
+`)
+
+	c := Compiler{ImportPaths: []string{dir}}
+	result, err := c.Compile(filepath.Join(dir, "group.proto"))
+	assert.Nil(t, err)
+
+	fd := result.Files[len(result.Files)-1]
+	assert.Len(t, fd.MessageType, 2)
+	assert.Equal(t, "Extra", fd.MessageType[1].GetName())
+	ext := fd.Extension[0]
+	assert.Equal(t, "extra", ext.GetName())
+	assert.Equal(t, descriptorpb.FieldDescriptorProto_TYPE_GROUP, ext.GetType())
+	assert.Equal(t, ".test.Extra", ext.GetTypeName())
+	assert.Equal(t, ".test.Base", ext.GetExtendee())
+}
+
+func TestCompileError(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "undefined.proto", `syntax = "proto3";
+
+message Request {
+  Missing missing = 1;
+}
+`)
+	writeFile(t, dir, "a.proto", `syntax = "proto3";
+import "b.proto";
+`)
+	writeFile(t, dir, "b.proto", `syntax = "proto3";
+import "a.proto";
+`)
+	writeFile(t, dir, "import.proto", `syntax = "proto3";
+import "missing.proto";
+`)
+
+	tests := []struct {
+		file    string
+		message string
+	}{
+		{file: "undefined.proto", message: `undefined.proto:4:3: "Missing" is not defined`},
+		{file: "a.proto", message: "file recursively imports itself: a.proto -> b.proto -> a.proto"},
+		{file: "import.proto", message: `import.proto: import "missing.proto" was not found`},
+	}
+	for _, test := range tests {
+		c := Compiler{ImportPaths: []string{dir}}
+		_, err := c.Compile(filepath.Join(dir, test.file))
+		assert.EqualError(t, err, test.message)
+	}
+
+	c := Compiler{ImportPaths: []string{filepath.Join(dir, "sub")}}
+	_, err := c.Compile(filepath.Join(dir, "a.proto"))
+	assert.Error(t, err)
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,114 @@
+package protocompile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// unescape returns the value of the string literal of the proto file without the quotes,
+// the escapes of protoc are supported.
+func unescape(s string) ([]byte, error) {
+	var ret []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			ret = append(ret, c)
+			continue
+		}
+
+		i++
+		if i >= len(s) {
+			return nil, fmt.Errorf("invalid escape at the end of %q", s)
+		}
+		switch c = s[i]; c {
+		case 'a':
+			ret = append(ret, '\a')
+		case 'b':
+			ret = append(ret, '\b')
+		case 'f':
+			ret = append(ret, '\f')
+		case 'n':
+			ret = append(ret, '\n')
+		case 'r':
+			ret = append(ret, '\r')
+		case 't':
+			ret = append(ret, '\t')
+		case 'v':
+			ret = append(ret, '\v')
+		case '\\', '\'', '"', '?':
+			ret = append(ret, c)
+		case 'x', 'X':
+			j := i + 1
+			for j < len(s) && j < i+3 && isHex(s[j]) {
+				j++
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("invalid hex escape in %q", s)
+			}
+			v, _ := strconv.ParseUint(s[i+1:j], 16, 8)
+			ret = append(ret, byte(v))
+			i = j - 1
+		case 'u', 'U':
+			size := 4
+			if c == 'U' {
+				size = 8
+			}
+			if i+size >= len(s) {
+				return nil, fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			v, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(v)) {
+				return nil, fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			ret = append(ret, string(rune(v))...)
+			i += size
+		default:
+			if c < '0' || c > '7' {
+				return nil, fmt.Errorf("invalid escape \\%c in %q", c, s)
+			}
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			v, _ := strconv.ParseUint(s[i:j], 8, 16)
+			ret = append(ret, byte(v))
+			i = j - 1
+		}
+	}
+
+	return ret, nil
+}
+
+// escape is the CEscape of protoc
+func escape(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		switch c {
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '"':
+			sb.WriteString(`\"`)
+		case '\'':
+			sb.WriteString(`\'`)
+		case '\\':
+			sb.WriteString(`\\`)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				fmt.Fprintf(&sb, "\\%03o", c)
+			} else {
+				sb.WriteByte(c)
+			}
+		}
+	}
+	return sb.String()
+}
+
+func isHex(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
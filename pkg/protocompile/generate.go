@@ -0,0 +1,155 @@
+package protocompile
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/pkg/protocompile/gengrpc"
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const grpcDocURL = "https://grpc.io/docs/languages/go/quickstart/#regenerate-grpc-code"
+
+// GenerateOptions are the outputs and the options of protoc-gen-go and protoc-gen-go-grpc,
+// the outputs are like --go_out and --go-grpc_out of protoc which may be prefixed with the
+// parameters like paths=source_relative:.
+type GenerateOptions struct {
+	GoOut    string
+	GoOpts   []string
+	GrpcOut  string
+	GrpcOpts []string
+}
+
+// Generate compiles the files and generates the pb.go and the _grpc.pb.go files like protoc
+// does with protoc-gen-go and protoc-gen-go-grpc, the generators run in process.
+func (c *Compiler) Generate(opt GenerateOptions, files ...string) error {
+	result, err := c.Compile(files...)
+	if err != nil {
+		return err
+	}
+
+	if len(opt.GoOut) > 0 {
+		if err := result.run(opt.GoOut, opt.GoOpts, generateGo); err != nil {
+			return fmt.Errorf("--go_out: %w", err)
+		}
+	}
+	if len(opt.GrpcOut) > 0 {
+		if err := result.run(opt.GrpcOut, opt.GrpcOpts, generateGrpc); err != nil {
+			return fmt.Errorf("--go-grpc_out: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// generateGo is the main of protoc-gen-go
+func generateGo() (func(string, string) error, func(*protogen.Plugin) error) {
+	var (
+		flags   flag.FlagSet
+		plugins = flags.String("plugins", "", "deprecated option")
+	)
+	return flags.Set, func(gen *protogen.Plugin) error {
+		if *plugins != "" {
+			return errors.New("protoc-gen-go: plugins are not supported; use 'protoc --go-grpc_out=...' to generate gRPC\n\n" +
+				"See " + grpcDocURL + " for more information.")
+		}
+		for _, f := range gen.Files {
+			if f.Generate {
+				gengo.GenerateFile(gen, f)
+			}
+		}
+		gen.SupportedFeatures = gengo.SupportedFeatures
+		return nil
+	}
+}
+
+// generateGrpc is the main of protoc-gen-go-grpc
+func generateGrpc() (func(string, string) error, func(*protogen.Plugin) error) {
+	var flags flag.FlagSet
+	requireUnimplemented := flags.Bool("require_unimplemented_servers", true, "set to false to match legacy behavior")
+	return flags.Set, func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		for _, f := range gen.Files {
+			if f.Generate {
+				gengrpc.GenerateFile(gen, f, *requireUnimplemented)
+			}
+		}
+		return nil
+	}
+}
+
+// run runs the plugin with the request which protoc sends, the generated files are written
+// into the output directory.
+func (r *Result) run(out string, opts []string, plugin func() (func(string, string) error,
+	func(*protogen.Plugin) error)) error {
+	params, dir := splitOut(out)
+	params = append(params, opts...)
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: r.Generate,
+		ProtoFile:      r.Files,
+	}
+	if len(params) > 0 {
+		req.Parameter = protobuf.String(strings.Join(params, ","))
+	}
+
+	// the request goes through the wire like it's sent to a plugin
+	data, err := protobuf.Marshal(req)
+	if err != nil {
+		return err
+	}
+	req = new(pluginpb.CodeGeneratorRequest)
+	if err := protobuf.Unmarshal(data, req); err != nil {
+		return err
+	}
+
+	paramFunc, generate := plugin()
+	gen, err := protogen.Options{ParamFunc: paramFunc}.New(req)
+	if err != nil {
+		return err
+	}
+	if err := generate(gen); err != nil {
+		gen.Error(err)
+	}
+	resp := gen.Response()
+	if resp.Error != nil {
+		return errors.New(resp.GetError())
+	}
+
+	for _, file := range resp.File {
+		if len(file.GetInsertionPoint()) > 0 {
+			return fmt.Errorf("%s: insertion points are not supported", file.GetName())
+		}
+
+		filename := filepath.Join(dir, filepath.FromSlash(file.GetName()))
+		if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filename, []byte(file.GetContent()), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitOut splits the parameters and the directory of the output like protoc does
+func splitOut(out string) ([]string, string) {
+	i := strings.Index(out, ":")
+	// the volume of windows is not a parameter
+	if i < 0 || len(filepath.VolumeName(out)) == i+1 {
+		return nil, out
+	}
+
+	var params []string
+	if i > 0 {
+		params = append(params, out[:i])
+	}
+	return params, out[i+1:]
+}
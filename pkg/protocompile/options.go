@@ -0,0 +1,359 @@
+package protocompile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// interpreter sets the options of the descriptors, the custom options are encoded as the
+// unknown fields of the options like a plugin receives them from protoc.
+type interpreter struct {
+	b       *builder
+	symbols symbols
+	files   *protoregistry.Files
+	// local is the file being built for the custom options defined in the same file
+	local *protoregistry.Files
+}
+
+func (i *interpreter) interpret() error {
+	for _, pending := range i.b.options {
+		msg := pending.message.ProtoReflect()
+		var custom *dynamicpb.Message
+		for _, option := range pending.list {
+			ext, rest := splitOptionName(option.Name)
+			if len(ext) == 0 {
+				fd := msg.Descriptor().Fields().ByName(protoreflect.Name(rest[0]))
+				if fd == nil {
+					return i.errorf(option, "option %q unknown", option.Name)
+				}
+				if err := i.setPath(msg, fd, rest[1:], &option.Constant); err != nil {
+					return i.errorf(option, "%v", err)
+				}
+				continue
+			}
+
+			xd, err := i.extension(ext, pending.scope)
+			if err != nil {
+				return i.errorf(option, "%v", err)
+			}
+			if xd.ContainingMessage().FullName() != msg.Descriptor().FullName() {
+				return i.errorf(option, "option %q is an extension of %q, not %q", ext,
+					xd.ContainingMessage().FullName(), msg.Descriptor().FullName())
+			}
+			if custom == nil {
+				custom = dynamicpb.NewMessage(msg.Descriptor())
+			}
+			xt := dynamicpb.NewExtensionType(xd)
+			if err := i.setPath(custom, xt.TypeDescriptor(), rest, &option.Constant); err != nil {
+				return i.errorf(option, "%v", err)
+			}
+		}
+
+		if custom != nil {
+			b, err := appendMessage(nil, custom)
+			if err != nil {
+				return err
+			}
+			msg.SetUnknown(append(msg.GetUnknown(), b...))
+		}
+		pending.attach()
+	}
+
+	return nil
+}
+
+func (i *interpreter) errorf(option *proto.Option, format string, args ...interface{}) error {
+	return fmt.Errorf("%s:%d:%d: %s", i.b.name, option.Position.Line, option.Position.Column,
+		fmt.Sprintf(format, args...))
+}
+
+// extension returns the extension of the custom option name
+func (i *interpreter) extension(name, scope string) (protoreflect.ExtensionDescriptor, error) {
+	full, kind, ok := i.symbols.lookup(name, scope, false)
+	if !ok {
+		return nil, fmt.Errorf("option %q unknown", name)
+	}
+	if kind != symbolExtension {
+		return nil, fmt.Errorf("option %q is not an extension", name)
+	}
+
+	d, err := i.files.FindDescriptorByName(protoreflect.FullName(full))
+	if err != nil {
+		if i.local == nil {
+			// the file is built without the options to find the extensions in it
+			fd := protobuf.Clone(i.b.fd).(*descriptorpb.FileDescriptorProto)
+			desc, err := protodesc.NewFile(fd, i.files)
+			if err != nil {
+				return nil, err
+			}
+			i.local = new(protoregistry.Files)
+			if err := i.local.RegisterFile(desc); err != nil {
+				return nil, err
+			}
+		}
+		d, err = i.local.FindDescriptorByName(protoreflect.FullName(full))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	xd, ok := d.(protoreflect.ExtensionDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("option %q is not an extension", name)
+	}
+	return xd, nil
+}
+
+// setPath sets the value of the field, the path are the names of the fields in the message
+// of the field.
+func (i *interpreter) setPath(m protoreflect.Message, fd protoreflect.FieldDescriptor, path []string,
+	lit *proto.Literal) error {
+	for _, name := range path {
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("%q is not a message", fd.FullName())
+		}
+		m = m.Mutable(fd).Message()
+		fd = m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return fmt.Errorf("%q has no field named %q", m.Descriptor().FullName(), name)
+		}
+	}
+
+	return setValue(m, fd, lit)
+}
+
+func setValue(m protoreflect.Message, fd protoreflect.FieldDescriptor, lit *proto.Literal) error {
+	switch {
+	case fd.IsMap():
+		return fmt.Errorf("the map field %q is not supported in options", fd.FullName())
+	case fd.IsList():
+		list := m.Mutable(fd).List()
+		values := lit.Array
+		if values == nil {
+			values = []*proto.Literal{lit}
+		}
+		for _, each := range values {
+			if isMessage(fd) {
+				v := list.NewElement()
+				if err := fillMessage(v.Message(), each); err != nil {
+					return err
+				}
+				list.Append(v)
+				continue
+			}
+
+			v, err := scalarValue(fd, each)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	case isMessage(fd):
+		return fillMessage(m.Mutable(fd).Message(), lit)
+	default:
+		v, err := scalarValue(fd, lit)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+		return nil
+	}
+}
+
+// fillMessage fills the message with the aggregate value in the text format
+func fillMessage(m protoreflect.Message, lit *proto.Literal) error {
+	if lit.OrderedMap == nil && len(lit.Source) > 0 {
+		return fmt.Errorf("expected an aggregate value of %q, got %q", m.Descriptor().FullName(), lit.Source)
+	}
+
+	fields := m.Descriptor().Fields()
+	for _, each := range lit.OrderedMap {
+		fd := fields.ByName(protoreflect.Name(each.Name))
+		if fd == nil {
+			// the fields of the groups are named after the types
+			fd = fields.ByName(protoreflect.Name(strings.ToLower(each.Name)))
+			if fd == nil || fd.Kind() != protoreflect.GroupKind {
+				return fmt.Errorf("%q has no field named %q", m.Descriptor().FullName(), each.Name)
+			}
+		}
+		if err := setValue(m, fd, each.Literal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, lit *proto.Literal) (protoreflect.Value, error) {
+	source := lit.Source
+	if lit.IsString != (fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind) {
+		return protoreflect.Value{}, fmt.Errorf("invalid value %s of %q", lit.SourceRepresentation(), fd.FullName())
+	}
+
+	var (
+		v   protoreflect.Value
+		err error
+	)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if source != "true" && source != "false" {
+			return v, fmt.Errorf("expected \"true\" or \"false\" of %q, got %q", fd.FullName(), source)
+		}
+		v = protoreflect.ValueOfBool(source == "true")
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByName(protoreflect.Name(source))
+		if ev == nil {
+			return v, fmt.Errorf("enum %q has no value named %q", fd.Enum().FullName(), source)
+		}
+		v = protoreflect.ValueOfEnum(ev.Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		var n int64
+		n, err = strconv.ParseInt(source, 0, 32)
+		v = protoreflect.ValueOfInt32(int32(n))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		var n int64
+		n, err = strconv.ParseInt(source, 0, 64)
+		v = protoreflect.ValueOfInt64(n)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		var n uint64
+		n, err = strconv.ParseUint(source, 0, 32)
+		v = protoreflect.ValueOfUint32(uint32(n))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		var n uint64
+		n, err = strconv.ParseUint(source, 0, 64)
+		v = protoreflect.ValueOfUint64(n)
+	case protoreflect.FloatKind:
+		var f float64
+		f, err = parseFloat(source)
+		v = protoreflect.ValueOfFloat32(float32(f))
+	case protoreflect.DoubleKind:
+		var f float64
+		f, err = parseFloat(source)
+		v = protoreflect.ValueOfFloat64(f)
+	case protoreflect.StringKind:
+		var b []byte
+		b, err = unescape(source)
+		v = protoreflect.ValueOfString(string(b))
+	case protoreflect.BytesKind:
+		var b []byte
+		b, err = unescape(source)
+		v = protoreflect.ValueOfBytes(b)
+	default:
+		err = fmt.Errorf("unsupported kind %v", fd.Kind())
+	}
+	if err != nil {
+		return v, fmt.Errorf("invalid value %s of %q: %v", lit.SourceRepresentation(), fd.FullName(), err)
+	}
+
+	return v, nil
+}
+
+func parseFloat(s string) (float64, error) {
+	switch strings.ToLower(s) {
+	case "inf", "+inf", "infinity":
+		return math.Inf(1), nil
+	case "-inf", "-infinity":
+		return math.Inf(-1), nil
+	case "nan", "-nan":
+		return math.NaN(), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+// splitOptionName splits the option name like (foo.bar).baz into the extension name and the
+// names of the fields, the extension name is empty for the options of descriptor.proto.
+func splitOptionName(name string) (string, []string) {
+	if !strings.HasPrefix(name, "(") {
+		return "", strings.Split(name, ".")
+	}
+
+	end := strings.Index(name, ")")
+	if end < 0 {
+		return name[1:], nil
+	}
+	ext := name[1:end]
+	rest := strings.TrimPrefix(name[end+1:], ".")
+	if len(rest) == 0 {
+		return ext, nil
+	}
+	return ext, strings.Split(rest, ".")
+}
+
+// appendMessage encodes the message with the fields in the order of the numbers like protoc
+// does, proto.Marshal orders the extensions by the names.
+func appendMessage(b []byte, m protoreflect.Message) ([]byte, error) {
+	type field struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+	var fields []field
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, field{fd: fd, v: v})
+		return true
+	})
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].fd.Number() < fields[j].fd.Number()
+	})
+
+	var err error
+	for _, each := range fields {
+		b, err = appendField(b, m, each.fd, each.v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(b, m.GetUnknown()...), nil
+}
+
+func appendField(b []byte, m protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	if !isMessage(fd) || fd.IsMap() {
+		single := m.New()
+		single.Set(fd, v)
+		data, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(single.Interface())
+		if err != nil {
+			return nil, err
+		}
+		return append(b, data...), nil
+	}
+
+	values := []protoreflect.Value{v}
+	if fd.IsList() {
+		values = values[:0]
+		for i := 0; i < v.List().Len(); i++ {
+			values = append(values, v.List().Get(i))
+		}
+	}
+
+	for _, each := range values {
+		data, err := appendMessage(nil, each.Message())
+		if err != nil {
+			return nil, err
+		}
+		if fd.Kind() == protoreflect.GroupKind {
+			b = protowire.AppendTag(b, fd.Number(), protowire.StartGroupType)
+			b = append(b, data...)
+			b = protowire.AppendTag(b, fd.Number(), protowire.EndGroupType)
+			continue
+		}
+		b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+		b = protowire.AppendBytes(b, data)
+	}
+	return b, nil
+}
@@ -0,0 +1,93 @@
+package protocompile
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type symbolKind int
+
+const (
+	symbolPackage symbolKind = iota + 1
+	symbolMessage
+	symbolEnum
+	symbolService
+	symbolExtension
+)
+
+// symbols are the fully qualified names visible to a file
+type symbols map[string]symbolKind
+
+func (s symbols) addFile(fd *descriptorpb.FileDescriptorProto) {
+	pkg := fd.GetPackage()
+	for len(pkg) > 0 {
+		if _, ok := s[pkg]; !ok {
+			s[pkg] = symbolPackage
+		}
+		pkg = parentScope(pkg)
+	}
+
+	scope := fd.GetPackage()
+	for _, each := range fd.MessageType {
+		s.addMessage(scope, each)
+	}
+	for _, each := range fd.EnumType {
+		s[join(scope, each.GetName())] = symbolEnum
+	}
+	for _, each := range fd.Service {
+		s[join(scope, each.GetName())] = symbolService
+	}
+	for _, each := range fd.Extension {
+		s[join(scope, each.GetName())] = symbolExtension
+	}
+}
+
+func (s symbols) addMessage(scope string, md *descriptorpb.DescriptorProto) {
+	name := join(scope, md.GetName())
+	s[name] = symbolMessage
+	for _, each := range md.NestedType {
+		s.addMessage(name, each)
+	}
+	for _, each := range md.EnumType {
+		s[join(name, each.GetName())] = symbolEnum
+	}
+	for _, each := range md.Extension {
+		s[join(name, each.GetName())] = symbolExtension
+	}
+}
+
+// lookup resolves the name in the scope with the scoping rules of protoc, the first part of
+// a compound name is searched from the innermost scope outwards, types reports whether the
+// single name must be a message or an enum.
+func (s symbols) lookup(name, scope string, types bool) (string, symbolKind, bool) {
+	if strings.HasPrefix(name, ".") {
+		kind, ok := s[name[1:]]
+		return name[1:], kind, ok
+	}
+
+	first := name
+	if i := strings.Index(name, "."); i >= 0 {
+		first = name[:i]
+	}
+	for {
+		candidate := join(scope, first)
+		if kind, ok := s[candidate]; ok {
+			if first != name {
+				// the rest of the name is looked up in the aggregate which is found
+				if kind != symbolExtension {
+					full := join(scope, name)
+					kind, ok = s[full]
+					return full, kind, ok
+				}
+			} else if !types || kind == symbolMessage || kind == symbolEnum {
+				return candidate, kind, true
+			}
+		}
+
+		if len(scope) == 0 {
+			return "", 0, false
+		}
+		scope = parentScope(scope)
+	}
+}
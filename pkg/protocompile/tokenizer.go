@@ -0,0 +1,437 @@
+package protocompile
+
+import "strings"
+
+type tokenType int
+
+const (
+	tokenStart tokenType = iota
+	tokenEnd
+	tokenIdentifier
+	tokenNumber
+	tokenString
+	tokenSymbol
+)
+
+type commentType int
+
+const (
+	noComment commentType = iota
+	lineComment
+	blockComment
+	slashNotComment
+)
+
+// token is a token of the proto file, the line and the columns are zero based and the tabs
+// are expanded like protoc does, the rune column is one based and counts a tab as one
+// character like text/scanner does.
+type token struct {
+	typ       tokenType
+	text      string
+	line      int
+	column    int
+	endColumn int
+	runeCol   int
+}
+
+// tokenizer is the tokenizer of protoc, the comments are collected by nextWithComments
+// with the same rules, so the comments are attached to the same declarations.
+type tokenizer struct {
+	src      []byte
+	pos      int
+	line     int
+	column   int
+	runeCol  int
+	current  token
+	previous token
+	// record is the buffer of the comment being consumed
+	record    *strings.Builder
+	recordPos int
+}
+
+func newTokenizer(src []byte) *tokenizer {
+	return &tokenizer{
+		src:     src,
+		runeCol: 1,
+		current: token{typ: tokenStart},
+	}
+}
+
+func (t *tokenizer) char() byte {
+	if t.pos >= len(t.src) {
+		return 0
+	}
+	return t.src[t.pos]
+}
+
+func (t *tokenizer) nextChar() {
+	c := t.char()
+	switch {
+	case c == '\n':
+		t.line++
+		t.column = 0
+		t.runeCol = 1
+	case c == '\t':
+		t.column += 8 - t.column%8
+		t.runeCol++
+	default:
+		t.column++
+		// the continuation bytes of utf-8 are not characters
+		if c&0xc0 != 0x80 {
+			t.runeCol++
+		}
+	}
+	t.pos++
+}
+
+func (t *tokenizer) tryConsume(c byte) bool {
+	if t.pos < len(t.src) && t.char() == c {
+		t.nextChar()
+		return true
+	}
+	return false
+}
+
+func (t *tokenizer) recordTo(b *strings.Builder) {
+	t.record = b
+	t.recordPos = t.pos
+}
+
+func (t *tokenizer) stopRecording() {
+	if t.record != nil {
+		t.record.Write(t.src[t.recordPos:t.pos])
+		t.record = nil
+	}
+}
+
+func isWhitespace(c byte, newline bool) bool {
+	switch c {
+	case ' ', '\t', '\r', '\v', '\f':
+		return true
+	case '\n':
+		return newline
+	}
+	return false
+}
+
+func (t *tokenizer) consumeWhitespace(newline bool) {
+	for t.pos < len(t.src) && isWhitespace(t.char(), newline) {
+		t.nextChar()
+	}
+}
+
+func (t *tokenizer) tryConsumeCommentStart() commentType {
+	if !t.tryConsume('/') {
+		return noComment
+	}
+	if t.tryConsume('/') {
+		return lineComment
+	}
+	if t.tryConsume('*') {
+		return blockComment
+	}
+
+	t.previous = t.current
+	t.current = token{
+		typ:       tokenSymbol,
+		text:      "/",
+		line:      t.line,
+		column:    t.column - 1,
+		endColumn: t.column,
+		runeCol:   t.runeCol - 1,
+	}
+	return slashNotComment
+}
+
+func (t *tokenizer) consumeLineComment(b *strings.Builder) {
+	if b != nil {
+		t.recordTo(b)
+	}
+	for t.pos < len(t.src) && t.char() != '\n' {
+		t.nextChar()
+	}
+	t.tryConsume('\n')
+	if b != nil {
+		t.stopRecording()
+	}
+}
+
+func (t *tokenizer) consumeBlockComment(b *strings.Builder) {
+	if b != nil {
+		t.recordTo(b)
+	}
+
+	for {
+		for t.pos < len(t.src) && t.char() != '*' && t.char() != '/' && t.char() != '\n' {
+			t.nextChar()
+		}
+
+		if t.tryConsume('\n') {
+			if b != nil {
+				t.stopRecording()
+			}
+			// the leading whitespaces and asterisk are not a part of the comment
+			t.consumeWhitespace(false)
+			if t.tryConsume('*') && t.tryConsume('/') {
+				return
+			}
+			if b != nil {
+				t.recordTo(b)
+			}
+		} else if t.tryConsume('*') && t.tryConsume('/') {
+			if b != nil {
+				t.stopRecording()
+				s := b.String()
+				b.Reset()
+				b.WriteString(s[:len(s)-2])
+			}
+			return
+		} else if t.tryConsume('/') {
+			continue
+		} else if t.pos >= len(t.src) {
+			if b != nil {
+				t.stopRecording()
+			}
+			return
+		}
+	}
+}
+
+// next reads the next token, the comments are skipped.
+func (t *tokenizer) next() bool {
+	t.previous = t.current
+	for t.pos < len(t.src) {
+		t.consumeWhitespace(true)
+		switch t.tryConsumeCommentStart() {
+		case lineComment:
+			t.consumeLineComment(nil)
+			continue
+		case blockComment:
+			t.consumeBlockComment(nil)
+			continue
+		case slashNotComment:
+			return true
+		}
+		if t.pos >= len(t.src) {
+			break
+		}
+
+		t.readToken()
+		return true
+	}
+
+	t.current = token{
+		typ:       tokenEnd,
+		line:      t.line,
+		column:    t.column,
+		endColumn: t.column,
+		runeCol:   t.runeCol,
+	}
+	return false
+}
+
+func (t *tokenizer) readToken() {
+	start, line, column, runeCol := t.pos, t.line, t.column, t.runeCol
+	c := t.char()
+	typ := tokenSymbol
+	switch {
+	case isLetter(c):
+		typ = tokenIdentifier
+		for t.pos < len(t.src) && (isLetter(t.char()) || isDigit(t.char())) {
+			t.nextChar()
+		}
+	case isDigit(c) || c == '.' && t.pos+1 < len(t.src) && isDigit(t.src[t.pos+1]):
+		typ = tokenNumber
+		t.consumeNumber()
+	case c == '"' || c == '\'':
+		typ = tokenString
+		t.nextChar()
+		t.consumeString(c)
+	default:
+		t.nextChar()
+	}
+
+	t.current = token{
+		typ:       typ,
+		text:      string(t.src[start:t.pos]),
+		line:      line,
+		column:    column,
+		endColumn: t.column,
+		runeCol:   runeCol,
+	}
+}
+
+func (t *tokenizer) consumeNumber() {
+	for t.pos < len(t.src) {
+		c := t.char()
+		switch {
+		case isDigit(c) || isLetter(c) || c == '.':
+			t.nextChar()
+			if (c == 'e' || c == 'E') && (t.char() == '+' || t.char() == '-') {
+				t.nextChar()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (t *tokenizer) consumeString(delimiter byte) {
+	for t.pos < len(t.src) {
+		switch t.char() {
+		case delimiter:
+			t.nextChar()
+			return
+		case '\n':
+			return
+		case '\\':
+			t.nextChar()
+			if t.pos < len(t.src) {
+				t.nextChar()
+			}
+		default:
+			t.nextChar()
+		}
+	}
+}
+
+// nextWithComments reads the next token like next, the comments between the tokens are
+// collected as the trailing comments of the previous token, the detached comments and the
+// leading comments of the next token.
+func (t *tokenizer) nextWithComments(prevTrailing *string, detached *[]string, nextLeading *string) bool {
+	c := commentCollector{
+		prevTrailing: prevTrailing,
+		detached:     detached,
+		nextLeading:  nextLeading,
+		canAttach:    true,
+	}
+	defer c.finish()
+
+	if t.current.typ == tokenStart {
+		// the utf-8 byte order mark is ignored
+		if strings.HasPrefix(string(t.src), "\xef\xbb\xbf") {
+			t.pos += 3
+		}
+		c.detachFromPrev()
+	} else {
+		// the comment on the same line is attached to the previous declaration
+		t.consumeWhitespace(false)
+		switch t.tryConsumeCommentStart() {
+		case lineComment:
+			t.consumeLineComment(c.lineBuffer())
+			c.flush()
+		case blockComment:
+			t.consumeBlockComment(c.blockBuffer())
+			t.consumeWhitespace(false)
+			if !t.tryConsume('\n') {
+				c.clear()
+				return t.next()
+			}
+			c.flush()
+		case slashNotComment:
+			return true
+		case noComment:
+			if !t.tryConsume('\n') {
+				return t.next()
+			}
+		}
+	}
+
+	for {
+		t.consumeWhitespace(false)
+		switch t.tryConsumeCommentStart() {
+		case lineComment:
+			t.consumeLineComment(c.lineBuffer())
+		case blockComment:
+			t.consumeBlockComment(c.blockBuffer())
+			t.consumeWhitespace(false)
+			t.tryConsume('\n')
+		case slashNotComment:
+			return true
+		case noComment:
+			if t.tryConsume('\n') {
+				c.flush()
+				c.detachFromPrev()
+				continue
+			}
+
+			ok := t.next()
+			if !ok || t.current.text == "}" || t.current.text == "]" || t.current.text == ")" {
+				// the comments at the end of a scope are not attached to the following token
+				c.flush()
+			}
+			return ok
+		}
+	}
+}
+
+// commentCollector is the CommentCollector of protoc
+type commentCollector struct {
+	prevTrailing *string
+	detached     *[]string
+	nextLeading  *string
+	buffer       strings.Builder
+	hasComment   bool
+	isLine       bool
+	canAttach    bool
+}
+
+func (c *commentCollector) lineBuffer() *strings.Builder {
+	// the line comments are combined, but not with the block comments
+	if c.hasComment && !c.isLine {
+		c.flush()
+	}
+	c.hasComment = true
+	c.isLine = true
+	return &c.buffer
+}
+
+func (c *commentCollector) blockBuffer() *strings.Builder {
+	if c.hasComment {
+		c.flush()
+	}
+	c.hasComment = true
+	c.isLine = false
+	return &c.buffer
+}
+
+func (c *commentCollector) clear() {
+	c.buffer.Reset()
+	c.hasComment = false
+}
+
+func (c *commentCollector) flush() {
+	if !c.hasComment {
+		return
+	}
+
+	if c.canAttach {
+		if c.prevTrailing != nil {
+			*c.prevTrailing += c.buffer.String()
+		}
+		c.canAttach = false
+	} else if c.detached != nil {
+		*c.detached = append(*c.detached, c.buffer.String())
+	}
+	c.clear()
+}
+
+func (c *commentCollector) detachFromPrev() {
+	c.canAttach = false
+}
+
+// finish takes what is left in the buffer as the leading comments
+func (c *commentCollector) finish() {
+	if c.nextLeading != nil && c.hasComment {
+		*c.nextLeading = c.buffer.String()
+		c.clear()
+	}
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
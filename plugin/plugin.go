@@ -1,13 +1,10 @@
 package plugin
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"io/ioutil"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -16,7 +13,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yeyudekuangxiang/goctl/api/parser"
 	"github.com/yeyudekuangxiang/goctl/api/spec"
-	"github.com/yeyudekuangxiang/goctl/rpc/execx"
 	"github.com/yeyudekuangxiang/goctl/util/pathx"
 )
 
@@ -39,13 +35,24 @@ var (
 	VarStringAPI string
 	// VarStringStyle describes a style.
 	VarStringStyle string
+	// VarStringLock describes the lock file which pins the remote plugins.
+	VarStringLock string
+	// VarBoolUnpinned describes whether to run the remote plugins which are not pinned.
+	VarBoolUnpinned bool
+	// VarBoolSchema describes whether to print the json schema of the plugin protocol.
+	VarBoolSchema bool
 )
 
 // PluginCommand is the entry of goctl api plugin
 func PluginCommand(_ *cobra.Command, _ []string) error {
-	ex, err := os.Executable()
-	if err != nil {
-		panic(err)
+	if VarBoolSchema {
+		schema, err := Schema()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(schema))
+		return nil
 	}
 
 	plugin := VarStringPlugin
@@ -53,41 +60,22 @@ func PluginCommand(_ *cobra.Command, _ []string) error {
 		return errors.New("missing plugin")
 	}
 
-	transferData, err := prepareArgs()
-	if err != nil {
-		return err
-	}
-
-	bin, args := getPluginAndArgs(plugin)
-
-	bin, download, err := getCommand(bin)
+	req, err := prepareArgs()
 	if err != nil {
 		return err
 	}
 
-	if download {
-		defer func() {
-			_ = os.Remove(bin)
-		}()
-	}
-
-	content, err := execx.Run(bin+" "+args, filepath.Dir(ex), bytes.NewBuffer(transferData))
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(content)
-	return nil
+	return Run(plugin, req)
 }
 
-func prepareArgs() ([]byte, error) {
+func prepareArgs() (Request, error) {
 	apiPath := VarStringAPI
 
-	var transferData Plugin
+	transferData := Request{Kind: KindApi}
 	if len(apiPath) > 0 && pathx.FileExists(apiPath) {
 		api, err := parser.Parse(apiPath)
 		if err != nil {
-			return nil, err
+			return transferData, err
 		}
 
 		transferData.Api = api
@@ -95,23 +83,18 @@ func prepareArgs() ([]byte, error) {
 
 	absApiFilePath, err := filepath.Abs(apiPath)
 	if err != nil {
-		return nil, err
+		return transferData, err
 	}
 
 	transferData.ApiFilePath = absApiFilePath
 	dirAbs, err := filepath.Abs(VarStringDir)
 	if err != nil {
-		return nil, err
+		return transferData, err
 	}
 
 	transferData.Dir = dirAbs
 	transferData.Style = VarStringStyle
-	data, err := json.Marshal(transferData)
-	if err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return transferData, nil
 }
 
 func getCommand(arg string) (string, bool, error) {
@@ -124,48 +107,35 @@ func getCommand(arg string) (string, bool, error) {
 		return abs, false, nil
 	}
 
-	defaultErr := errors.New("invalid plugin value " + arg)
-	if strings.HasPrefix(arg, "http") {
-		items := strings.Split(arg, "/")
-		if len(items) == 0 {
-			return "", false, defaultErr
-		}
-
-		filename, err := filepath.Abs(pluginArg + items[len(items)-1])
-		if err != nil {
-			return "", false, err
-		}
-
-		err = downloadFile(filename, arg)
-		if err != nil {
-			return "", false, err
-		}
+	if bin, ok := discover(arg); ok {
+		return bin, false, nil
+	}
 
-		os.Chmod(filename, os.ModePerm)
-		return filename, true, nil
+	if strings.HasPrefix(arg, "http") {
+		return fetchRemote(arg)
 	}
 	return arg, false, nil
 }
 
-func downloadFile(filepath, url string) error {
-	resp, err := http.Get(url)
-	if err != nil {
-		return err
+// discover looks up the plugin in the plugin dir of goctl home, the plugin may be
+// named with the prefix goctl-.
+func discover(name string) (string, bool) {
+	if len(name) == 0 || strings.ContainsAny(name, `/\`) {
+		return "", false
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
-	out, err := os.Create(filepath)
+	dir, err := pathx.GetPluginDir()
 	if err != nil {
-		return err
+		return "", false
 	}
-	defer func() {
-		_ = out.Close()
-	}()
 
-	_, err = io.Copy(out, resp.Body)
-	return err
+	for _, each := range []string{name, "goctl-" + name} {
+		bin := filepath.Join(dir, each)
+		if info, err := os.Stat(bin); err == nil && !info.IsDir() {
+			return bin, true
+		}
+	}
+	return "", false
 }
 
 // NewPlugin returns contextual resources when written in other languages
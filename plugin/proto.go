@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+type (
+	// Proto describes a proto file for the plugins, the elements of emicklei/proto
+	// refer to their parents and can't be encoded as json.
+	Proto struct {
+		Name      string
+		Package   string
+		GoPackage string
+		Imports   []string
+		Messages  []Message
+		Services  []Service
+	}
+
+	// Message describes a message, the nested messages are named like Outer.Inner.
+	Message struct {
+		Name    string
+		Comment string `json:",omitempty"`
+		Fields  []MessageField
+	}
+
+	// MessageField describes a field of a message, the types of the map fields are
+	// like map<string, int64>.
+	MessageField struct {
+		Name     string
+		Type     string
+		Number   int
+		Repeated bool   `json:",omitempty"`
+		Optional bool   `json:",omitempty"`
+		OneOf    string `json:",omitempty"`
+		Comment  string `json:",omitempty"`
+	}
+
+	// Service describes a service.
+	Service struct {
+		Name    string
+		Comment string `json:",omitempty"`
+		RPCs    []RPC
+	}
+
+	// RPC describes a rpc of a service.
+	RPC struct {
+		Name           string
+		RequestType    string
+		ReturnsType    string
+		StreamsRequest bool   `json:",omitempty"`
+		StreamsReturns bool   `json:",omitempty"`
+		Comment        string `json:",omitempty"`
+	}
+)
+
+// NewProto converts the parsed proto for the plugins.
+func NewProto(p parser.Proto) *Proto {
+	ret := &Proto{
+		Name:      p.Name,
+		GoPackage: p.GoPackage,
+	}
+	if p.Package.Package != nil {
+		ret.Package = p.Package.Name
+	}
+	for _, each := range p.Import {
+		ret.Imports = append(ret.Imports, each.Filename)
+	}
+	for _, each := range p.Message {
+		// the nested messages are walked too, they are converted with their parents
+		if _, ok := each.Parent.(*proto.Message); ok {
+			continue
+		}
+		ret.Messages = append(ret.Messages, convertMessage("", each.Message)...)
+	}
+	for _, each := range p.Service {
+		service := Service{Name: each.Name, Comment: comment(each.Comment)}
+		for _, rpc := range each.RPC {
+			service.RPCs = append(service.RPCs, RPC{
+				Name:           rpc.Name,
+				RequestType:    rpc.RequestType,
+				ReturnsType:    rpc.ReturnsType,
+				StreamsRequest: rpc.StreamsRequest,
+				StreamsReturns: rpc.StreamsReturns,
+				Comment:        comment(rpc.Comment),
+			})
+		}
+		ret.Services = append(ret.Services, service)
+	}
+
+	return ret
+}
+
+// convertMessage returns the message followed by its nested messages
+func convertMessage(prefix string, m *proto.Message) []Message {
+	msg := Message{Name: prefix + m.Name, Comment: comment(m.Comment)}
+	var nested []Message
+	for _, element := range m.Elements {
+		switch v := element.(type) {
+		case *proto.NormalField:
+			field := newMessageField(v.Field)
+			field.Repeated = v.Repeated
+			field.Optional = v.Optional
+			msg.Fields = append(msg.Fields, field)
+		case *proto.MapField:
+			field := newMessageField(v.Field)
+			field.Type = fmt.Sprintf("map<%s, %s>", v.KeyType, v.Type)
+			msg.Fields = append(msg.Fields, field)
+		case *proto.Oneof:
+			for _, each := range v.Elements {
+				if oneOf, ok := each.(*proto.OneOfField); ok {
+					field := newMessageField(oneOf.Field)
+					field.OneOf = v.Name
+					msg.Fields = append(msg.Fields, field)
+				}
+			}
+		case *proto.Message:
+			nested = append(nested, convertMessage(msg.Name+".", v)...)
+		}
+	}
+
+	return append([]Message{msg}, nested...)
+}
+
+func newMessageField(f *proto.Field) MessageField {
+	return MessageField{
+		Name:    f.Name,
+		Type:    f.Type,
+		Number:  f.Sequence,
+		Comment: comment(f.Comment),
+	}
+}
+
+func comment(c *proto.Comment) string {
+	if c == nil {
+		return ""
+	}
+
+	var lines []string
+	for _, line := range c.Lines {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
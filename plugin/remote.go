@@ -0,0 +1,151 @@
+package plugin
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/pkg/downloader"
+	"github.com/yeyudekuangxiang/goctl/util/console"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+const (
+	sha256Separator = "@sha256:"
+	// defaultLockFile pins the remote plugins, each line is the url of a plugin
+	// followed by sha256:<hex>, the lines starting with # are comments.
+	defaultLockFile = "goctl-plugin.lock"
+	cachePluginDir  = "plugins"
+)
+
+var sha256Regex = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// fetchRemote downloads the remote plugin, the pinned plugins are cached by sha256 and
+// verified, the unpinned ones are refused unless --unpinned is given, then they're downloaded
+// into the temp dir and must be removed after.
+func fetchRemote(arg string) (string, bool, error) {
+	link, sum := parseRemote(arg)
+	if len(sum) == 0 {
+		var err error
+		sum, err = lookupLock(link)
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", false, err
+	}
+
+	if len(sum) == 0 {
+		if !VarBoolUnpinned {
+			return "", false, fmt.Errorf("plugin %s is not pinned, pin it by %s%s<hex> or in %s, "+
+				"or run it with --unpinned", link, link, sha256Separator, lockFile())
+		}
+
+		console.Warning("plugin %s is not pinned, pin it by %s%s<hex> or in %s", link, link,
+			sha256Separator, lockFile())
+		f, err := os.CreateTemp("", pluginArg+"*"+path.Ext(u.Path))
+		if err != nil {
+			return "", false, err
+		}
+		_ = f.Close()
+
+		if err = download(link, f.Name()); err != nil {
+			_ = os.Remove(f.Name())
+			return "", false, err
+		}
+
+		return f.Name(), true, nil
+	}
+
+	sum = strings.ToLower(sum)
+	if !sha256Regex.MatchString(sum) {
+		return "", false, fmt.Errorf("invalid sha256 %q of plugin %s", sum, link)
+	}
+
+	cacheDir, err := pathx.GetCacheDir()
+	if err != nil {
+		return "", false, err
+	}
+
+	dir := filepath.Join(cacheDir, cachePluginDir)
+	if err = pathx.MkdirIfNotExist(dir); err != nil {
+		return "", false, err
+	}
+
+	// the plugins are addressed by content, the extension keeps them executable on windows
+	filename := filepath.Join(dir, sum+path.Ext(u.Path))
+	if actual, err := downloader.Sha256(filename); err == nil && actual == sum {
+		return filename, false, nil
+	}
+
+	if err = download(link, filename, downloader.WithSha256(sum)); err != nil {
+		return "", false, err
+	}
+
+	return filename, false, nil
+}
+
+func download(link, filename string, opts ...downloader.Option) error {
+	if err := downloader.Download(link, filename, opts...); err != nil {
+		return err
+	}
+
+	return os.Chmod(filename, 0o755)
+}
+
+// parseRemote splits the url and the sha256 of url@sha256:<hex>
+func parseRemote(arg string) (string, string) {
+	i := strings.LastIndex(arg, sha256Separator)
+	if i < 0 {
+		return arg, ""
+	}
+
+	return arg[:i], arg[i+len(sha256Separator):]
+}
+
+// lookupLock returns the sha256 of the url in the lock file, it returns empty if
+// the lock file doesn't exist or the url isn't pinned.
+func lookupLock(link string) (string, error) {
+	f, err := os.Open(lockFile())
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "sha256:") {
+			return "", fmt.Errorf("%s: invalid line %q, expected <url> sha256:<hex>", lockFile(), line)
+		}
+		if fields[0] == link {
+			return strings.TrimPrefix(fields[1], "sha256:"), nil
+		}
+	}
+
+	return "", scanner.Err()
+}
+
+func lockFile() string {
+	if len(VarStringLock) > 0 {
+		return VarStringLock
+	}
+
+	return defaultLockFile
+}
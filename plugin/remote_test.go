@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/pkg/downloader"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+const remoteContent = "#!/bin/sh\necho remote\n"
+
+func newRemoteServer(t *testing.T) (*httptest.Server, *int32) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(remoteContent))
+	}))
+	t.Cleanup(server.Close)
+
+	home := t.TempDir()
+	pathx.RegisterGoctlHome(home)
+	t.Cleanup(func() {
+		pathx.RegisterGoctlHome("")
+	})
+
+	return server, &hits
+}
+
+func TestFetchRemotePinned(t *testing.T) {
+	server, hits := newRemoteServer(t)
+	sum := sha256.Sum256([]byte(remoteContent))
+	arg := server.URL + "/goctl-remote" + sha256Separator + hex.EncodeToString(sum[:])
+
+	bin, temporary, err := getCommand(arg)
+	assert.Nil(t, err)
+	assert.False(t, temporary)
+	assertFile(t, bin, remoteContent)
+	info, err := os.Stat(bin)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	cached, _, err := getCommand(arg)
+	assert.Nil(t, err)
+	assert.Equal(t, bin, cached)
+	assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+
+	// the tampered cache is downloaded again
+	assert.Nil(t, os.WriteFile(bin, []byte("tampered"), 0o755))
+	_, _, err = getCommand(arg)
+	assert.Nil(t, err)
+	assertFile(t, bin, remoteContent)
+	assert.Equal(t, int32(2), atomic.LoadInt32(hits))
+}
+
+func TestFetchRemoteMismatch(t *testing.T) {
+	server, _ := newRemoteServer(t)
+	sum := sha256.Sum256([]byte("other"))
+	_, _, err := getCommand(server.URL + "/goctl-remote" + sha256Separator + hex.EncodeToString(sum[:]))
+	assert.True(t, errors.Is(err, downloader.ErrChecksumMismatch))
+
+	cacheDir, err := pathx.GetCacheDir()
+	assert.Nil(t, err)
+	entries, err := os.ReadDir(filepath.Join(cacheDir, cachePluginDir))
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+
+	_, _, err = getCommand(server.URL + "/goctl-remote" + sha256Separator + "invalid")
+	assert.NotNil(t, err)
+}
+
+func TestFetchRemoteLock(t *testing.T) {
+	server, _ := newRemoteServer(t)
+	link := server.URL + "/goctl-remote"
+	lock := filepath.Join(t.TempDir(), defaultLockFile)
+	VarStringLock = lock
+	defer func() {
+		VarStringLock = ""
+	}()
+
+	assert.Nil(t, os.WriteFile(lock, []byte("# pinned plugins\n"+link+" sha256:"+
+		hex.EncodeToString(make([]byte, 32))+"\n"), 0o644))
+	_, _, err := getCommand(link)
+	assert.True(t, errors.Is(err, downloader.ErrChecksumMismatch))
+
+	sum := sha256.Sum256([]byte(remoteContent))
+	assert.Nil(t, os.WriteFile(lock, []byte(link+" sha256:"+hex.EncodeToString(sum[:])+"\n"), 0o644))
+	bin, temporary, err := getCommand(link)
+	assert.Nil(t, err)
+	assert.False(t, temporary)
+	assertFile(t, bin, remoteContent)
+
+	assert.Nil(t, os.WriteFile(lock, []byte(link+"\n"), 0o644))
+	_, _, err = getCommand(link)
+	assert.NotNil(t, err)
+}
+
+func TestFetchRemoteUnpinned(t *testing.T) {
+	server, hits := newRemoteServer(t)
+	VarStringLock = filepath.Join(t.TempDir(), defaultLockFile)
+	defer func() {
+		VarStringLock = ""
+	}()
+
+	_, _, err := getCommand(server.URL + "/goctl-remote")
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(hits))
+
+	VarBoolUnpinned = true
+	defer func() {
+		VarBoolUnpinned = false
+	}()
+	bin, temporary, err := getCommand(server.URL + "/goctl-remote")
+	assert.Nil(t, err)
+	assert.True(t, temporary)
+	assertFile(t, bin, remoteContent)
+	assert.Nil(t, os.Remove(bin))
+}
+
+func TestParseRemote(t *testing.T) {
+	link, sum := parseRemote("https://example.com/plugin")
+	assert.Equal(t, "https://example.com/plugin", link)
+	assert.Equal(t, "", sum)
+
+	link, sum = parseRemote("https://user@example.com/plugin@sha256:abc")
+	assert.Equal(t, "https://user@example.com/plugin", link)
+	assert.Equal(t, "abc", sum)
+}
@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+)
+
+const schemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema returns the json schema of the request and the response of the protocol.
+func Schema() ([]byte, error) {
+	g := schemaGenerator{defs: make(map[string]interface{})}
+	schema := map[string]interface{}{
+		"$schema": schemaDraft,
+		"title":   fmt.Sprintf("goctl plugin protocol v%d", ProtocolVersion),
+		"version": ProtocolVersion,
+		"properties": map[string]interface{}{
+			"request":  g.schema(reflect.TypeOf(Request{})),
+			"response": g.schema(reflect.TypeOf(Response{})),
+		},
+		"$defs": g.defs,
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+type schemaGenerator struct {
+	defs map[string]interface{}
+}
+
+func (g schemaGenerator) schema(tp reflect.Type) interface{} {
+	switch tp.Kind() {
+	case reflect.Ptr:
+		return g.schema(tp.Elem())
+	case reflect.Interface:
+		return true
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if tp.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]interface{}{"type": "array", "items": g.schema(tp.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(tp.Elem())}
+	case reflect.Struct:
+		if len(tp.Name()) == 0 {
+			return g.object(tp)
+		}
+
+		name := defName(tp)
+		if _, ok := g.defs[name]; !ok {
+			// the placeholder breaks the recursion of the self referencing types
+			g.defs[name] = nil
+			g.defs[name] = g.object(tp)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + name}
+	default:
+		return true
+	}
+}
+
+func (g schemaGenerator) object(tp reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	g.properties(tp, properties)
+	return map[string]interface{}{"type": "object", "properties": properties}
+}
+
+func (g schemaGenerator) properties(tp reflect.Type, properties map[string]interface{}) {
+	for i := 0; i < tp.NumField(); i++ {
+		field := tp.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && len(name) == 0 {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.properties(embedded, properties)
+				continue
+			}
+		}
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		properties[name] = g.schema(field.Type)
+	}
+}
+
+// defName qualifies the types out of this package with the package name, spec and
+// this package both have Service.
+func defName(tp reflect.Type) string {
+	if tp.PkgPath() == reflect.TypeOf(Request{}).PkgPath() {
+		return tp.Name()
+	}
+
+	return path.Base(tp.PkgPath()) + "." + tp.Name()
+}
@@ -0,0 +1,91 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	apiparser "github.com/yeyudekuangxiang/goctl/api/parser"
+)
+
+// Handler generates the files of an in-process plugin.
+type Handler func(p *PluginV2) error
+
+var handlers = make(map[string]Handler)
+
+// Register registers an in-process plugin, which is invoked by name instead of running
+// an executable, it must be called before the commands are executed.
+func Register(name string, handler Handler) {
+	handlers[name] = handler
+}
+
+// PluginV2 is the request of the plugin protocol v2 with the files to respond.
+type PluginV2 struct {
+	Request
+	response Response
+}
+
+// NewPluginV2 reads the request from stdin, the plugins written in go add the files
+// and call Done to respond.
+func NewPluginV2() (*PluginV2, error) {
+	return readPluginV2(os.Stdin)
+}
+
+func readPluginV2(r io.Reader) (*PluginV2, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// the api spec can't be decoded since its types are interfaces, it is parsed
+	// from the api file instead.
+	var req struct {
+		Request
+		Api json.RawMessage
+	}
+	if err := json.Unmarshal(content, &req); err != nil {
+		return nil, err
+	}
+
+	if req.Version > ProtocolVersion {
+		return nil, fmt.Errorf("unsupported plugin protocol version %d, expected %d or lower",
+			req.Version, ProtocolVersion)
+	}
+
+	p := &PluginV2{Request: req.Request}
+	if len(p.ApiFilePath) > 0 {
+		api, err := apiparser.Parse(p.ApiFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Api = api
+	}
+
+	return p, nil
+}
+
+// AddFile adds a file to generate, it is skipped if it exists.
+func (p *PluginV2) AddFile(path, content string) {
+	p.response.Files = append(p.response.Files, File{Path: path, Content: content})
+}
+
+// OverwriteFile adds a file to generate, it replaces the existing file.
+func (p *PluginV2) OverwriteFile(path, content string) {
+	p.response.Files = append(p.response.Files, File{Path: path, Content: content, Overwrite: true})
+}
+
+// Done writes the response to stdout, err fails the generation if it is not nil.
+func (p *PluginV2) Done(err error) error {
+	return p.write(os.Stdout, err)
+}
+
+func (p *PluginV2) write(w io.Writer, err error) error {
+	p.response.Version = ProtocolVersion
+	if err != nil {
+		p.response.Error = err.Error()
+	}
+
+	return json.NewEncoder(w).Encode(p.response)
+}
@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"sort"
+
+	"github.com/yeyudekuangxiang/goctl/model/sql/parser"
+)
+
+type (
+	// Table describes a table for the plugins.
+	Table struct {
+		Name string
+		Db   string
+		// PrimaryKey is the column name of the primary key.
+		PrimaryKey    string
+		AutoIncrement bool
+		// UniqueIndex is the column names of the unique indexes by index name.
+		UniqueIndex map[string][]string `json:",omitempty"`
+		Fields      []TableField
+	}
+
+	// TableField describes a column, DataType is the go type of the column.
+	TableField struct {
+		Name            string
+		GoName          string
+		DataType        string
+		Comment         string `json:",omitempty"`
+		SeqInIndex      int    `json:",omitempty"`
+		OrdinalPosition int    `json:",omitempty"`
+	}
+)
+
+// NewTables converts the parsed tables for the plugins.
+func NewTables(tables []*parser.Table) []Table {
+	var ret []Table
+	for _, each := range tables {
+		table := Table{
+			Name:          each.Name.Source(),
+			Db:            each.Db.Source(),
+			PrimaryKey:    each.PrimaryKey.Name.Source(),
+			AutoIncrement: each.PrimaryKey.AutoIncrement,
+		}
+		if len(each.UniqueIndex) > 0 {
+			table.UniqueIndex = make(map[string][]string)
+			for name, fields := range each.UniqueIndex {
+				for _, field := range fields {
+					table.UniqueIndex[name] = append(table.UniqueIndex[name], field.Name.Source())
+				}
+			}
+		}
+		for _, field := range each.Fields {
+			table.Fields = append(table.Fields, TableField{
+				Name:            field.Name.Source(),
+				GoName:          field.Name.ToCamel(),
+				DataType:        field.DataType,
+				Comment:         field.Comment,
+				SeqInIndex:      field.SeqInIndex,
+				OrdinalPosition: field.OrdinalPosition,
+			})
+		}
+		ret = append(ret, table)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
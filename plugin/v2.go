@@ -0,0 +1,176 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/pkg/golang"
+	"github.com/yeyudekuangxiang/goctl/rpc/execx"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+// ProtocolVersion is the version of the plugin protocol, the plugins of version 1 receive
+// the same request without Version and print their output instead of returning files.
+const ProtocolVersion = 2
+
+const (
+	// KindApi means the request carries the api spec.
+	KindApi = "api"
+	// KindRpc means the request carries the proto.
+	KindRpc = "rpc"
+	// KindModel means the request carries the tables.
+	KindModel = "model"
+)
+
+type (
+	// Request is passed to the plugins as json on stdin.
+	Request struct {
+		Version int
+		Kind    string
+		// Dir is the target dir, the paths of the files in the response are relative to it.
+		Dir   string
+		Style string
+		// Args are the arguments of the plugin, see getPluginAndArgs.
+		Args          string
+		Api           *spec.ApiSpec `json:",omitempty"`
+		ApiFilePath   string        `json:",omitempty"`
+		Proto         *Proto        `json:",omitempty"`
+		ProtoFilePath string        `json:",omitempty"`
+		Tables        []Table       `json:",omitempty"`
+	}
+
+	// Response is printed by the plugins as json on stdout.
+	Response struct {
+		Version int
+		Files   []File `json:",omitempty"`
+		// Error fails the generation if it is not empty.
+		Error string `json:",omitempty"`
+	}
+
+	// File is a file generated by a plugin, goctl writes it into the target dir
+	// and formats it if it is a go file.
+	File struct {
+		Path    string
+		Content string
+		// Overwrite replaces the existing file, otherwise the file is skipped if it exists.
+		Overwrite bool `json:",omitempty"`
+	}
+)
+
+// Run invokes the plugin with the request and writes the files it returns, the
+// output of the plugins of version 1 is printed.
+func Run(plugin string, req Request) error {
+	bin, args := getPluginAndArgs(plugin)
+	req.Version = ProtocolVersion
+	req.Args = args
+
+	var resp *Response
+	if handler, ok := handlers[bin]; ok {
+		p := &PluginV2{Request: req}
+		if err := handler(p); err != nil {
+			return err
+		}
+
+		resp = &p.response
+	} else {
+		content, err := execute(bin, args, req)
+		if err != nil {
+			return err
+		}
+
+		resp, err = decodeResponse(content)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			fmt.Println(content)
+			return nil
+		}
+	}
+
+	if len(resp.Error) > 0 {
+		return fmt.Errorf("plugin %s: %s", bin, resp.Error)
+	}
+
+	return writeFiles(req.Dir, resp.Files)
+}
+
+func execute(bin, args string, req Request) (string, error) {
+	ex, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	bin, download, err := getCommand(bin)
+	if err != nil {
+		return "", err
+	}
+
+	if download {
+		defer func() {
+			_ = os.Remove(bin)
+		}()
+	}
+
+	return execx.Run(bin+" "+args, filepath.Dir(ex), bytes.NewBuffer(data))
+}
+
+// decodeResponse returns nil if the output is not a response, which is printed
+// by the plugins of version 1.
+func decodeResponse(content string) (*Response, error) {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, "{") {
+		return nil, nil
+	}
+
+	var resp Response
+	if err := json.Unmarshal([]byte(content), &resp); err != nil || resp.Version == 0 {
+		return nil, nil
+	}
+
+	if resp.Version > ProtocolVersion {
+		return nil, fmt.Errorf("unsupported plugin protocol version %d, expected %d or lower",
+			resp.Version, ProtocolVersion)
+	}
+
+	return &resp, nil
+}
+
+func writeFiles(dir string, files []File) error {
+	for _, file := range files {
+		path := filepath.Clean(filepath.FromSlash(file.Path))
+		if filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("plugin file %s is out of the target dir", file.Path)
+		}
+
+		filename := filepath.Join(dir, path)
+		if !file.Overwrite && pathx.FileExists(filename) {
+			fmt.Printf("%s exists, ignored generation\n", filename)
+			continue
+		}
+
+		if err := pathx.MkdirIfNotExist(filepath.Dir(filename)); err != nil {
+			return err
+		}
+
+		content := file.Content
+		if filepath.Ext(filename) == ".go" {
+			content = golang.FormatCode(content)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
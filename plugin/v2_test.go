@@ -0,0 +1,203 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+func TestDecodeResponse(t *testing.T) {
+	resp, err := decodeResponse("generated by a v1 plugin")
+	assert.Nil(t, err)
+	assert.Nil(t, resp)
+
+	resp, err = decodeResponse(`{"name": "not a response"}`)
+	assert.Nil(t, err)
+	assert.Nil(t, resp)
+
+	resp, err = decodeResponse(`{"version": 2, "files": [{"path": "a.txt", "content": "a"}]}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []File{{Path: "a.txt", Content: "a"}}, resp.Files)
+
+	_, err = decodeResponse(`{"Version": 3}`)
+	assert.NotNil(t, err)
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	assert.NotNil(t, writeFiles(dir, []File{{Path: "../a.txt"}}))
+	assert.NotNil(t, writeFiles(dir, []File{{Path: "/tmp/a.txt"}}))
+
+	err := writeFiles(dir, []File{
+		{Path: "a/b.go", Content: "package a\nvar  a=1\n"},
+		{Path: "a/c.txt", Content: "c"},
+	})
+	assert.Nil(t, err)
+	assertFile(t, filepath.Join(dir, "a", "b.go"), "package a\n\nvar a = 1\n")
+
+	err = writeFiles(dir, []File{
+		{Path: "a/b.go", Content: "package b\n"},
+		{Path: "a/c.txt", Content: "d", Overwrite: true},
+	})
+	assert.Nil(t, err)
+	assertFile(t, filepath.Join(dir, "a", "b.go"), "package a\n\nvar a = 1\n")
+	assertFile(t, filepath.Join(dir, "a", "c.txt"), "d")
+}
+
+func TestRunInProcess(t *testing.T) {
+	dir := t.TempDir()
+	Register("test-inprocess", func(p *PluginV2) error {
+		assert.Equal(t, ProtocolVersion, p.Version)
+		assert.Equal(t, KindModel, p.Kind)
+		assert.Equal(t, "-v 1", p.Args)
+		p.AddFile("tables.txt", p.Tables[0].Name)
+		return nil
+	})
+	Register("test-failed", func(p *PluginV2) error {
+		return errors.New("failed")
+	})
+
+	err := Run("test-inprocess=-v 1", Request{
+		Kind:   KindModel,
+		Dir:    dir,
+		Tables: []Table{{Name: "user"}},
+	})
+	assert.Nil(t, err)
+	assertFile(t, filepath.Join(dir, "tables.txt"), "user")
+	assert.NotNil(t, Run("test-failed", Request{Dir: dir}))
+}
+
+func TestReadPluginV2(t *testing.T) {
+	data, err := json.Marshal(Request{
+		Version: ProtocolVersion,
+		Kind:    KindRpc,
+		Dir:     "/tmp",
+		Proto:   &Proto{Name: "greet.proto", Services: []Service{{Name: "Greet"}}},
+	})
+	assert.Nil(t, err)
+
+	p, err := readPluginV2(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, "Greet", p.Proto.Services[0].Name)
+
+	p.AddFile("a.txt", "a")
+	p.OverwriteFile("b.txt", "b")
+	var buf bytes.Buffer
+	assert.Nil(t, p.write(&buf, nil))
+	resp, err := decodeResponse(buf.String())
+	assert.Nil(t, err)
+	assert.Equal(t, []File{{Path: "a.txt", Content: "a"}, {Path: "b.txt", Content: "b", Overwrite: true}},
+		resp.Files)
+
+	_, err = readPluginV2(strings.NewReader(`{"Version": 3}`))
+	assert.NotNil(t, err)
+}
+
+func TestDiscover(t *testing.T) {
+	home := t.TempDir()
+	pathx.RegisterGoctlHome(home)
+	defer pathx.RegisterGoctlHome("")
+
+	dir := filepath.Join(home, "plugins")
+	assert.Nil(t, os.MkdirAll(dir, 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "goctl-swagger"), nil, 0o755))
+
+	bin, ok := discover("swagger")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(dir, "goctl-swagger"), bin)
+
+	_, ok = discover("unknown")
+	assert.False(t, ok)
+	_, ok = discover("../plugins/goctl-swagger")
+	assert.False(t, ok)
+}
+
+func TestNewProto(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "greet.proto")
+	assert.Nil(t, os.WriteFile(filename, []byte(`syntax = "proto3";
+
+package greet;
+option go_package = "./greet";
+
+// Request is the request
+message Request {
+  message Meta {
+    string trace = 1;
+  }
+  repeated string names = 1;
+  map<string, int64> counts = 2;
+  oneof target {
+    string email = 3;
+    int64 id = 4;
+  }
+  Meta meta = 5;
+}
+
+message Response {}
+
+service Greet {
+  // Hello says hello
+  rpc Hello(Request) returns (stream Response);
+}
+`), 0o644))
+
+	p, err := parser.NewDefaultProtoParser().Parse(filename)
+	assert.Nil(t, err)
+
+	proto := NewProto(p)
+	assert.Equal(t, "greet", proto.Package)
+	assert.Equal(t, "./greet", proto.GoPackage)
+	assert.Equal(t, []string{"Request", "Request.Meta", "Response"}, messageNames(proto))
+	assert.Equal(t, "Request is the request", proto.Messages[0].Comment)
+	assert.Equal(t, []MessageField{
+		{Name: "names", Type: "string", Number: 1, Repeated: true},
+		{Name: "counts", Type: "map<string, int64>", Number: 2},
+		{Name: "email", Type: "string", Number: 3, OneOf: "target"},
+		{Name: "id", Type: "int64", Number: 4, OneOf: "target"},
+		{Name: "meta", Type: "Meta", Number: 5},
+	}, proto.Messages[0].Fields)
+	assert.Equal(t, []RPC{{
+		Name:           "Hello",
+		RequestType:    "Request",
+		ReturnsType:    "Response",
+		StreamsReturns: true,
+		Comment:        "Hello says hello",
+	}}, proto.Services[0].RPCs)
+}
+
+func TestSchema(t *testing.T) {
+	data, err := Schema()
+	assert.Nil(t, err)
+
+	var schema struct {
+		Version int
+		Defs    map[string]json.RawMessage `json:"$defs"`
+	}
+	assert.Nil(t, json.Unmarshal(data, &schema))
+	assert.Equal(t, ProtocolVersion, schema.Version)
+	for _, name := range []string{"Request", "Response", "File", "Proto", "Table", "spec.ApiSpec", "spec.Service"} {
+		assert.Contains(t, schema.Defs, name)
+	}
+}
+
+func messageNames(proto *Proto) []string {
+	var names []string
+	for _, each := range proto.Messages {
+		names = append(names, each.Name)
+	}
+	return names
+}
+
+func assertFile(t *testing.T, filename, expected string) {
+	data, err := os.ReadFile(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, string(data))
+}
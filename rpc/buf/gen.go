@@ -0,0 +1,106 @@
+package buf
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	// PluginGo is the kind of protoc-gen-go
+	PluginGo = "go"
+	// PluginGrpc is the kind of protoc-gen-go-grpc
+	PluginGrpc = "go-grpc"
+
+	localPluginPrefix = "protoc-gen-"
+)
+
+type (
+	// Options are the plugin options which may be a string or a list in buf.gen.yaml
+	Options []string
+
+	// Plugin is a plugin in buf.gen.yaml, the plugin is referred by one of Name, Plugin,
+	// Remote and Local by the versions of buf.
+	Plugin struct {
+		Name   string  `yaml:"name"`
+		Plugin string  `yaml:"plugin"`
+		Remote string  `yaml:"remote"`
+		Local  string  `yaml:"local"`
+		Out    string  `yaml:"out"`
+		Opt    Options `yaml:"opt"`
+	}
+
+	// GenConfig is the generation template buf.gen.yaml
+	GenConfig struct {
+		Version string `yaml:"version"`
+		Managed struct {
+			Enabled bool `yaml:"enabled"`
+		} `yaml:"managed"`
+		Plugins []Plugin `yaml:"plugins"`
+	}
+)
+
+// UnmarshalYAML accepts a string or a list of strings
+func (o *Options) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err == nil {
+		*o = Options{s}
+		return nil
+	}
+
+	var list []string
+	if err := unmarshal(&list); err != nil {
+		return err
+	}
+	*o = list
+	return nil
+}
+
+// LoadGenConfig loads the generation template
+func LoadGenConfig(filename string) (*GenConfig, error) {
+	var cfg GenConfig
+	ok, err := load(filename, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%s is not found", filename)
+	}
+
+	return &cfg, nil
+}
+
+// Lookup returns the plugin of the kind, like PluginGo and PluginGrpc
+func (c *GenConfig) Lookup(kind string) (Plugin, bool) {
+	for _, each := range c.Plugins {
+		if each.Kind() == kind {
+			return each, true
+		}
+	}
+	return Plugin{}, false
+}
+
+// Ref returns how the plugin is referred in the template
+func (p Plugin) Ref() string {
+	for _, each := range []string{p.Name, p.Plugin, p.Remote, p.Local} {
+		if len(each) > 0 {
+			return each
+		}
+	}
+	return ""
+}
+
+// Kind returns the name of the plugin without the protoc-gen- prefix, the remote plugins of
+// protoc-gen-go and protoc-gen-go-grpc are PluginGo and PluginGrpc, like buf.build/grpc/go.
+func (p Plugin) Kind() string {
+	ref := p.Ref()
+	if i := strings.LastIndex(ref, ":"); i >= 0 {
+		ref = ref[:i]
+	}
+
+	name := strings.TrimPrefix(path.Base(ref), localPluginPrefix)
+	if name == PluginGo && strings.Contains(ref, "/grpc/") {
+		return PluginGrpc
+	}
+	return name
+}
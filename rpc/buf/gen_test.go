@@ -0,0 +1,56 @@
+package buf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadGenConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, GenFile, `version: v1
+managed:
+  enabled: true
+plugins:
+  - plugin: buf.build/protocolbuffers/go:v1.28.0
+    out: gen
+    opt: paths=source_relative
+  - name: go-grpc
+    out: gen
+    opt:
+      - paths=source_relative
+      - require_unimplemented_servers=false
+  - remote: buf.build/bufbuild/plugins/validate-go
+    out: gen
+`)
+
+	cfg, err := LoadGenConfig(filepath.Join(dir, GenFile))
+	assert.Nil(t, err)
+	assert.True(t, cfg.Managed.Enabled)
+
+	plugin, ok := cfg.Lookup(PluginGo)
+	assert.True(t, ok)
+	assert.Equal(t, "gen", plugin.Out)
+	assert.Equal(t, Options{"paths=source_relative"}, plugin.Opt)
+
+	plugin, ok = cfg.Lookup(PluginGrpc)
+	assert.True(t, ok)
+	assert.Equal(t, Options{"paths=source_relative", "require_unimplemented_servers=false"}, plugin.Opt)
+	assert.Equal(t, "validate-go", cfg.Plugins[2].Kind())
+
+	_, err = LoadGenConfig(filepath.Join(dir, "foo.yaml"))
+	assert.NotNil(t, err)
+}
+
+func TestPlugin_Kind(t *testing.T) {
+	tests := map[string]Plugin{
+		PluginGo:   {Local: "protoc-gen-go"},
+		PluginGrpc: {Remote: "buf.build/grpc/plugins/go:v1.2.0-1"},
+		"python":   {Plugin: "python"},
+	}
+	for kind, plugin := range tests {
+		assert.Equal(t, kind, plugin.Kind())
+	}
+	assert.Equal(t, PluginGrpc, Plugin{Plugin: "buf.build/grpc/go"}.Kind())
+}
@@ -0,0 +1,252 @@
+// Package buf reads the buf workspace, the modules and the generation template, only the
+// modules in the local directories are supported, the remote dependencies are not resolved.
+package buf
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// WorkFile is the buf workspace file which lists the module directories
+	WorkFile = "buf.work.yaml"
+	// ModuleFile is the buf module file in the root of the module
+	ModuleFile = "buf.yaml"
+	// GenFile is the default buf generation template
+	GenFile = "buf.gen.yaml"
+
+	protoExt = ".proto"
+)
+
+type (
+	workConfig struct {
+		Version     string   `yaml:"version"`
+		Directories []string `yaml:"directories"`
+	}
+
+	moduleConfig struct {
+		Version string   `yaml:"version"`
+		Name    string   `yaml:"name"`
+		Deps    []string `yaml:"deps"`
+		Build   struct {
+			Excludes []string `yaml:"excludes"`
+		} `yaml:"build"`
+		// Modules are the modules of the v2 buf.yaml
+		Modules []struct {
+			Path     string   `yaml:"path"`
+			Name     string   `yaml:"name"`
+			Excludes []string `yaml:"excludes"`
+		} `yaml:"modules"`
+	}
+
+	// Module is a directory of proto files, the files import each other by the paths
+	// relative to the directory.
+	Module struct {
+		// Dir is the directory of the module
+		Dir string
+		// Path is the directory of the module relative to the workspace
+		Path string
+		// Name is the name of the module in the buf registry, it may be empty
+		Name string
+		// Excludes are the directories relative to the module which are not built
+		Excludes []string
+		// Deps are the remote dependencies which are not resolved
+		Deps []string
+	}
+
+	// Workspace is a set of modules which can import each other
+	Workspace struct {
+		// Dir is the directory of the workspace
+		Dir     string
+		Modules []Module
+	}
+)
+
+// LoadWorkspace loads the workspace in the directory, the modules are listed in buf.work.yaml,
+// or in the v2 buf.yaml, or the directory is the only module with a v1 buf.yaml.
+func LoadWorkspace(dir string) (*Workspace, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := &Workspace{Dir: abs}
+	var work workConfig
+	ok, err := load(filepath.Join(abs, WorkFile), &work)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if len(work.Directories) == 0 {
+			return nil, fmt.Errorf("%s: no directories", filepath.Join(abs, WorkFile))
+		}
+		for _, each := range work.Directories {
+			module, err := loadModule(abs, each)
+			if err != nil {
+				return nil, err
+			}
+			ws.Modules = append(ws.Modules, module)
+		}
+		return ws, nil
+	}
+
+	var cfg moduleConfig
+	filename := filepath.Join(abs, ModuleFile)
+	ok, err = load(filename, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("neither %s nor %s is found in %q", WorkFile, ModuleFile, abs)
+	}
+	if cfg.Version != "v2" {
+		ws.Modules = append(ws.Modules, newModule(abs, ".", cfg.Name, cfg.Build.Excludes, cfg.Deps))
+		return ws, nil
+	}
+
+	if len(cfg.Modules) == 0 {
+		ws.Modules = append(ws.Modules, newModule(abs, ".", cfg.Name, nil, cfg.Deps))
+		return ws, nil
+	}
+	for _, each := range cfg.Modules {
+		var excludes []string
+		for _, exclude := range each.Excludes {
+			// the excludes of v2 are relative to the workspace
+			rel, err := filepath.Rel(filepath.FromSlash(each.Path), filepath.FromSlash(exclude))
+			if err != nil {
+				return nil, err
+			}
+			excludes = append(excludes, rel)
+		}
+		ws.Modules = append(ws.Modules, newModule(abs, each.Path, each.Name, excludes, cfg.Deps))
+	}
+	return ws, nil
+}
+
+func loadModule(workspace, dir string) (Module, error) {
+	var cfg moduleConfig
+	moduleDir := filepath.Join(workspace, filepath.FromSlash(dir))
+	if _, err := os.Stat(moduleDir); err != nil {
+		return Module{}, fmt.Errorf("%s: module %q is not found", filepath.Join(workspace, WorkFile), dir)
+	}
+	if _, err := load(filepath.Join(moduleDir, ModuleFile), &cfg); err != nil {
+		return Module{}, err
+	}
+
+	return newModule(workspace, dir, cfg.Name, cfg.Build.Excludes, cfg.Deps), nil
+}
+
+func newModule(workspace, dir, name string, excludes, deps []string) Module {
+	return Module{
+		Dir:      filepath.Join(workspace, filepath.FromSlash(dir)),
+		Path:     path.Clean(filepath.ToSlash(dir)),
+		Name:     name,
+		Excludes: excludes,
+		Deps:     deps,
+	}
+}
+
+// Module returns the module by the path relative to the workspace or by the name
+func (w *Workspace) Module(name string) (Module, error) {
+	name = strings.TrimSuffix(filepath.ToSlash(name), "/")
+	for _, each := range w.Modules {
+		if each.Path == path.Clean(name) || len(each.Name) > 0 && each.Name == name {
+			return each, nil
+		}
+	}
+
+	var list []string
+	for _, each := range w.Modules {
+		list = append(list, each.Path)
+	}
+	return Module{}, fmt.Errorf("module %q is not found, the modules are: %s", name, strings.Join(list, ", "))
+}
+
+// ProtoPaths returns the directories of the modules which are the import paths of the files
+func (w *Workspace) ProtoPaths() []string {
+	var ret []string
+	for _, each := range w.Modules {
+		ret = append(ret, each.Dir)
+	}
+	return ret
+}
+
+// Files returns the proto files in the module except the excluded directories, the files are
+// sorted by the paths.
+func (m Module) Files() ([]string, error) {
+	excluded := make(map[string]bool)
+	for _, each := range m.Excludes {
+		excluded[path.Clean(filepath.ToSlash(each))] = true
+	}
+
+	var files []string
+	err := fs.WalkDir(os.DirFS(m.Dir), ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if excluded[name] {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if path.Ext(name) == protoExt {
+			files = append(files, filepath.Join(m.Dir, filepath.FromSlash(name)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// load reads the yaml file into v, it reports false if the file doesn't exist
+func load(filename string, v interface{}) (bool, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("%s: %w", filename, err)
+	}
+	return true, nil
+}
+
+// HasService reports whether the proto file defines any service
+func HasService(filename string) (bool, error) {
+	r, err := os.Open(filename)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	p := proto.NewParser(r)
+	p.Filename(filename)
+	set, err := p.Parse()
+	if err != nil {
+		return false, err
+	}
+
+	for _, each := range set.Elements {
+		if _, ok := each.(*proto.Service); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,92 @@
+package buf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, WorkFile, "version: v1\ndirectories:\n  - proto\n  - third\n")
+	writeFile(t, dir, "proto/buf.yaml", `version: v1
+name: buf.build/acme/apis
+deps:
+  - buf.build/googleapis/googleapis
+build:
+  excludes:
+    - acme/internal
+`)
+	writeFile(t, dir, "proto/acme/user/v1/user.proto", "syntax = \"proto3\";\nservice User {}\n")
+	writeFile(t, dir, "proto/acme/internal/tmp.proto", "syntax = \"proto3\";\n")
+	writeFile(t, dir, "third/common/v1/page.proto", "syntax = \"proto3\";\nmessage Page {}\n")
+
+	ws, err := LoadWorkspace(dir)
+	assert.Nil(t, err)
+	assert.Len(t, ws.Modules, 2)
+	assert.Equal(t, []string{filepath.Join(dir, "proto"), filepath.Join(dir, "third")}, ws.ProtoPaths())
+	assert.Equal(t, "buf.build/acme/apis", ws.Modules[0].Name)
+	assert.Equal(t, []string{"buf.build/googleapis/googleapis"}, ws.Modules[0].Deps)
+
+	module, err := ws.Module("buf.build/acme/apis")
+	assert.Nil(t, err)
+	files, err := module.Files()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "proto/acme/user/v1/user.proto")}, files)
+
+	ok, err := HasService(files[0])
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	module, err = ws.Module("third/")
+	assert.Nil(t, err)
+	files, err = module.Files()
+	assert.Nil(t, err)
+	ok, err = HasService(files[0])
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	_, err = ws.Module("foo")
+	assert.NotNil(t, err)
+}
+
+func TestLoadWorkspace_Module(t *testing.T) {
+	dir := t.TempDir()
+	_, err := LoadWorkspace(dir)
+	assert.NotNil(t, err)
+
+	writeFile(t, dir, ModuleFile, "version: v1\n")
+	ws, err := LoadWorkspace(dir)
+	assert.Nil(t, err)
+	assert.Len(t, ws.Modules, 1)
+	assert.Equal(t, dir, ws.Modules[0].Dir)
+	assert.Equal(t, ".", ws.Modules[0].Path)
+
+	writeFile(t, dir, ModuleFile, `version: v2
+modules:
+  - path: proto
+    excludes:
+      - proto/foo
+  - path: vendor/third
+`)
+	ws, err = LoadWorkspace(dir)
+	assert.Nil(t, err)
+	assert.Len(t, ws.Modules, 2)
+	assert.Equal(t, []string{"foo"}, ws.Modules[0].Excludes)
+	assert.Equal(t, "vendor/third", ws.Modules[1].Path)
+}
+
+func TestLoadWorkspace_MissingModule(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, WorkFile, "version: v1\ndirectories:\n  - proto\n")
+	_, err := LoadWorkspace(dir)
+	assert.NotNil(t, err)
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	filename := filepath.Join(dir, filepath.FromSlash(name))
+	assert.Nil(t, os.MkdirAll(filepath.Dir(filename), os.ModePerm))
+	assert.Nil(t, os.WriteFile(filename, []byte(content), 0o644))
+}
@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/pkg/protocompile"
+	"github.com/yeyudekuangxiang/goctl/rpc/buf"
+	"github.com/yeyudekuangxiang/goctl/rpc/execx"
+	"github.com/yeyudekuangxiang/goctl/rpc/generator"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/console"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+var (
+	// VarStringBufModule describes the module of the buf workspace to generate.
+	VarStringBufModule string
+	// VarStringBufTemplate describes the buf generation template.
+	VarStringBufTemplate string
+)
+
+// bufTarget is the outputs and the options of protoc-gen-go and protoc-gen-go-grpc
+type bufTarget struct {
+	protoPaths []string
+	goOut      string
+	goOpts     []string
+	grpcOut    string
+	grpcOpts   []string
+}
+
+// Buf generates the pb code of the buf workspace like buf generate does with the go and the
+// go-grpc plugins in buf.gen.yaml, then generates the zrpc code for every proto file which
+// defines services, the workspace is the current directory if it's not specified.
+func Buf(_ *cobra.Command, args []string) error {
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	if len(VarStringZRPCOut) == 0 {
+		return errInvalidZrpcOutput
+	}
+
+	ws, err := buf.LoadWorkspace(dir)
+	if err != nil {
+		return err
+	}
+	modules := ws.Modules
+	if len(VarStringBufModule) > 0 {
+		module, err := ws.Module(VarStringBufModule)
+		if err != nil {
+			return err
+		}
+		modules = []buf.Module{module}
+	}
+
+	template := VarStringBufTemplate
+	if len(template) == 0 {
+		template = filepath.Join(ws.Dir, buf.GenFile)
+	}
+	target, err := loadBufTarget(template, ws)
+	if err != nil {
+		return err
+	}
+
+	var files, services []string
+	for _, module := range modules {
+		if len(module.Deps) > 0 {
+			console.Warning("the remote dependencies of module %q are not resolved: %s",
+				module.Path, strings.Join(module.Deps, ", "))
+		}
+		list, err := module.Files()
+		if err != nil {
+			return err
+		}
+		for _, each := range list {
+			ok, err := buf.HasService(each)
+			if err != nil {
+				return err
+			}
+			if ok {
+				services = append(services, each)
+			}
+		}
+		files = append(files, list...)
+	}
+	if len(services) == 0 {
+		return errors.New("no service is found in the buf workspace")
+	}
+
+	outputs, err := bufOutputs(VarStringZRPCOut, services)
+	if err != nil {
+		return err
+	}
+
+	if len(VarStringRemote) > 0 {
+		repo, _ := util.CloneIntoGitHome(VarStringRemote, VarStringBranch)
+		if len(repo) > 0 {
+			VarStringHome = repo
+		}
+	}
+	if len(VarStringHome) > 0 {
+		pathx.RegisterGoctlHome(VarStringHome)
+	}
+
+	// the files without services are generated too, they may be imported by the services
+	builtin := builtinProtoc()
+	if err := target.generate(builtin, files); err != nil {
+		return err
+	}
+
+	g := generator.NewGenerator(VarStringStyle, VarBoolVerbose)
+	for i, each := range services {
+		ctx := generator.ZRpcContext{
+			Src:            each,
+			ProtocCmd:      target.protocCmd(each),
+			IsGooglePlugin: true,
+			GoOutput:       target.goOut,
+			GrpcOutput:     target.grpcOut,
+			Output:         outputs[i],
+			Multiple:       VarBoolMultiple,
+			Builtin:        builtin,
+			ProtoPaths:     target.protoPaths,
+			GoOpts:         target.goOpts,
+			GrpcOpts:       target.grpcOpts,
+		}
+		if err := g.Generate(&ctx); err != nil {
+			return fmt.Errorf("%s: %w", each, err)
+		}
+	}
+
+	return nil
+}
+
+func loadBufTarget(template string, ws *buf.Workspace) (*bufTarget, error) {
+	cfg, err := buf.LoadGenConfig(template)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Managed.Enabled {
+		console.Warning("the managed mode of %s is not supported, the options in the proto files are used",
+			template)
+	}
+
+	goPlugin, ok := cfg.Lookup(buf.PluginGo)
+	if !ok {
+		return nil, fmt.Errorf("%s: the plugin %s is not found", template, buf.PluginGo)
+	}
+	grpcPlugin, ok := cfg.Lookup(buf.PluginGrpc)
+	if !ok {
+		return nil, fmt.Errorf("%s: the plugin %s is not found", template, buf.PluginGrpc)
+	}
+	for _, each := range cfg.Plugins {
+		if kind := each.Kind(); kind != buf.PluginGo && kind != buf.PluginGrpc {
+			console.Warning("the plugin %s is ignored", each.Ref())
+		}
+	}
+
+	// the outputs are relative to the working directory like buf generate
+	goOut, err := filepath.Abs(goPlugin.Out)
+	if err != nil {
+		return nil, err
+	}
+	grpcOut, err := filepath.Abs(grpcPlugin.Out)
+	if err != nil {
+		return nil, err
+	}
+	if err := pathx.MkdirIfNotExist(goOut); err != nil {
+		return nil, err
+	}
+	if err := pathx.MkdirIfNotExist(grpcOut); err != nil {
+		return nil, err
+	}
+
+	return &bufTarget{
+		protoPaths: ws.ProtoPaths(),
+		goOut:      goOut,
+		goOpts:     goPlugin.Opt,
+		grpcOut:    grpcOut,
+		grpcOpts:   grpcPlugin.Opt,
+	}, nil
+}
+
+// bufOutputs returns the zrpc outputs of the proto files, the only proto file is generated
+// into the output, or every proto file is generated into the directory named after it.
+func bufOutputs(zrpcOut string, files []string) ([]string, error) {
+	zrpcOut, err := filepath.Abs(zrpcOut)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 1 {
+		return []string{zrpcOut}, nil
+	}
+
+	var outputs []string
+	seen := make(map[string]string)
+	for _, each := range files {
+		name := strings.ToLower(strings.TrimSuffix(filepath.Base(each), filepath.Ext(each)))
+		if prev, ok := seen[name]; ok {
+			return nil, fmt.Errorf("the zrpc outputs of %s and %s are the same, "+
+				"please use --module to generate them separately", prev, each)
+		}
+		seen[name] = each
+		outputs = append(outputs, filepath.Join(zrpcOut, name))
+	}
+	return outputs, nil
+}
+
+func (t *bufTarget) generate(builtin bool, files []string) error {
+	if builtin {
+		compiler := protocompile.Compiler{ImportPaths: t.protoPaths}
+		return compiler.Generate(protocompile.GenerateOptions{
+			GoOut:    t.goOut,
+			GoOpts:   t.goOpts,
+			GrpcOut:  t.grpcOut,
+			GrpcOpts: t.grpcOpts,
+		}, files...)
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	_, err = execx.Run(t.protocCmd(files...), pwd)
+	return err
+}
+
+func (t *bufTarget) protocCmd(files ...string) string {
+	args := []string{"protoc"}
+	for _, each := range t.protoPaths {
+		args = append(args, "--proto_path", each)
+	}
+	args = append(args, "--go_out", t.goOut)
+	for _, each := range t.goOpts {
+		args = append(args, "--go_opt", each)
+	}
+	args = append(args, "--go-grpc_out", t.grpcOut)
+	for _, each := range t.grpcOpts {
+		args = append(args, "--go-grpc_opt", each)
+	}
+	return strings.Join(append(args, files...), " ")
+}
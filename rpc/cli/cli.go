@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/pkg/protoc"
 	"github.com/yeyudekuangxiang/goctl/rpc/generator"
 	"github.com/yeyudekuangxiang/goctl/util"
 	"github.com/yeyudekuangxiang/goctl/util/console"
@@ -28,6 +29,8 @@ var (
 	VarStringSliceGoGRPCOut []string
 	// VarStringSlicePlugin describes the protoc plugin.
 	VarStringSlicePlugin []string
+	// VarStringSliceGoctlPlugin describes the goctl plugins invoked with the parsed proto.
+	VarStringSliceGoctlPlugin []string
 	// VarStringSliceProtoPath describes the proto path.
 	VarStringSliceProtoPath []string
 	// VarStringSliceGoOpt describes the go options.
@@ -44,6 +47,20 @@ var (
 	VarBoolVerbose bool
 	// VarBoolMultiple describes whether support generating multiple rpc services or not.
 	VarBoolMultiple bool
+	// VarBoolBuiltinProtoc describes whether compile the proto files in process without protoc.
+	VarBoolBuiltinProtoc bool
+	// VarBoolHealth describes whether the health status follows the readiness of the service context.
+	VarBoolHealth bool
+	// VarBoolReflection describes whether the reflection service can be enabled by the config.
+	VarBoolReflection bool
+	// VarBoolInterceptors describes whether the interceptor chains are declared in the service context.
+	VarBoolInterceptors bool
+	// VarBoolDrain describes whether the timeout of the graceful stop on SIGTERM is configured.
+	VarBoolDrain bool
+	// VarBoolTests describes whether to generate the tests of the rpcs and the testkit.
+	VarBoolTests bool
+	// VarBoolCli describes whether to generate the command line clients of the services.
+	VarBoolCli bool
 )
 
 // RPCNew is to generate rpc greet service, this greet service can speed
@@ -98,11 +115,21 @@ func RPCNew(_ *cobra.Command, args []string) error {
 	if len(goOptList) > 0 {
 		ctx.ProtocCmd += " --go_opt=" + strings.Join(goOptList, ",")
 	}
+	ctx.Builtin = builtinProtoc()
+	ctx.ProtoPaths = []string{filepath.Dir(src)}
+	ctx.GoOpts = goOptList
+	ctx.GrpcOpts = grpcOptList
 
 	g := generator.NewGenerator(style, verbose)
 	return g.Generate(&ctx)
 }
 
+// builtinProtoc reports whether the proto files are compiled in process, it's used if
+// --builtin-protoc is set or protoc is not found in PATH.
+func builtinProtoc() bool {
+	return VarBoolBuiltinProtoc || !protoc.Exists()
+}
+
 // RPCTemplate is the entry for generate rpc template
 func RPCTemplate(_ *cobra.Command, _ []string) error {
 	console.Warning("deprecated: goctl rpc template -o is deprecated and will be removed in the future, use goctl rpc -o instead")
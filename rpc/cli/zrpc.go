@@ -7,8 +7,11 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/plugin"
 	"github.com/yeyudekuangxiang/goctl/rpc/generator"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
 	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/console"
 	"github.com/yeyudekuangxiang/goctl/util/pathx"
 )
 
@@ -102,8 +105,51 @@ func ZRPC(_ *cobra.Command, args []string) error {
 	ctx.IsGooglePlugin = isGooglePlugin
 	ctx.Output = zrpcOut
 	ctx.ProtocCmd = strings.Join(protocArgs, " ")
+	ctx.Builtin = builtinProtoc()
+	ctx.ProtoPaths = VarStringSliceProtoPath
+	ctx.GoOpts = VarStringSliceGoOpt
+	ctx.GrpcOpts = VarStringSliceGoGRPCOpt
+	ctx.Health = VarBoolHealth
+	ctx.Reflection = VarBoolReflection
+	ctx.Interceptors = VarBoolInterceptors
+	ctx.Drain = VarBoolDrain
+	ctx.Tests = VarBoolTests
+	ctx.Cli = VarBoolCli
+	if ctx.Builtin && len(VarStringSlicePlugin) > 0 {
+		console.Warning("--plugin is ignored by the builtin protoc")
+	}
 	g := generator.NewGenerator(style, verbose)
-	return g.Generate(&ctx)
+	if err := g.Generate(&ctx); err != nil {
+		return err
+	}
+
+	return runPlugins(source, zrpcOut, style)
+}
+
+func runPlugins(source, dir, style string) error {
+	if len(VarStringSliceGoctlPlugin) == 0 {
+		return nil
+	}
+
+	proto, err := parser.NewDefaultProtoParser(VarStringSliceProtoPath...).Parse(source, VarBoolMultiple)
+	if err != nil {
+		return err
+	}
+
+	for _, each := range VarStringSliceGoctlPlugin {
+		err = plugin.Run(each, plugin.Request{
+			Kind:          plugin.KindRpc,
+			Dir:           dir,
+			Style:         style,
+			Proto:         plugin.NewProto(proto),
+			ProtoFilePath: proto.Src,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func wrapProtocCmd(name string, args []string) []string {
@@ -2,7 +2,11 @@ package rpc
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/plugin"
 	"github.com/yeyudekuangxiang/goctl/rpc/cli"
+	"github.com/yeyudekuangxiang/goctl/rpc/diff"
+	"github.com/yeyudekuangxiang/goctl/rpc/lint"
+	"github.com/yeyudekuangxiang/goctl/rpc/model"
 )
 
 var (
@@ -13,6 +17,43 @@ var (
 		RunE:  cli.RPCTemplate,
 	}
 
+	breakingCmd = &cobra.Command{
+		Use:     "breaking",
+		Short:   "Report the breaking changes of the proto files against a directory or a git revision",
+		Example: "goctl rpc breaking ./proto --against main",
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    diff.BreakingCommand,
+	}
+
+	bufCmd = &cobra.Command{
+		Use:     "buf",
+		Short:   "Generate grpc and zrpc code of the services in a buf workspace",
+		Example: "goctl rpc buf . --zrpc_out=./svc",
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    cli.Buf,
+	}
+
+	diffCmd = &cobra.Command{
+		Use:     "diff",
+		Short:   "Report the breaking changes between two versions of a proto file",
+		Example: "goctl rpc diff --old main:greet.proto --new greet.proto",
+		RunE:    diff.DiffCommand,
+	}
+
+	lintCmd = &cobra.Command{
+		Use:     "lint",
+		Short:   "Check the naming, the go_package, the field numbers and the imports of the proto files",
+		Example: "goctl rpc lint ./proto -I ./third_party",
+		RunE:    lint.LintCommand,
+	}
+
+	modelCmd = &cobra.Command{
+		Use:     "model",
+		Short:   "Generate the mysql models and the converters from the proto messages",
+		Example: "goctl rpc model --proto user.proto --message User --dialect mysql --app user",
+		RunE:    model.ModelCommand,
+	}
+
 	newCmd = &cobra.Command{
 		Use:   "new",
 		Short: "Generate rpc demo service",
@@ -47,6 +88,70 @@ func init() {
 	Cmd.Flags().StringVar(&cli.VarStringBranch, "branch", "", "The branch of the "+
 		"remote repo, it does work with --remote")
 
+	bufCmd.Flags().StringVar(&cli.VarStringZRPCOut, "zrpc_out", "", "The zrpc output directory, "+
+		"every proto file with services is generated into a sub directory if there are more than one")
+	bufCmd.Flags().StringVar(&cli.VarStringBufModule, "module", "", "The module to generate, "+
+		"referred by the directory or the name, all the modules in the workspace are generated if it's empty")
+	bufCmd.Flags().StringVar(&cli.VarStringBufTemplate, "template", "", "The buf generation template, "+
+		"default buf.gen.yaml in the workspace")
+	bufCmd.Flags().BoolVarP(&cli.VarBoolMultiple, "multiple", "m", false,
+		"Generated in multiple rpc service mode")
+	bufCmd.Flags().StringVar(&cli.VarStringStyle, "style", "gozero", "The file "+
+		"naming format, see [https://github.com/zeromicro/go-zero/tree/master/tools/goctl/config/readme.md]")
+	bufCmd.Flags().StringVar(&cli.VarStringHome, "home", "", "The goctl home "+
+		"path of the template, --home and --remote cannot be set at the same time, if they are, --remote "+
+		"has higher priority")
+	bufCmd.Flags().StringVar(&cli.VarStringRemote, "remote", "", "The remote "+
+		"git repo of the template, --home and --remote cannot be set at the same time, if they are, "+
+		"--remote has higher priority\n\tThe git repo directory must be consistent with the "+
+		"https://github.com/zeromicro/go-zero-template directory structure")
+	bufCmd.Flags().StringVar(&cli.VarStringBranch, "branch", "",
+		"The branch of the remote repo, it does work with --remote")
+	bufCmd.Flags().BoolVarP(&cli.VarBoolVerbose, "verbose", "v", false, "Enable log output")
+	bufCmd.Flags().BoolVar(&cli.VarBoolBuiltinProtoc, "builtin-protoc", false, "Compile the proto "+
+		"files in process without protoc, it's used if protoc is not found")
+
+	breakingCmd.Flags().StringVar(&diff.VarStringAgainst, "against", "", "The directory or the git "+
+		"revision to check against, the proto files are compared by the same relative paths")
+	breakingCmd.Flags().StringVar(&diff.VarStringBreakingFormat, "format", "text", "The output "+
+		"format of the breaking changes, text, json or sarif")
+
+	diffCmd.Flags().StringVar(&diff.VarStringOld, "old", "", "The old proto file, or <git ref>:<path> "+
+		"to read it from a git revision")
+	diffCmd.Flags().StringVar(&diff.VarStringNew, "new", "", "The new proto file, or <git ref>:<path> "+
+		"to read it from a git revision")
+	diffCmd.Flags().StringVar(&diff.VarStringFormat, "format", "text", "The output format of "+
+		"the changes, text or json")
+
+	lintCmd.Flags().StringVar(&lint.VarStringFormat, "format", "text", "The output format of "+
+		"the diagnostics, text, json or sarif")
+	lintCmd.Flags().StringSliceVarP(&lint.VarStringSliceProtoPath, "proto_path", "I", nil, "The "+
+		"paths to search the imports, the directory of the proto file is searched at last")
+
+	modelCmd.Flags().StringVar(&model.VarStringProto, "proto", "", "The proto file which "+
+		"defines the messages")
+	modelCmd.Flags().StringSliceVar(&model.VarStringSliceMessage, "message", nil, "The messages "+
+		"which the tables are derived from, the nested messages are like Outer.Inner")
+	modelCmd.Flags().StringVar(&model.VarStringDialect, "dialect", "mysql", "The sql dialect, "+
+		"only mysql is supported")
+	modelCmd.Flags().StringVarP(&model.VarStringDir, "dir", "d", "", "The target dir")
+	modelCmd.Flags().StringVar(&model.VarStringPbPackage, "pb_package", "", "The import path "+
+		"of the generated go package of the proto file, it's resolved from option go_package by default")
+	modelCmd.Flags().BoolVarP(&model.VarBoolCache, "cache", "c", false, "Generate code with cache [optional]")
+	modelCmd.Flags().StringVar(&model.VarStringStyle, "style", "", "The file naming format, see "+
+		"[https://github.com/zeromicro/go-zero/tree/master/tools/goctl/config/readme.md]")
+	modelCmd.Flags().StringVar(&model.VarStringDatabase, "database", "", "The name of database [optional]")
+	modelCmd.Flags().StringVar(&model.VarStringApp, "app", "", "The name of the application")
+	modelCmd.Flags().StringVar(&model.VarStringHome, "home", "", "The goctl home path of "+
+		"the template, --home and --remote cannot be set at the same time, if they are, --remote has"+
+		" higher priority")
+	modelCmd.Flags().StringVar(&model.VarStringRemote, "remote", "", "The remote git repo"+
+		" of the template, --home and --remote cannot be set at the same time, if they are, --remote"+
+		" has higher priority\n\tThe git repo directory must be consistent with the "+
+		"https://github.com/zeromicro/go-zero-template directory structure")
+	modelCmd.Flags().StringVar(&model.VarStringBranch, "branch", "", "The branch of the "+
+		"remote repo, it does work with --remote")
+
 	newCmd.Flags().StringSliceVar(&cli.VarStringSliceGoOpt, "go_opt", nil, "")
 	newCmd.Flags().StringSliceVar(&cli.VarStringSliceGoGRPCOpt, "go-grpc_opt", nil, "")
 	newCmd.Flags().StringVar(&cli.VarStringStyle, "style", "gozero", "The file "+
@@ -63,6 +168,8 @@ func init() {
 	newCmd.Flags().StringVar(&cli.VarStringBranch, "branch", "",
 		"The branch of the remote repo, it does work with --remote")
 	newCmd.Flags().BoolVarP(&cli.VarBoolVerbose, "verbose", "v", false, "Enable log output")
+	newCmd.Flags().BoolVar(&cli.VarBoolBuiltinProtoc, "builtin-protoc", false, "Compile the proto "+
+		"file in process without protoc, it's used if protoc is not found in PATH")
 	newCmd.Flags().MarkHidden("go_opt")
 	newCmd.Flags().MarkHidden("go-grpc_opt")
 
@@ -75,6 +182,12 @@ func init() {
 	protocCmd.Flags().StringSliceVar(&cli.VarStringSlicePlugin, "plugin", nil, "")
 	protocCmd.Flags().StringSliceVarP(&cli.VarStringSliceProtoPath, "proto_path", "I", nil, "")
 	protocCmd.Flags().StringVar(&cli.VarStringZRPCOut, "zrpc_out", "", "The zrpc output directory")
+	protocCmd.Flags().StringSliceVar(&cli.VarStringSliceGoctlPlugin, "goctl-plugin", nil, "The goctl "+
+		"plugins invoked with the parsed proto after the generation, like name=args")
+	protocCmd.Flags().StringVar(&plugin.VarStringLock, "lock", "goctl-plugin.lock", "The lock file "+
+		"which pins the remote goctl plugins by sha256, each line is like <url> sha256:<hex>")
+	protocCmd.Flags().BoolVar(&plugin.VarBoolUnpinned, "unpinned", false, "Run the remote goctl "+
+		"plugins which are not pinned by sha256")
 	protocCmd.Flags().StringVar(&cli.VarStringStyle, "style", "gozero", "The file "+
 		"naming format, see [https://github.com/zeromicro/go-zero/tree/master/tools/goctl/config/readme.md]")
 	protocCmd.Flags().StringVar(&cli.VarStringHome, "home", "", "The goctl home "+
@@ -87,6 +200,20 @@ func init() {
 	protocCmd.Flags().StringVar(&cli.VarStringBranch, "branch", "",
 		"The branch of the remote repo, it does work with --remote")
 	protocCmd.Flags().BoolVarP(&cli.VarBoolVerbose, "verbose", "v", false, "Enable log output")
+	protocCmd.Flags().BoolVar(&cli.VarBoolBuiltinProtoc, "builtin-protoc", false, "Compile the proto "+
+		"files in process without protoc, it's used if protoc is not found in PATH")
+	protocCmd.Flags().BoolVar(&cli.VarBoolHealth, "health", false, "Generate the grpc health "+
+		"checks whose status follows the readiness of the service context")
+	protocCmd.Flags().BoolVar(&cli.VarBoolReflection, "reflection", false, "Generate the config to "+
+		"register the grpc reflection service out of the dev and test mode")
+	protocCmd.Flags().BoolVar(&cli.VarBoolInterceptors, "interceptors", false, "Generate the unary "+
+		"and stream interceptor chains in the service context")
+	protocCmd.Flags().BoolVar(&cli.VarBoolDrain, "drain", false, "Generate the config of the "+
+		"timeout of the graceful stop on SIGTERM, the process is force quit after it")
+	protocCmd.Flags().BoolVar(&cli.VarBoolTests, "tests", false, "Generate the table-driven tests "+
+		"of the rpcs and the testkit which starts the server over bufconn")
+	protocCmd.Flags().BoolVar(&cli.VarBoolCli, "cli", false, "Generate the command line client "+
+		"of each service with a subcommand of each rpc")
 	protocCmd.Flags().MarkHidden("go_out")
 	protocCmd.Flags().MarkHidden("go-grpc_out")
 	protocCmd.Flags().MarkHidden("go_opt")
@@ -105,6 +232,11 @@ func init() {
 	templateCmd.Flags().StringVar(&cli.VarStringBranch, "branch", "", "The branch"+
 		" of the remote repo, it does work with --remote")
 
+	Cmd.AddCommand(breakingCmd)
+	Cmd.AddCommand(bufCmd)
+	Cmd.AddCommand(diffCmd)
+	Cmd.AddCommand(lintCmd)
+	Cmd.AddCommand(modelCmd)
 	Cmd.AddCommand(newCmd)
 	Cmd.AddCommand(protocCmd)
 	Cmd.AddCommand(templateCmd)
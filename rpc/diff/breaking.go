@@ -0,0 +1,193 @@
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/pkg/compat"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+const codeRemovedFile = "removed-file"
+
+// positions are the positions of the elements by the paths of the changes
+type positions map[string]scanner.Position
+
+// Breaking compares the proto files in the dir with the files of the same relative paths in the
+// against dir, all the proto files in the dir are compared if no file is given, the breaking
+// changes are reported at the positions in the new files.
+func Breaking(dir, against string, files ...string) (spec.Diagnostics, error) {
+	var diagnostics spec.Diagnostics
+	if len(files) == 0 {
+		newFiles, err := protoFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		oldFiles, err := protoFiles(against)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, each := range oldFiles {
+			if !contains(newFiles, each) {
+				diagnostics = append(diagnostics, spec.NewDiagnostic(filepath.Join(dir, each), 0, 0,
+					codeRemovedFile, "file removed"))
+				continue
+			}
+			files = append(files, each)
+		}
+	}
+
+	for _, each := range files {
+		oldFile := filepath.Join(against, each)
+		if _, err := os.Stat(oldFile); os.IsNotExist(err) {
+			continue
+		}
+
+		oldProto, _, err := load(oldFile)
+		if err != nil {
+			return nil, err
+		}
+
+		newFile := filepath.Join(dir, each)
+		newProto, newPositions, err := load(newFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range Compare(oldProto, newProto) {
+			if change.Kind != compat.Breaking {
+				continue
+			}
+
+			pos := newPositions.lookup(change.Path)
+			diagnostics = append(diagnostics, spec.NewDiagnostic(newFile, pos.Line, pos.Column, change.Code,
+				"%s: %s", change.Path, change.Message))
+		}
+	}
+
+	diagnostics.Sort()
+	return diagnostics, nil
+}
+
+// lookup returns the position of the element of the path, the position of the nearest enclosing
+// element is returned if the element is removed.
+func (p positions) lookup(path string) scanner.Position {
+	for len(path) > 0 {
+		if pos, ok := p[path]; ok {
+			return pos
+		}
+
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return scanner.Position{}
+}
+
+// load parses the proto file which may have no service, the positions of the elements are
+// indexed by the paths of the changes reported by Compare.
+func load(filename string) (parser.Proto, positions, error) {
+	r, err := os.Open(filename)
+	if err != nil {
+		return parser.Proto{}, nil, err
+	}
+	defer r.Close()
+
+	p := proto.NewParser(r)
+	p.Filename(filename)
+	set, err := p.Parse()
+	if err != nil {
+		return parser.Proto{}, nil, err
+	}
+
+	ret := parser.Proto{Src: filename, Name: filepath.Base(filename)}
+	index := make(positions)
+	proto.Walk(set,
+		proto.WithPackage(func(p *proto.Package) {
+			ret.Package = parser.Package{Package: p}
+			index["package"] = p.Position
+		}),
+		proto.WithMessage(func(message *proto.Message) {
+			ret.Message = append(ret.Message, parser.Message{Message: message})
+			if message.IsExtend {
+				return
+			}
+
+			name := messageName(message)
+			index[name] = message.Position
+			var collect func(elements []proto.Visitee)
+			collect = func(elements []proto.Visitee) {
+				for _, element := range elements {
+					switch e := element.(type) {
+					case *proto.NormalField:
+						index[name+"."+e.Name] = e.Position
+					case *proto.MapField:
+						index[name+"."+e.Name] = e.Position
+					case *proto.OneOfField:
+						index[name+"."+e.Name] = e.Position
+					case *proto.Oneof:
+						collect(e.Elements)
+					}
+				}
+			}
+			collect(message.Elements)
+		}),
+		proto.WithService(func(service *proto.Service) {
+			s := parser.Service{Service: service}
+			index[service.Name] = service.Position
+			for _, element := range service.Elements {
+				if rpc, ok := element.(*proto.RPC); ok {
+					s.RPC = append(s.RPC, &parser.RPC{RPC: rpc})
+					index[service.Name+"."+rpc.Name] = rpc.Position
+				}
+			}
+			ret.Service = append(ret.Service, s)
+		}),
+	)
+
+	return ret, index, nil
+}
+
+// protoFiles returns the proto files in the dir by the paths relative to the dir
+func protoFiles(dir string) ([]string, error) {
+	var files []string
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".proto" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, rel)
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+func contains(list []string, s string) bool {
+	for _, each := range list {
+		if each == s {
+			return true
+		}
+	}
+	return false
+}
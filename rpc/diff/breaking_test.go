@@ -0,0 +1,82 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+const messagesProto = `syntax = "proto3";
+
+package greet;
+
+message Req {
+  string name = 1;
+}
+`
+
+func TestBreaking(t *testing.T) {
+	oldDir, newDir := t.TempDir(), t.TempDir()
+	write := func(dir, name, content string) {
+		filename := filepath.Join(dir, name)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filename), os.ModePerm))
+		assert.Nil(t, ioutil.WriteFile(filename, []byte(content), os.ModePerm))
+	}
+	write(oldDir, "greet.proto", oldProto)
+	write(newDir, "greet.proto", newProto)
+	write(oldDir, "types/messages.proto", messagesProto)
+	write(newDir, "types/messages.proto", messagesProto)
+	write(oldDir, "removed.proto", messagesProto)
+
+	diagnostics, err := Breaking(newDir, oldDir)
+	assert.Nil(t, err)
+
+	type result struct {
+		file string
+		line int
+		code string
+	}
+	var results []result
+	for _, each := range diagnostics {
+		rel, err := filepath.Rel(newDir, each.File)
+		assert.Nil(t, err)
+		results = append(results, result{file: rel, line: each.Line, code: each.Code})
+	}
+	assert.Equal(t, []result{
+		// the renamed field is reported at the message
+		{"greet.proto", 6, codeRenamedField},
+		{"greet.proto", 8, codeChangedField},
+		{"greet.proto", 14, codeChangedLabel},
+		// the removed rpc is reported at the service
+		{"greet.proto", 20, codeRemovedRPC},
+		{"greet.proto", 21, codeChangedStream},
+		{"removed.proto", 0, codeRemovedFile},
+	}, results)
+
+	diagnostics, err = Breaking(newDir, oldDir, filepath.Join("types", "messages.proto"))
+	assert.Nil(t, err)
+	assert.Empty(t, diagnostics)
+}
+
+func TestBreakingCommand(t *testing.T) {
+	oldDir, newDir := t.TempDir(), t.TempDir()
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(oldDir, "greet.proto"), []byte(oldProto), os.ModePerm))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(newDir, "greet.proto"), []byte(newProto), os.ModePerm))
+	VarStringAgainst, VarStringBreakingFormat = oldDir, "sarif"
+	defer func() {
+		VarStringAgainst, VarStringBreakingFormat = "", ""
+	}()
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	assert.NotNil(t, BreakingCommand(cmd, []string{newDir}))
+	// the error of the breaking changes is not mixed with the report
+	assert.True(t, json.Valid(out.Bytes()))
+}
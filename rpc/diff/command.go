@@ -0,0 +1,172 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/validate"
+	"github.com/yeyudekuangxiang/goctl/pkg/compat"
+	"github.com/yeyudekuangxiang/goctl/rpc/execx"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util"
+)
+
+var (
+	// VarStringOld describes the old proto file or git revision.
+	VarStringOld string
+	// VarStringNew describes the new proto file or git revision.
+	VarStringNew string
+	// VarStringFormat describes the output format, text or json.
+	VarStringFormat string
+	// VarStringAgainst describes the directory or the git revision to check the breaking changes against.
+	VarStringAgainst string
+	// VarStringBreakingFormat describes the output format of the breaking changes, text, json or sarif.
+	VarStringBreakingFormat string
+)
+
+// DiffCommand compares two versions of a proto file and reports the changes,
+// it fails if there is any breaking change, only the report is written to the output.
+func DiffCommand(cmd *cobra.Command, _ []string) error {
+	if len(VarStringOld) == 0 {
+		return errors.New("missing --old")
+	}
+	if len(VarStringNew) == 0 {
+		return errors.New("missing --new")
+	}
+
+	oldProto, err := parse(VarStringOld)
+	if err != nil {
+		return err
+	}
+
+	newProto, err := parse(VarStringNew)
+	if err != nil {
+		return err
+	}
+
+	report := Compare(oldProto, newProto)
+	if err = report.Print(cmd.OutOrStdout(), VarStringFormat); err != nil {
+		return err
+	}
+
+	if report.Breaking() {
+		return errors.New("breaking changes found")
+	}
+
+	return nil
+}
+
+// BreakingCommand checks the proto files in the directory or the proto file given by the argument
+// against the files of the same paths in a directory or a git revision, it fails if there is any
+// breaking change, only the report is written to the output.
+func BreakingCommand(cmd *cobra.Command, args []string) error {
+	if len(VarStringAgainst) == 0 {
+		return errors.New("missing --against")
+	}
+
+	source := "."
+	if len(args) > 0 {
+		source = args[0]
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	dir := source
+	var files []string
+	if !info.IsDir() {
+		dir = filepath.Dir(source)
+		files = []string{filepath.Base(source)}
+	}
+
+	against, cleanup, err := resolveAgainst(dir, VarStringAgainst)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	diagnostics, err := Breaking(dir, against, files...)
+	if err != nil {
+		return err
+	}
+
+	if err = validate.Print(cmd.OutOrStdout(), VarStringBreakingFormat, diagnostics); err != nil {
+		return err
+	}
+
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%d breaking change(s) found", len(diagnostics))
+	}
+
+	return nil
+}
+
+// resolveAgainst returns the directory to check the dir against, the against is either a
+// directory or a git revision, the dir of which is exported to a temporary directory.
+func resolveAgainst(dir, against string) (string, func(), error) {
+	nop := func() {}
+	if info, err := os.Stat(against); err == nil && info.IsDir() {
+		return against, nop, nil
+	}
+
+	top, err := execx.Run("git rev-parse --show-toplevel", "")
+	if err != nil {
+		return "", nop, fmt.Errorf("%s: no such directory or git revision", against)
+	}
+
+	rel, err := relativePath(strings.TrimSpace(top), dir)
+	if err != nil {
+		return "", nop, err
+	}
+
+	tmp, err := ioutil.TempDir("", "goctl-breaking-")
+	if err != nil {
+		return "", nop, err
+	}
+	cleanup := func() {
+		_ = os.RemoveAll(tmp)
+	}
+
+	if err = util.ExportGitRef(against, tmp); err != nil {
+		cleanup()
+		return "", nop, err
+	}
+
+	return filepath.Join(tmp, rel), cleanup, nil
+}
+
+func relativePath(base, target string) (string, error) {
+	base, err := filepath.EvalSymlinks(base)
+	if err != nil {
+		return "", err
+	}
+
+	abs, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+
+	abs, err = filepath.EvalSymlinks(abs)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(base, abs)
+}
+
+func parse(source string) (parser.Proto, error) {
+	file, cleanup, err := compat.Resolve(source)
+	if err != nil {
+		return parser.Proto{}, err
+	}
+	defer cleanup()
+
+	return parser.NewDefaultProtoParser().Parse(file, true)
+}
@@ -0,0 +1,264 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/emicklei/proto"
+	"github.com/yeyudekuangxiang/goctl/pkg/compat"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+const (
+	codeRemovedService = "removed-service"
+	codeAddedService   = "added-service"
+	codeRemovedRPC     = "removed-rpc"
+	codeAddedRPC       = "added-rpc"
+	codeChangedRPC     = "changed-rpc"
+	codeChangedStream  = "changed-stream"
+	codeRemovedMessage = "removed-message"
+	codeAddedMessage   = "added-message"
+	codeRemovedField   = "removed-field"
+	codeAddedField     = "added-field"
+	codeChangedField   = "changed-field"
+	codeRenamedField   = "renamed-field"
+	codeChangedNumber  = "changed-number"
+	codeChangedLabel   = "changed-label"
+	codeChangedPackage = "changed-package"
+)
+
+type field struct {
+	name   string
+	typ    string
+	number int
+	label  string
+}
+
+// Compare compares two versions of a proto file and classifies the changes
+// by the wire and the generated code compatibility.
+func Compare(oldProto, newProto parser.Proto) compat.Report {
+	var report compat.Report
+	if oldProto.Package.Package != nil && newProto.Package.Package != nil &&
+		oldProto.Package.Name != newProto.Package.Name {
+		report.Add(compat.Breaking, codeChangedPackage, "package", "package changed from %s to %s",
+			oldProto.Package.Name, newProto.Package.Name)
+	}
+
+	compareServices(&report, oldProto.Service, newProto.Service)
+	compareMessages(&report, oldProto.Message, newProto.Message)
+	return report
+}
+
+func compareServices(report *compat.Report, oldServices, newServices parser.Services) {
+	newByName := make(map[string]parser.Service)
+	for _, each := range newServices {
+		newByName[each.Name] = each
+	}
+
+	oldByName := make(map[string]parser.Service)
+	for _, oldService := range oldServices {
+		oldByName[oldService.Name] = oldService
+		newService, ok := newByName[oldService.Name]
+		if !ok {
+			report.Add(compat.Breaking, codeRemovedService, oldService.Name, "service removed")
+			continue
+		}
+
+		newRPCs := make(map[string]*parser.RPC)
+		for _, each := range newService.RPC {
+			newRPCs[each.Name] = each
+		}
+		oldRPCs := make(map[string]*parser.RPC)
+		for _, oldRPC := range oldService.RPC {
+			oldRPCs[oldRPC.Name] = oldRPC
+			path := oldService.Name + "." + oldRPC.Name
+			newRPC, ok := newRPCs[oldRPC.Name]
+			if !ok {
+				report.Add(compat.Breaking, codeRemovedRPC, path, "rpc removed")
+				continue
+			}
+
+			if oldRPC.RequestType != newRPC.RequestType {
+				report.Add(compat.Breaking, codeChangedRPC, path, "request type changed from %s to %s",
+					oldRPC.RequestType, newRPC.RequestType)
+			}
+			if oldRPC.ReturnsType != newRPC.ReturnsType {
+				report.Add(compat.Breaking, codeChangedRPC, path, "returns type changed from %s to %s",
+					oldRPC.ReturnsType, newRPC.ReturnsType)
+			}
+			if oldRPC.StreamsRequest != newRPC.StreamsRequest || oldRPC.StreamsReturns != newRPC.StreamsReturns {
+				report.Add(compat.Breaking, codeChangedStream, path, "streaming changed from %s to %s",
+					streamMode(oldRPC), streamMode(newRPC))
+			}
+		}
+
+		for _, each := range newService.RPC {
+			if _, ok := oldRPCs[each.Name]; !ok {
+				report.Add(compat.Compatible, codeAddedRPC, newService.Name+"."+each.Name, "rpc added")
+			}
+		}
+	}
+
+	for _, each := range newServices {
+		if _, ok := oldByName[each.Name]; !ok {
+			report.Add(compat.Compatible, codeAddedService, each.Name, "service added")
+		}
+	}
+}
+
+func compareMessages(report *compat.Report, oldMessages, newMessages []parser.Message) {
+	oldByName := indexMessages(oldMessages)
+	newByName := indexMessages(newMessages)
+	for _, name := range sortedNames(oldByName) {
+		newMessage, ok := newByName[name]
+		if !ok {
+			report.Add(compat.Breaking, codeRemovedMessage, name, "message removed")
+			continue
+		}
+
+		compareFields(report, name, fields(oldByName[name]), fields(newMessage))
+	}
+
+	for _, name := range sortedNames(newByName) {
+		if _, ok := oldByName[name]; !ok {
+			report.Add(compat.Compatible, codeAddedMessage, name, "message added")
+		}
+	}
+}
+
+func compareFields(report *compat.Report, message string, oldFields, newFields []field) {
+	newByNumber := make(map[int]field)
+	for _, each := range newFields {
+		newByNumber[each.number] = each
+	}
+	newByName := make(map[string]field)
+	for _, each := range newFields {
+		newByName[each.name] = each
+	}
+
+	oldByNumber := make(map[int]field)
+	for _, oldField := range oldFields {
+		oldByNumber[oldField.number] = oldField
+		path := message + "." + oldField.name
+		newField, ok := newByNumber[oldField.number]
+		if !ok {
+			if moved, ok := newByName[oldField.name]; ok {
+				report.Add(compat.Breaking, codeChangedNumber, path, "field number changed from %d to %d",
+					oldField.number, moved.number)
+			} else {
+				report.Add(compat.Breaking, codeRemovedField, path, "field %d removed", oldField.number)
+			}
+			continue
+		}
+
+		if oldField.typ != newField.typ {
+			report.Add(compat.Breaking, codeChangedField, path, "field type changed from %s to %s",
+				oldField.typ, newField.typ)
+		}
+		if oldField.label != newField.label {
+			report.Add(compat.Breaking, codeChangedLabel, path, "field label changed from %q to %q",
+				oldField.label, newField.label)
+		}
+		if oldField.name != newField.name {
+			// the wire format is compatible, but the generated code and the json name are not.
+			report.Add(compat.Breaking, codeRenamedField, path, "field %d renamed to %s",
+				oldField.number, newField.name)
+		}
+	}
+
+	for _, each := range newFields {
+		if _, ok := oldByNumber[each.number]; ok {
+			continue
+		}
+		// the field number changed is reported already
+		if hasName(oldFields, each.name) {
+			continue
+		}
+		report.Add(compat.Compatible, codeAddedField, message+"."+each.name, "field %d added", each.number)
+	}
+}
+
+func hasName(fields []field, name string) bool {
+	for _, each := range fields {
+		if each.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fields(message *proto.Message) []field {
+	var result []field
+	var collect func(elements []proto.Visitee, label string)
+	collect = func(elements []proto.Visitee, label string) {
+		for _, element := range elements {
+			switch v := element.(type) {
+			case *proto.NormalField:
+				l := label
+				switch {
+				case v.Repeated:
+					l = "repeated"
+				case v.Optional:
+					l = "optional"
+				case v.Required:
+					l = "required"
+				}
+				result = append(result, field{name: v.Name, typ: v.Type, number: v.Sequence, label: l})
+			case *proto.MapField:
+				result = append(result, field{
+					name:   v.Name,
+					typ:    fmt.Sprintf("map<%s, %s>", v.KeyType, v.Type),
+					number: v.Sequence,
+				})
+			case *proto.OneOfField:
+				result = append(result, field{name: v.Name, typ: v.Type, number: v.Sequence, label: label})
+			case *proto.Oneof:
+				collect(v.Elements, "oneof "+v.Name)
+			}
+		}
+	}
+	collect(message.Elements, "")
+	return result
+}
+
+func indexMessages(messages []parser.Message) map[string]*proto.Message {
+	result := make(map[string]*proto.Message)
+	for _, each := range messages {
+		if each.IsExtend {
+			continue
+		}
+		result[messageName(each.Message)] = each.Message
+	}
+	return result
+}
+
+// messageName returns the name of the message qualified by the enclosing messages
+func messageName(message *proto.Message) string {
+	name := message.Name
+	for parent, ok := message.Parent.(*proto.Message); ok; parent, ok = parent.Parent.(*proto.Message) {
+		name = parent.Name + "." + name
+	}
+	return name
+}
+
+func sortedNames(messages map[string]*proto.Message) []string {
+	var names []string
+	for name := range messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func streamMode(rpc *parser.RPC) string {
+	switch {
+	case rpc.StreamsRequest && rpc.StreamsReturns:
+		return "bidirectional"
+	case rpc.StreamsRequest:
+		return "client stream"
+	case rpc.StreamsReturns:
+		return "server stream"
+	default:
+		return "unary"
+	}
+}
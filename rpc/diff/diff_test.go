@@ -0,0 +1,112 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/pkg/compat"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+const oldProto = `syntax = "proto3";
+
+package greet;
+option go_package = "./greet";
+
+message Req {
+  string name = 1;
+  int64 age = 2;
+  string nick = 3;
+}
+
+message Resp {
+  repeated string tags = 1;
+  map<string, int64> scores = 2;
+}
+
+service Greet {
+  rpc Hello(Req) returns (Resp);
+  rpc Bye(Req) returns (Resp);
+}
+`
+
+const newProto = `syntax = "proto3";
+
+package greet;
+option go_package = "./greet";
+
+message Req {
+  string name = 1;
+  string age = 2;
+  string nickname = 3;
+  bool vip = 4;
+}
+
+message Resp {
+  string tags = 1;
+  map<string, int64> scores = 2;
+}
+
+message Empty {}
+
+service Greet {
+  rpc Hello(Req) returns (stream Resp);
+  rpc Ping(Empty) returns (Empty);
+}
+`
+
+func TestCompare(t *testing.T) {
+	report := Compare(mustParse(t, oldProto), mustParse(t, newProto))
+	assert.True(t, report.Breaking())
+	assert.ElementsMatch(t, []compat.Change{
+		{Kind: compat.Breaking, Code: codeChangedStream, Path: "Greet.Hello",
+			Message: "streaming changed from unary to server stream"},
+		{Kind: compat.Breaking, Code: codeRemovedRPC, Path: "Greet.Bye", Message: "rpc removed"},
+		{Kind: compat.Compatible, Code: codeAddedRPC, Path: "Greet.Ping", Message: "rpc added"},
+		{Kind: compat.Breaking, Code: codeChangedField, Path: "Req.age",
+			Message: "field type changed from int64 to string"},
+		{Kind: compat.Breaking, Code: codeRenamedField, Path: "Req.nick", Message: "field 3 renamed to nickname"},
+		{Kind: compat.Compatible, Code: codeAddedField, Path: "Req.vip", Message: "field 4 added"},
+		{Kind: compat.Breaking, Code: codeChangedLabel, Path: "Resp.tags",
+			Message: `field label changed from "repeated" to ""`},
+		{Kind: compat.Compatible, Code: codeAddedMessage, Path: "Empty", Message: "message added"},
+	}, []compat.Change(report))
+}
+
+func TestCompareSame(t *testing.T) {
+	proto := mustParse(t, oldProto)
+	report := Compare(proto, proto)
+	assert.False(t, report.Breaking())
+	assert.Empty(t, report)
+}
+
+func TestDiffCommand(t *testing.T) {
+	VarStringOld = filepath.Join(t.TempDir(), "greet.proto")
+	VarStringNew = filepath.Join(t.TempDir(), "greet.proto")
+	VarStringFormat = "json"
+	defer func() {
+		VarStringOld, VarStringNew, VarStringFormat = "", "", ""
+	}()
+	assert.Nil(t, ioutil.WriteFile(VarStringOld, []byte(oldProto), 0o644))
+	assert.Nil(t, ioutil.WriteFile(VarStringNew, []byte(newProto), 0o644))
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	assert.NotNil(t, DiffCommand(cmd, nil))
+	// the error of the breaking changes is not mixed with the report
+	assert.True(t, json.Valid(out.Bytes()))
+}
+
+func mustParse(t *testing.T, content string) parser.Proto {
+	file := filepath.Join(t.TempDir(), "greet.proto")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(content), 0o644))
+	proto, err := parser.NewDefaultProtoParser().Parse(file, true)
+	assert.Nil(t, err)
+	return proto
+}
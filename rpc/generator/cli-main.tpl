@@ -0,0 +1,55 @@
+{{.head}}
+
+package main
+
+import (
+	"context"
+{{if .stream}}	"io"
+{{end}}	"os"
+
+	{{.imports}}
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func main() {
+	root := &cobra.Command{
+		Use:          "{{.name}}",
+		Short:        "The command line client of the {{.service}} service",
+		SilenceUsage: true,
+	}
+	target := cli.NewTarget(root, "{{.key}}", "{{.endpoint}}")
+{{range .rpcs}}
+	root.AddCommand(cli.{{if .StreamsReturns}}StreamCommand{{else}}Command{{end}}(target, "{{.Use}}", {{printf "%q" .Short}}, new({{.Request}}),
+		func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, out func(proto.Message) error) error {
+{{if .StreamsReturns}}			stream, err := {{$.pkg}}.New{{$.service}}Client(conn).{{.Method}}(ctx, in.(*{{.Request}}))
+			if err != nil {
+				return err
+			}
+
+			for {
+				resp, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if err := out(resp); err != nil {
+					return err
+				}
+			}
+{{else}}			resp, err := {{$.pkg}}.New{{$.service}}Client(conn).{{.Method}}(ctx, in.(*{{.Request}}))
+			if err != nil {
+				return err
+			}
+
+			return out(resp)
+{{end}}		}))
+{{end}}
+	if err := root.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
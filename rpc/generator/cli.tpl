@@ -0,0 +1,353 @@
+{{.head}}
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxDepth stops the flags of the nested messages, the deeper fields are set by --data
+const maxDepth = 5
+
+type (
+	// Call calls the rpc with the request, the responses are written by out
+	Call func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message,
+		out func(proto.Message) error) error
+
+	// Target is the zrpc client conf of the service set by the persistent flags
+	Target struct {
+		file      string
+		etcd      []string
+		key       string
+		endpoints []string
+		target    string
+		endpoint  string
+		timeout   time.Duration
+		compact   bool
+		flags     *pflag.FlagSet
+	}
+
+	field struct {
+		path []protoreflect.FieldDescriptor
+		kind string
+	}
+)
+
+// NewTarget adds the flags of the target to the root command, the service is discovered by the
+// etcd key or called by the endpoint if neither the file nor the flags are given.
+func NewTarget(root *cobra.Command, key, endpoint string) *Target {
+	flags := root.PersistentFlags()
+	t := &Target{endpoint: endpoint, flags: flags}
+	flags.StringVarP(&t.file, "file", "f", "", "the yaml file of the zrpc client conf")
+	flags.StringSliceVar(&t.etcd, "etcd", nil, "the etcd hosts to discover the service")
+	flags.StringVar(&t.key, "key", key, "the etcd key of the service")
+	flags.StringSliceVar(&t.endpoints, "endpoints", nil, "the endpoints of the service, default "+endpoint)
+	flags.StringVar(&t.target, "target", "", "the grpc target of the service, e.g. dns:///127.0.0.1:8080")
+	flags.DurationVar(&t.timeout, "timeout", 5*time.Second, "the timeout of the unary call, the streams are not timed out")
+	flags.BoolVar(&t.compact, "compact", false, "print the responses in one line")
+	return t
+}
+
+// Conf returns the zrpc client conf, the flags take precedence over the file
+func (t *Target) Conf() (zrpc.RpcClientConf, error) {
+	var c zrpc.RpcClientConf
+	if len(t.file) > 0 {
+		if err := conf.Load(t.file, &c); err != nil {
+			return c, err
+		}
+	}
+
+	switch {
+	case len(t.target) > 0:
+		c = zrpc.RpcClientConf{Target: t.target, App: c.App, Token: c.Token}
+	case len(t.etcd) > 0:
+		c = zrpc.RpcClientConf{Etcd: discov.EtcdConf{Hosts: t.etcd, Key: t.key}, App: c.App, Token: c.Token}
+	case len(t.endpoints) > 0:
+		c = zrpc.RpcClientConf{Endpoints: t.endpoints, App: c.App, Token: c.Token}
+	case len(t.file) == 0:
+		c.Endpoints = []string{t.endpoint}
+	}
+	// the timeout of the call is set by --timeout
+	c.Timeout = 0
+	return c, nil
+}
+
+// Command returns the command of the unary rpc, the request is read from the json of --data, and
+// the fields are overridden by the flags like --name or --user.id, the repeated fields are appended.
+// The fields whose flags are taken by the target, e.g. --key or --timeout, are only set by --data.
+func Command(t *Target, use, short string, in proto.Message, call Call) *cobra.Command {
+	return command(t, use, short, in, call, false)
+}
+
+// StreamCommand returns the command of the server streaming rpc like Command, the responses are
+// printed until the stream ends, it's not timed out by --timeout.
+func StreamCommand(t *Target, use, short string, in proto.Message, call Call) *cobra.Command {
+	return command(t, use, short, in, call, true)
+}
+
+func command(t *Target, use, short string, in proto.Message, call Call, stream bool) *cobra.Command {
+	var data string
+	fields := make(map[string]field)
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := readData(data, in); err != nil {
+				return err
+			}
+			if err := mergeFlags(cmd.Flags(), fields, in); err != nil {
+				return err
+			}
+
+			c, err := t.Conf()
+			if err != nil {
+				return err
+			}
+			// the logs of the client would be mixed with the responses
+			logx.Disable()
+			client, err := zrpc.NewClient(c)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.Background(), context.CancelFunc(func() {})
+			if !stream {
+				ctx, cancel = context.WithTimeout(ctx, t.timeout)
+			}
+			defer cancel()
+			return call(ctx, client.Conn(), in, func(resp proto.Message) error {
+				return t.print(cmd.OutOrStdout(), resp)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&data, "data", "d", "", "the request in json, @file reads the file and - reads the stdin")
+	if shadowed := addFlags(cmd.Flags(), t.flags, fields, in.ProtoReflect().Descriptor(), "", nil); len(shadowed) > 0 {
+		cmd.Long = fmt.Sprintf("%s\n\nThe flags of the fields %s are taken by the target, set them by --data.",
+			short, strings.Join(shadowed, ", "))
+	}
+	return cmd
+}
+
+// print prints the response in json, the output of protojson is normalized to be stable
+func (t *Target) print(w io.Writer, resp proto.Message) error {
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if t.compact {
+		err = json.Compact(&buf, data)
+	} else {
+		err = json.Indent(&buf, data, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	buf.WriteByte('\n')
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+func readData(data string, in proto.Message) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	content := []byte(data)
+	var err error
+	switch {
+	case data == "-":
+		content, err = ioutil.ReadAll(os.Stdin)
+	case strings.HasPrefix(data, "@"):
+		content, err = ioutil.ReadFile(data[1:])
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := protojson.Unmarshal(content, in); err != nil {
+		return fmt.Errorf("--data: %w", err)
+	}
+	return nil
+}
+
+// addFlags adds the flags of the fields of the message, the nested messages are flattened by the
+// dotted names, the recursive messages, the maps of messages and the lists of messages are set
+// by --data. The fields shadowed by the flags of the target are skipped and returned.
+func addFlags(flags, target *pflag.FlagSet, fields map[string]field, desc protoreflect.MessageDescriptor,
+	prefix string, path []protoreflect.FieldDescriptor) []string {
+	var shadowed []string
+	list := desc.Fields()
+	for i := 0; i < list.Len(); i++ {
+		fd := list.Get(i)
+		name := prefix + string(fd.Name())
+		if name == "data" || name == "help" || target.Lookup(name) != nil {
+			shadowed = append(shadowed, name)
+			continue
+		}
+		if flags.Lookup(name) != nil {
+			continue
+		}
+
+		current := append(append([]protoreflect.FieldDescriptor{}, path...), fd)
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil && !isWellKnown(fd.MapValue().Message()) {
+				continue
+			}
+			flags.StringToString(name, nil, fmt.Sprintf("%s, key=value", typeName(fd.MapValue())))
+			fields[name] = field{path: current, kind: "map"}
+		case fd.IsList():
+			if fd.Message() != nil && !isWellKnown(fd.Message()) {
+				continue
+			}
+			flags.StringArray(name, nil, fmt.Sprintf("repeated %s", typeName(fd)))
+			fields[name] = field{path: current, kind: "list"}
+		case fd.Message() != nil && !isWellKnown(fd.Message()):
+			if len(path) < maxDepth && !visiting(path, fd.Message()) {
+				shadowed = append(shadowed, addFlags(flags, target, fields, fd.Message(), name+".", current)...)
+			}
+		default:
+			flags.String(name, "", typeName(fd))
+			fields[name] = field{path: current}
+		}
+	}
+	return shadowed
+}
+
+// mergeFlags merges the changed flags into the request
+func mergeFlags(flags *pflag.FlagSet, fields map[string]field, in proto.Message) error {
+	values := make(map[string]interface{})
+	flags.Visit(func(flag *pflag.Flag) {
+		f, ok := fields[flag.Name]
+		if !ok {
+			return
+		}
+
+		var value interface{}
+		fd := f.path[len(f.path)-1]
+		switch f.kind {
+		case "map":
+			m, _ := flags.GetStringToString(flag.Name)
+			object := make(map[string]json.RawMessage)
+			for k, v := range m {
+				object[k] = jsonValue(fd.MapValue(), v)
+			}
+			value = object
+		case "list":
+			items, _ := flags.GetStringArray(flag.Name)
+			var array []json.RawMessage
+			for _, each := range items {
+				array = append(array, jsonValue(fd, each))
+			}
+			value = array
+		default:
+			value = jsonValue(fd, flag.Value.String())
+		}
+
+		object := values
+		for _, each := range f.path[:len(f.path)-1] {
+			child, ok := object[string(each.Name())].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				object[string(each.Name())] = child
+			}
+			object = child
+		}
+		object[string(fd.Name())] = value
+	})
+	if len(values) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	override := in.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(data, override); err != nil {
+		return fmt.Errorf("flags: %w", err)
+	}
+	proto.Merge(in, override)
+	return nil
+}
+
+// jsonValue returns the json of the flag value, the strings, the bytes and the enum names are
+// quoted, and the other values are quoted if they're not json, e.g. the timestamps.
+func jsonValue(fd protoreflect.FieldDescriptor, value string) json.RawMessage {
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return quote(value)
+	case protoreflect.EnumKind:
+		if _, err := strconv.Atoi(value); err == nil {
+			return json.RawMessage(value)
+		}
+		return quote(value)
+	default:
+		if json.Valid([]byte(value)) {
+			return json.RawMessage(value)
+		}
+		return quote(value)
+	}
+}
+
+func quote(value string) json.RawMessage {
+	data, _ := json.Marshal(value)
+	return data
+}
+
+func typeName(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.Enum() != nil:
+		var names []string
+		values := fd.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return strings.Join(names, "|")
+	case fd.Message() != nil:
+		return string(fd.Message().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// isWellKnown reports whether the message is a well known type which has a json value, e.g.
+// the timestamps, the durations and the wrappers.
+func isWellKnown(desc protoreflect.MessageDescriptor) bool {
+	return desc.ParentFile().Package() == "google.protobuf"
+}
+
+func visiting(path []protoreflect.FieldDescriptor, desc protoreflect.MessageDescriptor) bool {
+	for _, each := range path {
+		if each.Message() != nil && each.Message().FullName() == desc.FullName() {
+			return true
+		}
+	}
+	return false
+}
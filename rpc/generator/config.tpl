@@ -1,7 +1,13 @@
 package config
 
-import "github.com/zeromicro/go-zero/zrpc"
+import {{.imports}}
 
 type Config struct {
 	zrpc.RpcServerConf
-}
+{{if .reflection}}	// Reflection registers the grpc reflection service, it's always registered in dev and test mode
+	Reflection bool `json:",optional"`
+{{end}}{{if .health}}	// HealthInterval is the interval to check the readiness of the services
+	HealthInterval time.Duration `json:",default=5s"`
+{{end}}{{if .drain}}	// DrainPeriod is the timeout of the graceful stop on SIGTERM, the process is force quit after it
+	DrainPeriod time.Duration `json:",default=10s"`
+{{end}}}
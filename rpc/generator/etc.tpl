@@ -4,3 +4,7 @@ Etcd:
   Hosts:
   - 127.0.0.1:2379
   Key: {{.serviceName}}.rpc
+{{if .reflection}}Reflection: false
+{{end}}{{if .health}}HealthInterval: 5s
+{{end}}{{if .drain}}DrainPeriod: 10s
+{{end}}
\ No newline at end of file
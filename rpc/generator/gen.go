@@ -28,6 +28,41 @@ type ZRpcContext struct {
 	Output string
 	// Multiple is the flag to indicate whether the proto file is generated in multiple mode.
 	Multiple bool
+	// Builtin is the flag to indicate whether the proto file is compiled in process without protoc.
+	Builtin bool
+	// ProtoPaths are the import paths of the proto file.
+	ProtoPaths []string
+	// GoOpts are the options of protoc-gen-go, it works with Builtin.
+	GoOpts []string
+	// GrpcOpts are the options of protoc-gen-go-grpc, it works with Builtin.
+	GrpcOpts []string
+	// Health is the flag to indicate whether the health status follows the readiness of the service context.
+	Health bool
+	// Reflection is the flag to indicate whether the reflection service can be enabled by the config.
+	Reflection bool
+	// Interceptors is the flag to indicate whether the interceptor chains are declared in the service context.
+	Interceptors bool
+	// Drain is the flag to indicate whether the timeout of the graceful stop on SIGTERM is configured.
+	Drain bool
+	// Tests is the flag to indicate whether the tests of the rpcs and the testkit are generated.
+	Tests bool
+	// Cli is the flag to indicate whether the command line clients of the services are generated.
+	Cli bool
+}
+
+// serverOptions returns the server options as the template data
+func (c *ZRpcContext) serverOptions() map[string]interface{} {
+	return map[string]interface{}{
+		"health":       c.Health,
+		"reflection":   c.Reflection,
+		"interceptors": c.Interceptors,
+		"drain":        c.Drain,
+	}
+}
+
+// hasServerOptions reports whether any of the server options is enabled
+func (c *ZRpcContext) hasServerOptions() bool {
+	return c.Health || c.Reflection || c.Interceptors || c.Drain
 }
 
 // Generate generates a rpc service, through the proto file,
@@ -44,9 +79,12 @@ func (g *Generator) Generate(zctx *ZRpcContext) error {
 		return err
 	}
 
-	err = g.Prepare()
-	if err != nil {
-		return err
+	// protoc and the plugins are not required by the builtin compiler
+	if !zctx.Builtin {
+		err = g.Prepare()
+		if err != nil {
+			return err
+		}
 	}
 
 	projectCtx, err := ctx.Prepare(abs)
@@ -54,7 +92,7 @@ func (g *Generator) Generate(zctx *ZRpcContext) error {
 		return err
 	}
 
-	p := parser.NewDefaultProtoParser()
+	p := parser.NewDefaultProtoParser(zctx.ProtoPaths...)
 	proto, err := p.Parse(zctx.Src, zctx.Multiple)
 	if err != nil {
 		return err
@@ -65,22 +103,32 @@ func (g *Generator) Generate(zctx *ZRpcContext) error {
 		return err
 	}
 
-	err = g.GenEtc(dirCtx, proto, g.cfg)
+	err = g.GenEtc(dirCtx, proto, g.cfg, zctx)
+	if err != nil {
+		return err
+	}
+
+	err = g.GenPb(dirCtx, proto, zctx)
 	if err != nil {
 		return err
 	}
 
-	err = g.GenPb(dirCtx, zctx)
+	err = g.GenValidate(dirCtx, proto, g.cfg)
 	if err != nil {
 		return err
 	}
 
-	err = g.GenConfig(dirCtx, proto, g.cfg)
+	err = g.GenConfig(dirCtx, proto, g.cfg, zctx)
 	if err != nil {
 		return err
 	}
 
-	err = g.GenSvc(dirCtx, proto, g.cfg)
+	err = g.GenSvc(dirCtx, proto, g.cfg, zctx)
+	if err != nil {
+		return err
+	}
+
+	err = g.GenHealth(dirCtx, proto, g.cfg, zctx)
 	if err != nil {
 		return err
 	}
@@ -101,6 +149,16 @@ func (g *Generator) Generate(zctx *ZRpcContext) error {
 	}
 
 	err = g.GenCall(dirCtx, proto, g.cfg, zctx)
+	if err != nil {
+		return err
+	}
+
+	err = g.GenTests(dirCtx, proto, g.cfg, zctx)
+	if err != nil {
+		return err
+	}
+
+	err = g.GenCli(dirCtx, proto, g.cfg, zctx)
 
 	console.NewColorConsole().MarkDone()
 
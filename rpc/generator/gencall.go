@@ -63,12 +63,12 @@ func (g *Generator) genCallGroup(ctx DirContext, proto parser.Proto, cfg *conf.C
 		isCallPkgSameToPbPkg := childDir == ctx.GetProtoGo().Filename
 		isCallPkgSameToGrpcPkg := childDir == ctx.GetProtoGo().Filename
 
-		functions, err := g.genFunction(proto.PbPackage, service, isCallPkgSameToGrpcPkg)
+		functions, err := g.genFunction(ctx, proto, service, isCallPkgSameToGrpcPkg)
 		if err != nil {
 			return err
 		}
 
-		iFunctions, err := g.getInterfaceFuncs(proto.PbPackage, service, isCallPkgSameToGrpcPkg)
+		iFunctions, err := g.getInterfaceFuncs(ctx, proto, service, isCallPkgSameToGrpcPkg)
 		if err != nil {
 			return err
 		}
@@ -85,10 +85,16 @@ func (g *Generator) genCallGroup(ctx DirContext, proto parser.Proto, cfg *conf.C
 				alias.AddStr(fmt.Sprintf("%s = %s", parser.CamelCase(msgName),
 					fmt.Sprintf("%s.%s", proto.PbPackage, parser.CamelCase(msgName))))
 			}
+			// the messages of the imported files in the same go package
+			for _, item := range proto.Symbols {
+				if item.File != proto.Name && proto.SameGoPackage(item.GoPackage) {
+					alias.AddStr(fmt.Sprintf("%s = %s.%s", item.GoName, proto.PbPackage, item.GoName))
+				}
+			}
 		}
 
-		pbPackage := fmt.Sprintf(`"%s"`, ctx.GetPb().Package)
-		protoGoPackage := fmt.Sprintf(`"%s"`, ctx.GetProtoGo().Package)
+		pbPackage := quotePbImport(ctx, proto)
+		protoGoPackage := quoteImport(proto.PbPackage, ctx.GetProtoGo().Package)
 		if isCallPkgSameToGrpcPkg {
 			pbPackage = ""
 			protoGoPackage = ""
@@ -103,6 +109,7 @@ func (g *Generator) genCallGroup(ctx DirContext, proto parser.Proto, cfg *conf.C
 			"filePackage":    dir.Base,
 			"pbPackage":      pbPackage,
 			"protoGoPackage": protoGoPackage,
+			"imports":        strings.Join(callImports(ctx, proto, service), pathx.NL),
 			"serviceName":    stringx.From(service.Name).ToCamel(),
 			"functions":      strings.Join(functions, pathx.NL),
 			"interface":      strings.Join(iFunctions, pathx.NL),
@@ -127,12 +134,12 @@ func (g *Generator) genCallInCompatibility(ctx DirContext, proto parser.Proto,
 	}
 
 	filename := filepath.Join(dir.Filename, fmt.Sprintf("%s.go", callFilename))
-	functions, err := g.genFunction(proto.PbPackage, service, isCallPkgSameToGrpcPkg)
+	functions, err := g.genFunction(ctx, proto, service, isCallPkgSameToGrpcPkg)
 	if err != nil {
 		return err
 	}
 
-	iFunctions, err := g.getInterfaceFuncs(proto.PbPackage, service, isCallPkgSameToGrpcPkg)
+	iFunctions, err := g.getInterfaceFuncs(ctx, proto, service, isCallPkgSameToGrpcPkg)
 	if err != nil {
 		return err
 	}
@@ -149,10 +156,16 @@ func (g *Generator) genCallInCompatibility(ctx DirContext, proto parser.Proto,
 			alias.AddStr(fmt.Sprintf("%s = %s", parser.CamelCase(msgName),
 				fmt.Sprintf("%s.%s", proto.PbPackage, parser.CamelCase(msgName))))
 		}
+		// the messages of the imported files in the same go package
+		for _, item := range proto.Symbols {
+			if item.File != proto.Name && proto.SameGoPackage(item.GoPackage) {
+				alias.AddStr(fmt.Sprintf("%s = %s.%s", item.GoName, proto.PbPackage, item.GoName))
+			}
+		}
 	}
 
-	pbPackage := fmt.Sprintf(`"%s"`, ctx.GetPb().Package)
-	protoGoPackage := fmt.Sprintf(`"%s"`, ctx.GetProtoGo().Package)
+	pbPackage := quotePbImport(ctx, proto)
+	protoGoPackage := quoteImport(proto.PbPackage, ctx.GetProtoGo().Package)
 	if isCallPkgSameToGrpcPkg {
 		pbPackage = ""
 		protoGoPackage = ""
@@ -166,6 +179,7 @@ func (g *Generator) genCallInCompatibility(ctx DirContext, proto parser.Proto,
 		"filePackage":    dir.Base,
 		"pbPackage":      pbPackage,
 		"protoGoPackage": protoGoPackage,
+		"imports":        strings.Join(callImports(ctx, proto, service), pathx.NL),
 		"serviceName":    stringx.From(service.Name).ToCamel(),
 		"functions":      strings.Join(functions, pathx.NL),
 		"interface":      strings.Join(iFunctions, pathx.NL),
@@ -194,8 +208,9 @@ func getMessageName(msg proto.Message) string {
 	return strings.Join(list, "_")
 }
 
-func (g *Generator) genFunction(goPackage string, service parser.Service,
+func (g *Generator) genFunction(ctx DirContext, proto parser.Proto, service parser.Service,
 	isCallPkgSameToGrpcPkg bool) ([]string, error) {
+	goPackage := proto.PbPackage
 	functions := make([]string, 0)
 
 	for _, rpc := range service.RPC {
@@ -216,8 +231,8 @@ func (g *Generator) genFunction(goPackage string, service parser.Service,
 			"rpcServiceName":         parser.CamelCase(service.Name),
 			"method":                 parser.CamelCase(rpc.Name),
 			"package":                goPackage,
-			"pbRequest":              parser.CamelCase(rpc.RequestType),
-			"pbResponse":             parser.CamelCase(rpc.ReturnsType),
+			"pbRequest":              callType(ctx, proto, rpc.Request),
+			"pbResponse":             callType(ctx, proto, rpc.Returns),
 			"hasComment":             len(comment) > 0,
 			"comment":                comment,
 			"hasReq":                 !rpc.StreamsRequest,
@@ -235,8 +250,9 @@ func (g *Generator) genFunction(goPackage string, service parser.Service,
 	return functions, nil
 }
 
-func (g *Generator) getInterfaceFuncs(goPackage string, service parser.Service,
+func (g *Generator) getInterfaceFuncs(ctx DirContext, proto parser.Proto, service parser.Service,
 	isCallPkgSameToGrpcPkg bool) ([]string, error) {
+	goPackage := proto.PbPackage
 	functions := make([]string, 0)
 
 	for _, rpc := range service.RPC {
@@ -259,9 +275,9 @@ func (g *Generator) getInterfaceFuncs(goPackage string, service parser.Service,
 				"comment":    comment,
 				"method":     parser.CamelCase(rpc.Name),
 				"hasReq":     !rpc.StreamsRequest,
-				"pbRequest":  parser.CamelCase(rpc.RequestType),
+				"pbRequest":  callType(ctx, proto, rpc.Request),
 				"notStream":  !rpc.StreamsRequest && !rpc.StreamsReturns,
-				"pbResponse": parser.CamelCase(rpc.ReturnsType),
+				"pbResponse": callType(ctx, proto, rpc.Returns),
 				"streamBody": streamServer,
 			})
 		if err != nil {
@@ -273,3 +289,36 @@ func (g *Generator) getInterfaceFuncs(goPackage string, service parser.Service,
 
 	return functions, nil
 }
+
+// callType returns the go type of the message in the call package, the messages in the go
+// package of the proto file are aliased.
+func callType(ctx DirContext, proto parser.Proto, symbol parser.Symbol) string {
+	if proto.SameGoPackage(symbol.GoPackage) {
+		return symbol.GoName
+	}
+
+	goType, _ := pbType(ctx, proto, symbol)
+	return goType
+}
+
+// callImports returns the imports of the go packages which define the requests and the
+// responses out of the go package of the proto file.
+func callImports(ctx DirContext, proto parser.Proto, service parser.Service) []string {
+	imports := collection.NewSet()
+	for _, rpc := range service.RPC {
+		if !rpc.StreamsRequest {
+			if _, imp := pbType(ctx, proto, rpc.Request); len(imp) > 0 {
+				imports.AddStr(imp)
+			}
+		}
+		if !rpc.StreamsRequest && !rpc.StreamsReturns {
+			if _, imp := pbType(ctx, proto, rpc.Returns); len(imp) > 0 {
+				imports.AddStr(imp)
+			}
+		}
+	}
+
+	ret := imports.KeysStr()
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,135 @@
+package generator
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+	"github.com/zeromicro/go-zero/core/collection"
+)
+
+const (
+	cliDir = "cli"
+	// cliEndpoint is the default endpoint of the cli, it's the ListenOn of the generated etc
+	cliEndpoint = "127.0.0.1:8080"
+)
+
+var (
+	//go:embed cli.tpl
+	cliTemplate string
+	//go:embed cli-main.tpl
+	cliMainTemplate string
+)
+
+type cliRPCData struct {
+	Use            string
+	Short          string
+	Method         string
+	Request        string
+	StreamsReturns bool
+}
+
+// GenCli generates the command line client of each service in cli/<service>, which has a
+// subcommand of each rpc whose flags are the fields of the request, and the shared helpers
+// in internal/cli, they're generated with --cli and overwritten on each generation.
+func (g *Generator) GenCli(ctx DirContext, proto parser.Proto, cfg *conf.Config, c *ZRpcContext) error {
+	if !c.Cli {
+		return nil
+	}
+
+	dir := filepath.Join(ctx.GetInternal().Filename, cliDir)
+	if err := pathx.MkdirIfNotExist(dir); err != nil {
+		return err
+	}
+
+	text, err := pathx.LoadTemplate(category, cliTemplateFile, cliTemplate)
+	if err != nil {
+		return err
+	}
+
+	err = util.With("cli").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head": util.GetHead(proto.Name),
+	}, filepath.Join(dir, "cli.go"), true)
+	if err != nil {
+		return err
+	}
+
+	serviceName, err := format.FileNamingFormat(cfg.NamingFormat, ctx.GetServiceName().Source())
+	if err != nil {
+		return err
+	}
+
+	for _, service := range proto.Service {
+		if err := g.genCliMain(ctx, proto, serviceName, service); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) genCliMain(ctx DirContext, proto parser.Proto, serviceName string,
+	service parser.Service) error {
+	name := strings.ToLower(service.Name)
+	dir := filepath.Join(ctx.GetMain().Filename, cliDir, name)
+	if err := pathx.MkdirIfNotExist(dir); err != nil {
+		return err
+	}
+
+	imports := collection.NewSet()
+	imports.AddStr(quotePbImport(ctx, proto), fmt.Sprintf(`"%s/%s"`, ctx.GetInternal().Package, cliDir))
+	var rpcs []cliRPCData
+	var stream bool
+	for _, rpc := range service.RPC {
+		// the client streaming rpcs can't be called by a single request
+		if rpc.StreamsRequest {
+			continue
+		}
+
+		request, requestImport := pbType(ctx, proto, rpc.Request)
+		if len(requestImport) > 0 {
+			imports.AddStr(requestImport)
+		}
+
+		use, err := format.FileNamingFormat("go-zero", rpc.Name)
+		if err != nil {
+			return err
+		}
+
+		short := strings.TrimSpace(strings.TrimPrefix(parser.GetComment(rpc.Doc()), "//"))
+		if len(short) == 0 {
+			short = fmt.Sprintf("Call the %s rpc", rpc.Name)
+		}
+		stream = stream || rpc.StreamsReturns
+		rpcs = append(rpcs, cliRPCData{
+			Use:            use,
+			Short:          short,
+			Method:         parser.CamelCase(rpc.Name),
+			Request:        request,
+			StreamsReturns: rpc.StreamsReturns,
+		})
+	}
+
+	text, err := pathx.LoadTemplate(category, cliMainTemplateFile, cliMainTemplate)
+	if err != nil {
+		return err
+	}
+
+	return util.With("cli_main").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head":     util.GetHead(proto.Name),
+		"imports":  strings.Join(imports.KeysStr(), pathx.NL),
+		"name":     name,
+		"service":  parser.CamelCase(service.Name),
+		"pkg":      proto.PbPackage,
+		"key":      serviceName + ".rpc",
+		"endpoint": cliEndpoint,
+		"stream":   stream,
+		"rpcs":     rpcs,
+	}, filepath.Join(dir, "main.go"), true)
+}
@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/assert"
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+func TestGenCli(t *testing.T) {
+	dir, ctx := newTestDirContext(t)
+	p := parser.Proto{
+		Name:      "greet.proto",
+		PbPackage: "pb",
+		Service: parser.Services{{
+			Service: &proto.Service{Name: "Greet"},
+			RPC: []*parser.RPC{
+				{
+					RPC: &proto.RPC{Name: "SayHello", Comment: &proto.Comment{
+						Lines: []string{" SayHello greets the caller"},
+					}},
+					Request: parser.Symbol{GoName: "Req"},
+					Returns: parser.Symbol{GoName: "Resp"},
+				},
+				{
+					RPC:     &proto.RPC{Name: "Watch", StreamsReturns: true},
+					Request: parser.Symbol{GoName: "Req"},
+					Returns: parser.Symbol{GoName: "Resp"},
+				},
+				{
+					RPC:     &proto.RPC{Name: "Upload", StreamsRequest: true},
+					Request: parser.Symbol{GoName: "Req"},
+					Returns: parser.Symbol{GoName: "Resp"},
+				},
+			},
+		}},
+	}
+
+	g := NewGenerator("gozero", false)
+	cfg := &conf.Config{NamingFormat: "gozero"}
+	assert.Nil(t, g.GenCli(ctx, p, cfg, &ZRpcContext{}))
+	assert.NoDirExists(t, filepath.Join(dir, "cli"))
+
+	assert.Nil(t, g.GenCli(ctx, p, cfg, &ZRpcContext{Cli: true}))
+	assert.FileExists(t, filepath.Join(dir, "internal", "cli", "cli.go"))
+
+	data, err := os.ReadFile(filepath.Join(dir, "cli", "greet", "main.go"))
+	assert.Nil(t, err)
+	text := string(data)
+	assert.Contains(t, text, `cli.Command(target, "say-hello", "SayHello greets the caller", new(pb.Req),`)
+	assert.Contains(t, text, "resp, err := pb.NewGreetClient(conn).SayHello(ctx, in.(*pb.Req))")
+	assert.Contains(t, text, `cli.StreamCommand(target, "watch", "Call the Watch rpc", new(pb.Req),`)
+	assert.Contains(t, text, "stream, err := pb.NewGreetClient(conn).Watch(ctx, in.(*pb.Req))")
+	// the client streaming rpc is skipped
+	assert.NotContains(t, text, "Upload")
+}
@@ -2,12 +2,14 @@ package generator
 
 import (
 	_ "embed"
+	"fmt"
+	"path/filepath"
+
 	conf "github.com/yeyudekuangxiang/goctl/config"
 	"github.com/yeyudekuangxiang/goctl/rpc/parser"
 	"github.com/yeyudekuangxiang/goctl/util"
 	"github.com/yeyudekuangxiang/goctl/util/format"
 	"github.com/yeyudekuangxiang/goctl/util/pathx"
-	"path/filepath"
 )
 
 //go:embed config.tpl
@@ -17,7 +19,7 @@ var configTemplate string
 // which contains the zrpc.RpcServerConf configuration item by default.
 // You can specify the naming style of the target file name through config.Config. For details,
 // see https://github.com/zeromicro/go-zero/tree/master/tools/goctl/config/config.go
-func (g *Generator) GenConfig(ctx DirContext, _ parser.Proto, cfg *conf.Config) error {
+func (g *Generator) GenConfig(ctx DirContext, _ parser.Proto, cfg *conf.Config, c *ZRpcContext) error {
 	dir := ctx.GetConfig()
 	configFilename, err := format.FileNamingFormat(cfg.NamingFormat, "config")
 	if err != nil {
@@ -34,5 +36,11 @@ func (g *Generator) GenConfig(ctx DirContext, _ parser.Proto, cfg *conf.Config)
 		return err
 	}
 
-	return util.With("config").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{}, fileName, false)
+	imports := `"github.com/zeromicro/go-zero/zrpc"`
+	if c.Health || c.Drain {
+		imports = fmt.Sprintf("(\n\"time\"\n\n%s\n)", imports)
+	}
+	data := c.serverOptions()
+	data["imports"] = imports
+	return util.With("config").GoFmt(true).Parse(text).SaveTo(data, fileName, false)
 }
@@ -19,7 +19,7 @@ var etcTemplate string
 
 // GenEtc generates the yaml configuration file of the rpc service,
 // including host, port monitoring configuration items and etcd configuration
-func (g *Generator) GenEtc(ctx DirContext, _ parser.Proto, cfg *conf.Config) error {
+func (g *Generator) GenEtc(ctx DirContext, _ parser.Proto, cfg *conf.Config, c *ZRpcContext) error {
 	dir := ctx.GetEtc()
 	etcFilename, err := format.FileNamingFormat(cfg.NamingFormat, ctx.GetServiceName().Source())
 	if err != nil {
@@ -33,7 +33,7 @@ func (g *Generator) GenEtc(ctx DirContext, _ parser.Proto, cfg *conf.Config) err
 		return err
 	}
 
-	return util.With("etc").Parse(text).SaveTo(map[string]interface{}{
-		"serviceName": strings.ToLower(stringx.From(ctx.GetServiceName().Source()).ToCamel()),
-	}, fileName, false)
+	data := c.serverOptions()
+	data["serviceName"] = strings.ToLower(stringx.From(ctx.GetServiceName().Source()).ToCamel())
+	return util.With("etc").Parse(text).SaveTo(data, fileName, false)
 }
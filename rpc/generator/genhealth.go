@@ -0,0 +1,46 @@
+package generator
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+const healthDir = "health"
+
+//go:embed health.tpl
+var healthTemplate string
+
+// GenHealth generates the health server whose status follows the readiness of the service context,
+// it's generated with --health.
+func (g *Generator) GenHealth(ctx DirContext, proto parser.Proto, cfg *conf.Config, c *ZRpcContext) error {
+	if !c.Health {
+		return nil
+	}
+
+	dir := filepath.Join(ctx.GetInternal().Filename, healthDir)
+	if err := pathx.MkdirIfNotExist(dir); err != nil {
+		return err
+	}
+
+	healthFilename, err := format.FileNamingFormat(cfg.NamingFormat, "health")
+	if err != nil {
+		return err
+	}
+
+	text, err := pathx.LoadTemplate(category, healthTemplateFile, healthTemplate)
+	if err != nil {
+		return err
+	}
+
+	return util.With("health").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head":    util.GetHead(proto.Name),
+		"imports": fmt.Sprintf(`"%v"`, ctx.GetSvc().Package),
+	}, filepath.Join(dir, healthFilename+".go"), true)
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/assert"
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util/stringx"
+)
+
+func TestGenServerOptions(t *testing.T) {
+	dir, ctx := newTestDirContext(t)
+	p := parser.Proto{
+		Name:      "greet.proto",
+		PbPackage: "pb",
+		Service:   parser.Services{{Service: &proto.Service{Name: "Greet"}}},
+	}
+
+	g := NewGenerator("gozero", false)
+	cfg := &conf.Config{NamingFormat: "gozero"}
+	c := &ZRpcContext{Health: true, Reflection: true, Interceptors: true, Drain: true}
+	assert.Nil(t, g.GenEtc(ctx, p, cfg, c))
+	assert.Nil(t, g.GenConfig(ctx, p, cfg, c))
+	assert.Nil(t, g.GenSvc(ctx, p, cfg, c))
+	assert.Nil(t, g.GenHealth(ctx, p, cfg, c))
+	assert.Nil(t, g.GenMain(ctx, p, cfg, c))
+
+	assertContains := func(filename string, subs ...string) {
+		data, err := os.ReadFile(filepath.Join(dir, filename))
+		assert.Nil(t, err)
+		for _, sub := range subs {
+			assert.Contains(t, string(data), sub)
+		}
+	}
+	assertContains("etc/greet.yaml", "HealthInterval: 5s", "DrainPeriod: 10s")
+	assertContains("internal/config/config.go", "Reflection bool", "DrainPeriod time.Duration")
+	assertContains("internal/svc/servicecontext.go", "UnaryInterceptors  []grpc.UnaryServerInterceptor",
+		"func (s *ServiceContext) Ready(service string) error")
+	assertContains("internal/health/health.go", "func (s *Server) UnaryInterceptor(")
+	assertContains("greet.go", "health.NewServer(ctx, c.HealthInterval,",
+		"pb.Greet_ServiceDesc.ServiceName", "s.AddUnaryInterceptors(ctx.UnaryInterceptors...)",
+		"proc.SetTimeToForceQuit(c.DrainPeriod)", "if c.Reflection || c.Mode == service.DevMode")
+	assertContains("greet_test.go", "bufconn.Listen(", "grpc_reflection_v1alpha")
+}
+
+// newTestDirContext returns the context of the directories of a project in a temporary directory
+func newTestDirContext(t *testing.T) (string, *defaultDirContext) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/demo"), 0o644))
+	dirs := map[string]Dir{
+		wd:       {Filename: dir, Package: "example.com/demo"},
+		etc:      {Filename: filepath.Join(dir, "etc")},
+		internal: {Filename: filepath.Join(dir, "internal"), Package: "example.com/demo/internal"},
+		config:   {Filename: filepath.Join(dir, "internal", "config"), Package: "example.com/demo/internal/config"},
+		logic:    {Filename: filepath.Join(dir, "internal", "logic"), Package: "example.com/demo/internal/logic"},
+		server:   {Filename: filepath.Join(dir, "internal", "server"), Package: "example.com/demo/internal/server"},
+		svc:      {Filename: filepath.Join(dir, "internal", "svc"), Package: "example.com/demo/internal/svc"},
+		pb:       {Filename: filepath.Join(dir, "pb"), Package: "example.com/demo/pb"},
+		call:     {Filename: filepath.Join(dir, "greetclient"), Package: "example.com/demo/greetclient", Base: "greetclient"},
+	}
+	for _, each := range dirs {
+		assert.Nil(t, os.MkdirAll(each.Filename, os.ModePerm))
+	}
+	return dir, &defaultDirContext{inner: dirs, serviceName: stringx.From("greet")}
+}
@@ -48,14 +48,14 @@ func (g *Generator) genLogicInCompatibility(ctx DirContext, proto parser.Proto,
 		}
 
 		filename := filepath.Join(dir.Filename, logicFilename+".go")
-		functions, err := g.genLogicFunction(service, proto.PbPackage, logicName, rpc)
+		functions, pbImports, err := g.genLogicFunction(ctx, proto, service, logicName, rpc)
 		if err != nil {
 			return err
 		}
 
 		imports := collection.NewSet()
 		imports.AddStr(fmt.Sprintf(`"%v"`, ctx.GetSvc().Package))
-		imports.AddStr(fmt.Sprintf(`"%v"`, ctx.GetPb().Package))
+		imports.AddStr(pbImports...)
 		text, err := pathx.LoadTemplate(category, logicTemplateFileFile, logicTemplate)
 		if err != nil {
 			return err
@@ -101,14 +101,14 @@ func (g *Generator) genLogicGroup(ctx DirContext, proto parser.Proto, cfg *conf.
 			}
 
 			filename = filepath.Join(dir.Filename, serviceDir, logicFilename+".go")
-			functions, err := g.genLogicFunction(serviceName, proto.PbPackage, logicName, rpc)
+			functions, pbImports, err := g.genLogicFunction(ctx, proto, serviceName, logicName, rpc)
 			if err != nil {
 				return err
 			}
 
 			imports := collection.NewSet()
 			imports.AddStr(fmt.Sprintf(`"%v"`, ctx.GetSvc().Package))
-			imports.AddStr(fmt.Sprintf(`"%v"`, ctx.GetPb().Package))
+			imports.AddStr(pbImports...)
 			text, err := pathx.LoadTemplate(category, logicTemplateFileFile, logicTemplate)
 			if err != nil {
 				return err
@@ -127,13 +127,36 @@ func (g *Generator) genLogicGroup(ctx DirContext, proto parser.Proto, cfg *conf.
 	return nil
 }
 
-func (g *Generator) genLogicFunction(serviceName, goPackage, logicName string,
-	rpc *parser.RPC) (string,
-	error) {
+// genLogicFunction returns the function of the rpc and the imports of the go packages which
+// define the request and the response.
+func (g *Generator) genLogicFunction(ctx DirContext, proto parser.Proto, serviceName, logicName string,
+	rpc *parser.RPC) (string, []string, error) {
 	functions := make([]string, 0)
 	text, err := pathx.LoadTemplate(category, logicFuncTemplateFileFile, logicFunctionTemplate)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	goPackage := proto.PbPackage
+	request, requestImport := pbType(ctx, proto, rpc.Request)
+	response, responseImport := pbType(ctx, proto, rpc.Returns)
+	stream := rpc.StreamsRequest || rpc.StreamsReturns
+	pbImport := quotePbImport(ctx, proto)
+	imports := collection.NewSet()
+	addImport := func(imp string) {
+		if len(imp) == 0 {
+			imp = pbImport
+		}
+		imports.AddStr(imp)
+	}
+	// the request is not used if it's streamed, the stream server is in the pb package
+	if !rpc.StreamsRequest {
+		addImport(requestImport)
+	}
+	if stream {
+		imports.AddStr(pbImport)
+	} else {
+		addImport(responseImport)
 	}
 
 	comment := parser.GetComment(rpc.Doc())
@@ -143,19 +166,19 @@ func (g *Generator) genLogicFunction(serviceName, goPackage, logicName string,
 		"logicName":    logicName,
 		"method":       parser.CamelCase(rpc.Name),
 		"hasReq":       !rpc.StreamsRequest,
-		"request":      fmt.Sprintf("*%s.%s", goPackage, parser.CamelCase(rpc.RequestType)),
+		"request":      "*" + request,
 		"hasReply":     !rpc.StreamsRequest && !rpc.StreamsReturns,
-		"response":     fmt.Sprintf("*%s.%s", goPackage, parser.CamelCase(rpc.ReturnsType)),
-		"responseType": fmt.Sprintf("%s.%s", goPackage, parser.CamelCase(rpc.ReturnsType)),
-		"stream":       rpc.StreamsRequest || rpc.StreamsReturns,
+		"response":     "*" + response,
+		"responseType": response,
+		"stream":       stream,
 		"streamBody":   streamServer,
 		"hasComment":   len(comment) > 0,
 		"comment":      comment,
 	})
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	functions = append(functions, buffer.String())
-	return strings.Join(functions, pathx.NL), nil
+	return strings.Join(functions, pathx.NL), imports.KeysStr(), nil
 }
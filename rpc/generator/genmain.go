@@ -13,8 +13,12 @@ import (
 	"github.com/yeyudekuangxiang/goctl/util/pathx"
 )
 
-//go:embed main.tpl
-var mainTemplate string
+var (
+	//go:embed main.tpl
+	mainTemplate string
+	//go:embed main-test.tpl
+	mainTestTemplate string
+)
 
 type MainServiceTemplateData struct {
 	Service   string
@@ -22,7 +26,8 @@ type MainServiceTemplateData struct {
 	Pkg       string
 }
 
-// GenMain generates the main file of the rpc service, which is an rpc service program call entry
+// GenMain generates the main file of the rpc service, which is an rpc service program call entry,
+// the test which starts the server on a bufconn listener is generated with the server options.
 func (g *Generator) GenMain(ctx DirContext, proto parser.Proto, cfg *conf.Config,
 	c *ZRpcContext) error {
 	mainFilename, err := format.FileNamingFormat(cfg.NamingFormat, ctx.GetServiceName().Source())
@@ -32,29 +37,25 @@ func (g *Generator) GenMain(ctx DirContext, proto parser.Proto, cfg *conf.Config
 
 	fileName := filepath.Join(ctx.GetMain().Filename, fmt.Sprintf("%v.go", mainFilename))
 	imports := make([]string, 0)
-	pbImport := fmt.Sprintf(`"%v"`, ctx.GetPb().Package)
+	pbImport := quotePbImport(ctx, proto)
 	svcImport := fmt.Sprintf(`"%v"`, ctx.GetSvc().Package)
 	configImport := fmt.Sprintf(`"%v"`, ctx.GetConfig().Package)
 	imports = append(imports, configImport, pbImport, svcImport)
+	validate := proto.HasValidation()
+	if validate {
+		imports = append(imports, fmt.Sprintf(`"%s/%s"`, ctx.GetInternal().Package, interceptorDir))
+	}
+	if c.Health {
+		imports = append(imports, fmt.Sprintf(`"%s/%s"`, ctx.GetInternal().Package, healthDir))
+	}
 
 	var serviceNames []MainServiceTemplateData
 	for _, e := range proto.Service {
-		var (
-			remoteImport string
-			serverPkg    string
-		)
-		if !c.Multiple {
-			serverPkg = "server"
-			remoteImport = fmt.Sprintf(`"%v"`, ctx.GetServer().Package)
-		} else {
-			childPkg, err := ctx.GetServer().GetChildPackage(e.Name)
-			if err != nil {
-				return err
-			}
-
-			serverPkg = filepath.Base(childPkg + "Server")
-			remoteImport = fmt.Sprintf(`%s "%v"`, serverPkg, childPkg)
+		serverPkg, remoteImport, err := serverPackage(ctx, c, e.Name)
+		if err != nil {
+			return err
 		}
+
 		imports = append(imports, remoteImport)
 		serviceNames = append(serviceNames, MainServiceTemplateData{
 			Service:   parser.CamelCase(e.Name),
@@ -73,10 +74,22 @@ func (g *Generator) GenMain(ctx DirContext, proto parser.Proto, cfg *conf.Config
 		return err
 	}
 
-	return util.With("main").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
-		"serviceName":  etcFileName,
-		"imports":      strings.Join(imports, pathx.NL),
-		"pkg":          proto.PbPackage,
-		"serviceNames": serviceNames,
-	}, fileName, false)
+	data := c.serverOptions()
+	data["serviceName"] = etcFileName
+	data["imports"] = strings.Join(imports, pathx.NL)
+	data["pkg"] = proto.PbPackage
+	data["serviceNames"] = serviceNames
+	data["validate"] = validate
+	err = util.With("main").GoFmt(true).Parse(text).SaveTo(data, fileName, false)
+	if err != nil || !c.hasServerOptions() {
+		return err
+	}
+
+	text, err = pathx.LoadTemplate(category, mainTestTemplateFile, mainTestTemplate)
+	if err != nil {
+		return err
+	}
+
+	testFileName := filepath.Join(ctx.GetMain().Filename, fmt.Sprintf("%v_test.go", mainFilename))
+	return util.With("main_test").GoFmt(true).Parse(text).SaveTo(data, testFileName, false)
 }
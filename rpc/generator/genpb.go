@@ -7,23 +7,48 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/yeyudekuangxiang/goctl/pkg/protocompile"
 	"github.com/yeyudekuangxiang/goctl/rpc/execx"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
 )
 
 // GenPb generates the pb.go file, which is a layer of packaging for protoc to generate gprc,
-// but the commands and flags in protoc are not completely joined in goctl. At present, proto_path(-I) is introduced
-func (g *Generator) GenPb(ctx DirContext, c *ZRpcContext) error {
-	return g.genPbDirect(ctx, c)
+// but the commands and flags in protoc are not completely joined in goctl. At present, proto_path(-I) is introduced.
+// The imported files in the same go package or with the relative go_package are generated too.
+func (g *Generator) GenPb(ctx DirContext, proto parser.Proto, c *ZRpcContext) error {
+	files := pbFiles(proto)
+	if c.Builtin {
+		return g.genPbBuiltin(ctx, c, files)
+	}
+	return g.genPbDirect(ctx, c, files)
+}
+
+// genPbBuiltin compiles the proto file in process, the output is the same as protoc with
+// protoc-gen-go and protoc-gen-go-grpc.
+func (g *Generator) genPbBuiltin(ctx DirContext, c *ZRpcContext, files []string) error {
+	g.log.Debug("[builtin-protoc]: %s", c.ProtocCmd)
+	compiler := protocompile.Compiler{ImportPaths: c.ProtoPaths}
+	err := compiler.Generate(protocompile.GenerateOptions{
+		GoOut:    c.GoOutput,
+		GoOpts:   c.GoOpts,
+		GrpcOut:  c.GrpcOutput,
+		GrpcOpts: c.GrpcOpts,
+	}, append([]string{c.Src}, files...)...)
+	if err != nil {
+		return err
+	}
+	return g.setPbDir(ctx, c)
 }
 
-func (g *Generator) genPbDirect(ctx DirContext, c *ZRpcContext) error {
-	g.log.Debug("[command]: %s", c.ProtocCmd)
+func (g *Generator) genPbDirect(ctx DirContext, c *ZRpcContext, files []string) error {
+	cmd := strings.Join(append([]string{c.ProtocCmd}, files...), " ")
+	g.log.Debug("[command]: %s", cmd)
 	pwd, err := os.Getwd()
 	if err != nil {
 		return err
 	}
 
-	_, err = execx.Run(c.ProtocCmd, pwd)
+	_, err = execx.Run(cmd, pwd)
 	if err != nil {
 		return err
 	}
@@ -31,14 +56,14 @@ func (g *Generator) genPbDirect(ctx DirContext, c *ZRpcContext) error {
 }
 
 func (g *Generator) setPbDir(ctx DirContext, c *ZRpcContext) error {
-	pbDir, err := findPbFile(c.GoOutput, false)
+	pbDir, err := findPbFile(c.GoOutput, false, c.Src)
 	if err != nil {
 		return err
 	}
 	if len(pbDir) == 0 {
 		return fmt.Errorf("pg.go is not found under %q", c.GoOutput)
 	}
-	grpcDir, err := findPbFile(c.GrpcOutput, true)
+	grpcDir, err := findPbFile(c.GrpcOutput, true, c.Src)
 	if err != nil {
 		return err
 	}
@@ -62,27 +87,47 @@ const (
 	grpcSuffix = "_grpc.pb.go"
 )
 
-func findPbFile(current string, grpc bool) (string, error) {
+// findPbFile returns the directory of the pb.go or the _grpc.pb.go file, the file generated
+// from the proto file is preferred if there are more than one.
+func findPbFile(current string, grpc bool, protoFile ...string) (string, error) {
+	var name string
+	for _, each := range protoFile {
+		name = strings.TrimSuffix(filepath.Base(each), filepath.Ext(each))
+		if grpc {
+			name += grpcSuffix
+		} else {
+			name += "." + pbSuffix
+		}
+	}
+
 	fileSystem := os.DirFS(current)
 	var ret string
 	err := fs.WalkDir(fileSystem, ".", func(path string, d fs.DirEntry, err error) error {
 		if d.IsDir() {
 			return nil
 		}
+		if len(name) > 0 && filepath.Base(path) == name {
+			ret = path
+			return os.ErrExist
+		}
+		if len(ret) > 0 {
+			return nil
+		}
 		if strings.HasSuffix(path, pbSuffix) {
 			if grpc {
 				if strings.HasSuffix(path, grpcSuffix) {
 					ret = path
-					return os.ErrExist
 				}
 			} else if !strings.HasSuffix(path, grpcSuffix) {
 				ret = path
+			}
+			if len(ret) > 0 && len(name) == 0 {
 				return os.ErrExist
 			}
 		}
 		return nil
 	})
-	if err == os.ErrExist {
+	if err == os.ErrExist || err == nil && len(ret) > 0 {
 		return filepath.Dir(filepath.Join(current, ret)), nil
 	}
 	return "", err
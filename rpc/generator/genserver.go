@@ -64,17 +64,18 @@ func (g *Generator) genServerGroup(ctx DirContext, proto parser.Proto, cfg *conf
 		serverFile = filepath.Join(dir.Filename, serverDir, serverFilename+".go")
 
 		svcImport := fmt.Sprintf(`"%v"`, ctx.GetSvc().Package)
-		pbImport := fmt.Sprintf(`"%v"`, ctx.GetPb().Package)
+		pbImport := quotePbImport(ctx, proto)
 
 		imports := collection.NewSet()
 		imports.AddStr(logicImport, svcImport, pbImport)
 
 		head := util.GetHead(proto.Name)
 
-		funcList, err := g.genFunctions(proto.PbPackage, service, true)
+		funcList, pbImports, err := g.genFunctions(ctx, proto, service, true)
 		if err != nil {
 			return err
 		}
+		imports.AddStr(pbImports...)
 
 		text, err := pathx.LoadTemplate(category, serverTemplateFile, serverTemplate)
 		if err != nil {
@@ -109,7 +110,7 @@ func (g *Generator) genServerInCompatibility(ctx DirContext, proto parser.Proto,
 	dir := ctx.GetServer()
 	logicImport := fmt.Sprintf(`"%v"`, ctx.GetLogic().Package)
 	svcImport := fmt.Sprintf(`"%v"`, ctx.GetSvc().Package)
-	pbImport := fmt.Sprintf(`"%v"`, ctx.GetPb().Package)
+	pbImport := quotePbImport(ctx, proto)
 
 	imports := collection.NewSet()
 	imports.AddStr(logicImport, svcImport, pbImport)
@@ -122,10 +123,11 @@ func (g *Generator) genServerInCompatibility(ctx DirContext, proto parser.Proto,
 	}
 
 	serverFile := filepath.Join(dir.Filename, serverFilename+".go")
-	funcList, err := g.genFunctions(proto.PbPackage, service, false)
+	funcList, pbImports, err := g.genFunctions(ctx, proto, service, false)
 	if err != nil {
 		return err
 	}
+	imports.AddStr(pbImports...)
 
 	text, err := pathx.LoadTemplate(category, serverTemplateFile, serverTemplate)
 	if err != nil {
@@ -151,15 +153,29 @@ func (g *Generator) genServerInCompatibility(ctx DirContext, proto parser.Proto,
 	}, serverFile, true)
 }
 
-func (g *Generator) genFunctions(goPackage string, service parser.Service, multiple bool) ([]string, error) {
+// genFunctions returns the functions of the service and the imports of the go packages which
+// define the requests and the responses out of the pb package.
+func (g *Generator) genFunctions(ctx DirContext, proto parser.Proto, service parser.Service,
+	multiple bool) ([]string, []string, error) {
 	var (
 		functionList []string
 		logicPkg     string
+		imports      []string
 	)
+	goPackage := proto.PbPackage
 	for _, rpc := range service.RPC {
 		text, err := pathx.LoadTemplate(category, serverFuncTemplateFile, functionTemplate)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		request, requestImport := pbType(ctx, proto, rpc.Request)
+		response, responseImport := pbType(ctx, proto, rpc.Returns)
+		if len(requestImport) > 0 && !rpc.StreamsRequest {
+			imports = append(imports, requestImport)
+		}
+		if len(responseImport) > 0 && !rpc.StreamsRequest && !rpc.StreamsReturns {
+			imports = append(imports, responseImport)
 		}
 
 		var logicName string
@@ -179,8 +195,8 @@ func (g *Generator) genFunctions(goPackage string, service parser.Service, multi
 			"server":     stringx.From(service.Name).ToCamel(),
 			"logicName":  logicName,
 			"method":     parser.CamelCase(rpc.Name),
-			"request":    fmt.Sprintf("*%s.%s", goPackage, parser.CamelCase(rpc.RequestType)),
-			"response":   fmt.Sprintf("*%s.%s", goPackage, parser.CamelCase(rpc.ReturnsType)),
+			"request":    "*" + request,
+			"response":   "*" + response,
 			"hasComment": len(comment) > 0,
 			"comment":    comment,
 			"hasReq":     !rpc.StreamsRequest,
@@ -190,10 +206,10 @@ func (g *Generator) genFunctions(goPackage string, service parser.Service, multi
 			"logicPkg":   logicPkg,
 		})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		functionList = append(functionList, buffer.String())
 	}
-	return functionList, nil
+	return functionList, imports, nil
 }
@@ -17,7 +17,7 @@ var svcTemplate string
 
 // GenSvc generates the servicecontext.go file, which is the resource dependency of a service,
 // such as rpc dependency, model dependency, etc.
-func (g *Generator) GenSvc(ctx DirContext, _ parser.Proto, cfg *conf.Config) error {
+func (g *Generator) GenSvc(ctx DirContext, _ parser.Proto, cfg *conf.Config, c *ZRpcContext) error {
 	dir := ctx.GetSvc()
 	svcFilename, err := format.FileNamingFormat(cfg.NamingFormat, "service_context")
 	if err != nil {
@@ -30,7 +30,11 @@ func (g *Generator) GenSvc(ctx DirContext, _ parser.Proto, cfg *conf.Config) err
 		return err
 	}
 
-	return util.With("svc").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
-		"imports": fmt.Sprintf(`"%v"`, ctx.GetConfig().Package),
-	}, fileName, false)
+	imports := fmt.Sprintf(`"%v"`, ctx.GetConfig().Package)
+	if c.Interceptors {
+		imports = fmt.Sprintf("(\n%s\n\n\"google.golang.org/grpc\"\n)", imports)
+	}
+	data := c.serverOptions()
+	data["imports"] = imports
+	return util.With("svc").GoFmt(true).Parse(text).SaveTo(data, fileName, false)
 }
@@ -0,0 +1,210 @@
+package generator
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+	"github.com/yeyudekuangxiang/goctl/util/stringx"
+	"github.com/zeromicro/go-zero/core/collection"
+)
+
+const testkitDir = "testkit"
+
+var (
+	//go:embed testkit.tpl
+	testkitTemplate string
+	//go:embed logic-test.tpl
+	logicTestTemplate string
+)
+
+type (
+	testkitServiceData struct {
+		MainServiceTemplateData
+		// CallPkg is the name of the client package generated by GenCall
+		CallPkg string
+	}
+
+	logicTestData struct {
+		Service        string
+		Method         string
+		Request        string
+		Response       string
+		StreamsRequest bool
+		StreamsReturns bool
+		// Validated reports whether the request has validation rules, the empty request may be invalid
+		Validated bool
+	}
+)
+
+// GenTests generates the testkit which starts the server over bufconn and returns the clients
+// generated by GenCall, and the table-driven test of each rpc which calls it by the client,
+// they're generated with --tests.
+func (g *Generator) GenTests(ctx DirContext, proto parser.Proto, cfg *conf.Config, c *ZRpcContext) error {
+	if !c.Tests {
+		return nil
+	}
+
+	if err := g.genTestkit(ctx, proto, c); err != nil {
+		return err
+	}
+
+	for _, service := range proto.Service {
+		for _, rpc := range service.RPC {
+			if err := g.genLogicTest(ctx, proto, cfg, c, service, rpc); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) genTestkit(ctx DirContext, proto parser.Proto, c *ZRpcContext) error {
+	dir := filepath.Join(ctx.GetInternal().Filename, testkitDir)
+	if err := pathx.MkdirIfNotExist(dir); err != nil {
+		return err
+	}
+
+	imports := collection.NewSet()
+	imports.AddStr(quotePbImport(ctx, proto), fmt.Sprintf(`"%v"`, ctx.GetSvc().Package))
+	if proto.HasValidation() {
+		imports.AddStr(fmt.Sprintf(`"%s/%s"`, ctx.GetInternal().Package, interceptorDir))
+	}
+
+	var services []testkitServiceData
+	for _, e := range proto.Service {
+		serverPkg, serverImport, err := serverPackage(ctx, c, e.Name)
+		if err != nil {
+			return err
+		}
+
+		callPkg := ctx.GetCall().Base
+		callImport := fmt.Sprintf(`"%v"`, ctx.GetCall().Package)
+		if c.Multiple {
+			childPkg, err := ctx.GetCall().GetChildPackage(e.Name)
+			if err != nil {
+				return err
+			}
+
+			callPkg = filepath.Base(childPkg + "Client")
+			callImport = fmt.Sprintf(`%s "%v"`, callPkg, childPkg)
+		}
+		imports.AddStr(serverImport, callImport)
+		services = append(services, testkitServiceData{
+			MainServiceTemplateData: MainServiceTemplateData{
+				Service:   parser.CamelCase(e.Name),
+				ServerPkg: serverPkg,
+				Pkg:       proto.PbPackage,
+			},
+			CallPkg: callPkg,
+		})
+	}
+
+	text, err := pathx.LoadTemplate(category, testkitTemplateFile, testkitTemplate)
+	if err != nil {
+		return err
+	}
+
+	return util.With("testkit").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head":         util.GetHead(proto.Name),
+		"imports":      strings.Join(imports.KeysStr(), pathx.NL),
+		"services":     services,
+		"validate":     proto.HasValidation(),
+		"interceptors": c.Interceptors,
+	}, filepath.Join(dir, "testkit.go"), true)
+}
+
+func (g *Generator) genLogicTest(ctx DirContext, proto parser.Proto, cfg *conf.Config, c *ZRpcContext,
+	service parser.Service, rpc *parser.RPC) error {
+	dir := ctx.GetLogic()
+	logicFilename, err := format.FileNamingFormat(cfg.NamingFormat, rpc.Name+"_logic")
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(dir.Filename, logicFilename+"_test.go")
+	packageName := "logic"
+	if c.Multiple {
+		childPkg, err := dir.GetChildPackage(service.Name)
+		if err != nil {
+			return err
+		}
+
+		filename = filepath.Join(dir.Filename, filepath.Base(childPkg), logicFilename+"_test.go")
+		packageName = strings.ToLower(stringx.From(service.Name + "_logic").ToCamel())
+	}
+
+	request, requestImport := pbType(ctx, proto, rpc.Request)
+	response, responseImport := pbType(ctx, proto, rpc.Returns)
+	imports := collection.NewSet()
+	for _, each := range []string{requestImport, responseImport} {
+		if len(each) == 0 {
+			each = quotePbImport(ctx, proto)
+		}
+		imports.AddStr(each)
+	}
+	imports.AddStr(fmt.Sprintf(`"%v"`, ctx.GetConfig().Package),
+		fmt.Sprintf(`"%v"`, ctx.GetSvc().Package),
+		fmt.Sprintf(`"%s/%s"`, ctx.GetInternal().Package, testkitDir))
+
+	text, err := pathx.LoadTemplate(category, logicTestTemplateFile, logicTestTemplate)
+	if err != nil {
+		return err
+	}
+
+	return util.With("logic_test").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"packageName": packageName,
+		"imports":     strings.Join(imports.KeysStr(), pathx.NL),
+		"stream":      rpc.StreamsRequest || rpc.StreamsReturns,
+		"data": logicTestData{
+			Service:        parser.CamelCase(service.Name),
+			Method:         parser.CamelCase(rpc.Name),
+			Request:        request,
+			Response:       response,
+			StreamsRequest: rpc.StreamsRequest,
+			StreamsReturns: rpc.StreamsReturns,
+			Validated:      hasRules(proto, rpc.Request),
+		},
+	}, filename, false)
+}
+
+// serverPackage returns the name and the import of the server package of the service
+func serverPackage(ctx DirContext, c *ZRpcContext, service string) (string, string, error) {
+	if !c.Multiple {
+		return "server", fmt.Sprintf(`"%v"`, ctx.GetServer().Package), nil
+	}
+
+	childPkg, err := ctx.GetServer().GetChildPackage(service)
+	if err != nil {
+		return "", "", err
+	}
+
+	serverPkg := filepath.Base(childPkg + "Server")
+	return serverPkg, fmt.Sprintf(`%s "%v"`, serverPkg, childPkg), nil
+}
+
+// hasRules reports whether the message has validation rules in the proto file
+func hasRules(proto parser.Proto, symbol parser.Symbol) bool {
+	if !proto.SameGoPackage(symbol.GoPackage) {
+		return false
+	}
+
+	for _, message := range proto.Validation {
+		if message.GoName != symbol.GoName || message.Disabled {
+			continue
+		}
+		for _, field := range message.Fields {
+			if len(field.Rules) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
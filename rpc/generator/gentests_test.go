@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/assert"
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+func TestGenTests(t *testing.T) {
+	dir, ctx := newTestDirContext(t)
+	p := parser.Proto{
+		Name:      "greet.proto",
+		PbPackage: "pb",
+		Service: parser.Services{{
+			Service: &proto.Service{Name: "Greet"},
+			RPC: []*parser.RPC{
+				{
+					RPC:     &proto.RPC{Name: "Ping"},
+					Request: parser.Symbol{GoName: "Req"},
+					Returns: parser.Symbol{GoName: "Resp"},
+				},
+				{
+					RPC:     &proto.RPC{Name: "Watch", StreamsReturns: true},
+					Request: parser.Symbol{GoName: "Req"},
+					Returns: parser.Symbol{GoName: "Resp"},
+				},
+			},
+		}},
+		Validation: []parser.MessageValidation{
+			{Name: "Req", GoName: "Req", Fields: []parser.FieldValidation{
+				{Name: "name", GoName: "Name", Type: "string", Rules: []parser.Rule{
+					{Type: "string", Name: "min_len", Values: []string{"1"}},
+				}},
+			}},
+		},
+	}
+
+	g := NewGenerator("gozero", false)
+	cfg := &conf.Config{NamingFormat: "gozero"}
+	assert.Nil(t, g.GenTests(ctx, p, cfg, &ZRpcContext{}))
+	assert.NoFileExists(t, filepath.Join(dir, "internal", "testkit", "testkit.go"))
+
+	assert.Nil(t, g.GenTests(ctx, p, cfg, &ZRpcContext{Tests: true}))
+	data, err := os.ReadFile(filepath.Join(dir, "internal", "testkit", "testkit.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "func NewGreetClient(t testing.TB, svcCtx *svc.ServiceContext) greetclient.Greet {")
+	assert.Contains(t, string(data), "interceptor.ValidateInterceptor")
+
+	data, err = os.ReadFile(filepath.Join(dir, "internal", "logic", "pinglogic_test.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "got, err := client.Ping(context.Background(), test.in)")
+	assert.Contains(t, string(data), `t.Skip("the request has validation rules")`)
+
+	data, err = os.ReadFile(filepath.Join(dir, "internal", "logic", "watchlogic_test.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "want []*pb.Resp")
+}
@@ -0,0 +1,532 @@
+package generator
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+	"github.com/zeromicro/go-zero/core/collection"
+)
+
+const (
+	validateSuffix       = "_validate.go"
+	validatePluginSuffix = ".pb.validate.go"
+	interceptorDir       = "interceptor"
+	uuidPattern          = "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+)
+
+var (
+	//go:embed validate.tpl
+	validateTemplate string
+	//go:embed validate-interceptor.tpl
+	validateInterceptorTemplate string
+
+	numericTypes = map[string]bool{
+		"double":   true,
+		"float":    true,
+		"int32":    true,
+		"int64":    true,
+		"uint32":   true,
+		"uint64":   true,
+		"sint32":   true,
+		"sint64":   true,
+		"fixed32":  true,
+		"fixed64":  true,
+		"sfixed32": true,
+		"sfixed64": true,
+	}
+)
+
+type (
+	validateMessage struct {
+		Name string
+		Body string
+	}
+
+	validateBuilder struct {
+		message parser.MessageValidation
+		imports *collection.Set
+		vars    []string
+		body    strings.Builder
+	}
+)
+
+// GenValidate generates the Validate methods of the messages in the pb package by the field
+// rules of protoc-gen-validate or buf.validate, the methods generated by protoc-gen-validate
+// are reused if they exist, and the interceptor which validates the requests is generated.
+func (g *Generator) GenValidate(ctx DirContext, proto parser.Proto, cfg *conf.Config) error {
+	if !proto.HasValidation() {
+		return nil
+	}
+
+	name := strings.TrimSuffix(proto.Name, filepath.Ext(proto.Name))
+	filename := filepath.Join(ctx.GetPb().Filename, name+validateSuffix)
+	if pathx.FileExists(filepath.Join(ctx.GetPb().Filename, name+validatePluginSuffix)) {
+		// the methods of protoc-gen-validate conflict with the generated ones
+		if err := removeGenerated(filename); err != nil {
+			return err
+		}
+	} else if err := genValidateMethods(proto, filename); err != nil {
+		return err
+	}
+
+	return genValidateInterceptor(ctx, proto, cfg)
+}
+
+func genValidateMethods(proto parser.Proto, filename string) error {
+	imports := collection.NewSet()
+	imports.AddStr(`"fmt"`)
+	var (
+		messages []validateMessage
+		vars     []string
+	)
+	for _, each := range proto.Validation {
+		b := &validateBuilder{message: each, imports: imports}
+		if err := b.build(); err != nil {
+			return err
+		}
+
+		messages = append(messages, validateMessage{Name: each.GoName, Body: b.body.String()})
+		vars = append(vars, b.vars...)
+	}
+
+	text, err := pathx.LoadTemplate(category, validateTemplateFile, validateTemplate)
+	if err != nil {
+		return err
+	}
+
+	importList := imports.KeysStr()
+	sort.Strings(importList)
+	return util.With("validate").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head":     util.GetHead(proto.Name),
+		"pkg":      proto.PbPackage,
+		"imports":  strings.Join(importList, pathx.NL),
+		"vars":     strings.Join(vars, pathx.NL),
+		"messages": messages,
+	}, filename, true)
+}
+
+func genValidateInterceptor(ctx DirContext, proto parser.Proto, cfg *conf.Config) error {
+	dir := filepath.Join(ctx.GetInternal().Filename, interceptorDir)
+	if err := pathx.MkdirIfNotExist(dir); err != nil {
+		return err
+	}
+
+	interceptorFilename, err := format.FileNamingFormat(cfg.NamingFormat, "validate_interceptor")
+	if err != nil {
+		return err
+	}
+
+	text, err := pathx.LoadTemplate(category, validateInterceptorTemplateFile, validateInterceptorTemplate)
+	if err != nil {
+		return err
+	}
+
+	return util.With("interceptor").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head": util.GetHead(proto.Name),
+	}, filepath.Join(dir, interceptorFilename+".go"), true)
+}
+
+// removeGenerated removes the file if it's generated by goctl
+func removeGenerated(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !strings.HasPrefix(string(data), util.DoNotEditHead) {
+		return nil
+	}
+	return os.Remove(filename)
+}
+
+func (b *validateBuilder) build() error {
+	if b.message.Disabled {
+		return nil
+	}
+
+	for _, field := range b.message.Fields {
+		// the oneof and the optional fields are validated if they are set
+		switch {
+		case len(field.OneOf) > 0:
+			fmt.Fprintf(&b.body, "\tif _, ok := m.Get%s().(*%s_%s); ok {\n", parser.CamelCase(field.OneOf),
+				b.message.GoName, field.GoName)
+		case field.Optional:
+			fmt.Fprintf(&b.body, "\tif m.%s != nil {\n", field.GoName)
+		}
+
+		skip := false
+		for _, rule := range field.Rules {
+			if rule.Type == "message" && rule.Name == "skip" {
+				skip = rule.Values[0] == "true"
+				continue
+			}
+			if err := b.checkType(field, rule); err != nil {
+				return err
+			}
+			if err := b.buildRule(field, rule); err != nil {
+				return err
+			}
+		}
+		if field.Message && !skip {
+			b.buildEmbedded(field)
+		}
+		if len(field.OneOf) > 0 || field.Optional {
+			b.body.WriteString("\t}\n")
+		}
+	}
+	return nil
+}
+
+// checkType checks whether the rule applies to the type of the field
+func (b *validateBuilder) checkType(field parser.FieldValidation, rule parser.Rule) error {
+	var expected string
+	switch {
+	case len(rule.Type) == 0:
+		return nil
+	case field.Map:
+		expected = "map"
+	case field.Repeated:
+		expected = "repeated"
+	case field.Message:
+		expected = "message"
+	case parser.IsScalar(field.Type):
+		expected = field.Type
+	default:
+		expected = "enum"
+	}
+	if rule.Type == expected {
+		return nil
+	}
+
+	return fmt.Errorf("%s.%s: the %s rules can't be applied to the %s field", b.message.Name,
+		field.Name, rule.Type, expected)
+}
+
+func (b *validateBuilder) buildRule(field parser.FieldValidation, rule parser.Rule) error {
+	value := fmt.Sprintf("m.Get%s()", field.GoName)
+	literal := rule.Values[0]
+	enabled := literal == "true"
+	unsupported := fmt.Errorf("%s.%s: the rule %s is not supported, please generate the "+
+		"validation by protoc-gen-validate", b.message.Name, field.Name, ruleName(rule))
+
+	switch rule.Type {
+	case "":
+		if rule.Name != "required" {
+			return unsupported
+		}
+		if enabled {
+			b.check(field, b.zero(field, value), "value is required")
+		}
+	case "message":
+		if rule.Name != "required" {
+			return unsupported
+		}
+		if enabled {
+			b.check(field, value+" == nil", "value is required")
+		}
+	case "repeated":
+		switch rule.Name {
+		case "min_items":
+			b.check(field, fmt.Sprintf("len(%s) < %s", value, literal),
+				fmt.Sprintf("value must contain at least %s item(s)", literal))
+		case "max_items":
+			b.check(field, fmt.Sprintf("len(%s) > %s", value, literal),
+				fmt.Sprintf("value must contain no more than %s item(s)", literal))
+		default:
+			return unsupported
+		}
+	case "map":
+		switch rule.Name {
+		case "min_pairs":
+			b.check(field, fmt.Sprintf("len(%s) < %s", value, literal),
+				fmt.Sprintf("value must contain at least %s pair(s)", literal))
+		case "max_pairs":
+			b.check(field, fmt.Sprintf("len(%s) > %s", value, literal),
+				fmt.Sprintf("value must contain no more than %s pair(s)", literal))
+		default:
+			return unsupported
+		}
+	case "string":
+		return b.buildString(field, rule, value, unsupported)
+	case "bytes":
+		return b.buildBytes(field, rule, value, unsupported)
+	case "bool":
+		if rule.Name != "const" {
+			return unsupported
+		}
+		b.check(field, fmt.Sprintf("%s != %s", value, literal), "value must equal "+literal)
+	case "enum":
+		switch rule.Name {
+		case "defined_only":
+			if enabled {
+				b.check(field, fmt.Sprintf("%s.Descriptor().Values().ByNumber(%s.Number()) == nil",
+					value, value), "value must be one of the defined enum values")
+			}
+		default:
+			return b.buildComparison(field, rule, value, unsupported)
+		}
+	default:
+		if !numericTypes[rule.Type] {
+			return unsupported
+		}
+		return b.buildComparison(field, rule, value, unsupported)
+	}
+
+	return nil
+}
+
+func (b *validateBuilder) buildComparison(field parser.FieldValidation, rule parser.Rule, value string,
+	unsupported error) error {
+	literal := rule.Values[0]
+	switch rule.Name {
+	case "const":
+		b.check(field, fmt.Sprintf("%s != %s", value, literal), "value must equal "+literal)
+	case "lt":
+		b.check(field, fmt.Sprintf("%s >= %s", value, literal), "value must be less than "+literal)
+	case "lte":
+		b.check(field, fmt.Sprintf("%s > %s", value, literal),
+			"value must be less than or equal to "+literal)
+	case "gt":
+		b.check(field, fmt.Sprintf("%s <= %s", value, literal), "value must be greater than "+literal)
+	case "gte":
+		b.check(field, fmt.Sprintf("%s < %s", value, literal),
+			"value must be greater than or equal to "+literal)
+	case "in":
+		b.checkIn(field, rule, value, true)
+	case "not_in":
+		b.checkIn(field, rule, value, false)
+	default:
+		return unsupported
+	}
+	return nil
+}
+
+func (b *validateBuilder) buildString(field parser.FieldValidation, rule parser.Rule, value string,
+	unsupported error) error {
+	literal := rule.Values[0]
+	enabled := literal == "true"
+	switch rule.Name {
+	case "const":
+		b.check(field, fmt.Sprintf("%s != %s", value, literal), "value must equal "+literal)
+	case "len":
+		b.imports.AddStr(`"unicode/utf8"`)
+		b.check(field, fmt.Sprintf("utf8.RuneCountInString(%s) != %s", value, literal),
+			fmt.Sprintf("value length must be %s rune(s)", literal))
+	case "min_len":
+		b.imports.AddStr(`"unicode/utf8"`)
+		b.check(field, fmt.Sprintf("utf8.RuneCountInString(%s) < %s", value, literal),
+			fmt.Sprintf("value length must be at least %s rune(s)", literal))
+	case "max_len":
+		b.imports.AddStr(`"unicode/utf8"`)
+		b.check(field, fmt.Sprintf("utf8.RuneCountInString(%s) > %s", value, literal),
+			fmt.Sprintf("value length must be at most %s rune(s)", literal))
+	case "len_bytes":
+		b.check(field, fmt.Sprintf("len(%s) != %s", value, literal),
+			fmt.Sprintf("value length must be %s byte(s)", literal))
+	case "min_bytes":
+		b.check(field, fmt.Sprintf("len(%s) < %s", value, literal),
+			fmt.Sprintf("value length must be at least %s byte(s)", literal))
+	case "max_bytes":
+		b.check(field, fmt.Sprintf("len(%s) > %s", value, literal),
+			fmt.Sprintf("value length must be at most %s byte(s)", literal))
+	case "pattern":
+		re := b.regexp(field, "Pattern", literal)
+		b.check(field, fmt.Sprintf("!%s.MatchString(%s)", re, value),
+			"value does not match regex pattern "+literal)
+	case "prefix":
+		b.imports.AddStr(`"strings"`)
+		b.check(field, fmt.Sprintf("!strings.HasPrefix(%s, %s)", value, literal),
+			"value does not have prefix "+literal)
+	case "suffix":
+		b.imports.AddStr(`"strings"`)
+		b.check(field, fmt.Sprintf("!strings.HasSuffix(%s, %s)", value, literal),
+			"value does not have suffix "+literal)
+	case "contains":
+		b.imports.AddStr(`"strings"`)
+		b.check(field, fmt.Sprintf("!strings.Contains(%s, %s)", value, literal),
+			"value does not contain substring "+literal)
+	case "not_contains":
+		b.imports.AddStr(`"strings"`)
+		b.check(field, fmt.Sprintf("strings.Contains(%s, %s)", value, literal),
+			"value contains substring "+literal)
+	case "in":
+		b.checkIn(field, rule, value, true)
+	case "not_in":
+		b.checkIn(field, rule, value, false)
+	case "email":
+		if enabled {
+			b.imports.AddStr(`"net/mail"`)
+			b.check(field, fmt.Sprintf("addr, err := mail.ParseAddress(%s); err != nil || addr.Address != %s",
+				value, value), "value must be a valid email address")
+		}
+	case "uuid":
+		if enabled {
+			re := b.regexp(field, "UUID", strconv.Quote(uuidPattern))
+			b.check(field, fmt.Sprintf("!%s.MatchString(%s)", re, value), "value must be a valid UUID")
+		}
+	case "uri":
+		if enabled {
+			b.imports.AddStr(`"net/url"`)
+			b.check(field, fmt.Sprintf("u, err := url.Parse(%s); err != nil || !u.IsAbs()", value),
+				"value must be absolute URI")
+		}
+	case "ip":
+		if enabled {
+			b.imports.AddStr(`"net"`)
+			b.check(field, fmt.Sprintf("net.ParseIP(%s) == nil", value), "value must be a valid IP address")
+		}
+	case "ipv4":
+		if enabled {
+			b.imports.AddStr(`"net"`)
+			b.check(field, fmt.Sprintf("ip := net.ParseIP(%s); ip == nil || ip.To4() == nil", value),
+				"value must be a valid IPv4 address")
+		}
+	case "ipv6":
+		if enabled {
+			b.imports.AddStr(`"net"`)
+			b.check(field, fmt.Sprintf("ip := net.ParseIP(%s); ip == nil || ip.To4() != nil", value),
+				"value must be a valid IPv6 address")
+		}
+	default:
+		return unsupported
+	}
+	return nil
+}
+
+func (b *validateBuilder) buildBytes(field parser.FieldValidation, rule parser.Rule, value string,
+	unsupported error) error {
+	literal := rule.Values[0]
+	switch rule.Name {
+	case "len":
+		b.check(field, fmt.Sprintf("len(%s) != %s", value, literal),
+			fmt.Sprintf("value length must be %s byte(s)", literal))
+	case "min_len":
+		b.check(field, fmt.Sprintf("len(%s) < %s", value, literal),
+			fmt.Sprintf("value length must be at least %s byte(s)", literal))
+	case "max_len":
+		b.check(field, fmt.Sprintf("len(%s) > %s", value, literal),
+			fmt.Sprintf("value length must be at most %s byte(s)", literal))
+	case "pattern":
+		re := b.regexp(field, "Pattern", literal)
+		b.check(field, fmt.Sprintf("!%s.Match(%s)", re, value),
+			"value does not match regex pattern "+literal)
+	case "prefix":
+		b.imports.AddStr(`"bytes"`)
+		b.check(field, fmt.Sprintf("!bytes.HasPrefix(%s, []byte(%s))", value, literal),
+			"value does not have prefix "+literal)
+	case "suffix":
+		b.imports.AddStr(`"bytes"`)
+		b.check(field, fmt.Sprintf("!bytes.HasSuffix(%s, []byte(%s))", value, literal),
+			"value does not have suffix "+literal)
+	case "contains":
+		b.imports.AddStr(`"bytes"`)
+		b.check(field, fmt.Sprintf("!bytes.Contains(%s, []byte(%s))", value, literal),
+			"value does not contain "+literal)
+	default:
+		return unsupported
+	}
+	return nil
+}
+
+// buildEmbedded validates the messages in the field which have the Validate method
+func (b *validateBuilder) buildEmbedded(field parser.FieldValidation) {
+	value := fmt.Sprintf("m.Get%s()", field.GoName)
+	name := strconv.Quote(field.Name)
+	switch {
+	case field.Map:
+		fmt.Fprintf(&b.body, "\tfor key, val := range %s {\n", value)
+		value, name = "val", fmt.Sprintf(`fmt.Sprintf("%s[%%v]", key)`, field.Name)
+	case field.Repeated:
+		fmt.Fprintf(&b.body, "\tfor idx, item := range %s {\n", value)
+		value, name = "item", fmt.Sprintf(`fmt.Sprintf("%s[%%v]", idx)`, field.Name)
+	}
+
+	fmt.Fprintf(&b.body, `	if v, ok := interface{}(%s).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return %sValidationError{
+				field:  %s,
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+`, value, b.message.GoName, name)
+
+	if field.Map || field.Repeated {
+		b.body.WriteString("\t}\n")
+	}
+}
+
+func (b *validateBuilder) checkIn(field parser.FieldValidation, rule parser.Rule, value string, in bool) {
+	values := strings.Join(rule.Values, ", ")
+	if rule.Type == "enum" {
+		value = fmt.Sprintf("int32(%s)", value)
+	}
+
+	if in {
+		fmt.Fprintf(&b.body, "\tswitch %s {\n\tcase %s:\n\tdefault:\n", value, values)
+		b.writeError(field, fmt.Sprintf("value must be in list [%s]", values))
+	} else {
+		fmt.Fprintf(&b.body, "\tswitch %s {\n\tcase %s:\n", value, values)
+		b.writeError(field, fmt.Sprintf("value must not be in list [%s]", values))
+	}
+	b.body.WriteString("\t}\n")
+}
+
+func (b *validateBuilder) check(field parser.FieldValidation, cond, reason string) {
+	fmt.Fprintf(&b.body, "\tif %s {\n", cond)
+	b.writeError(field, reason)
+	b.body.WriteString("\t}\n")
+}
+
+func (b *validateBuilder) writeError(field parser.FieldValidation, reason string) {
+	fmt.Fprintf(&b.body, `		return %sValidationError{
+			field:  %q,
+			reason: %s,
+		}
+`, b.message.GoName, field.Name, strconv.Quote(reason))
+}
+
+// regexp declares the compiled pattern of the field and returns its name
+func (b *validateBuilder) regexp(field parser.FieldValidation, kind, literal string) string {
+	b.imports.AddStr(`"regexp"`)
+	name := fmt.Sprintf("_%s_%s_%s", b.message.GoName, field.GoName, kind)
+	b.vars = append(b.vars, fmt.Sprintf("%s = regexp.MustCompile(%s)", name, literal))
+	return name
+}
+
+// zero returns the condition that the value of the field is not set
+func (b *validateBuilder) zero(field parser.FieldValidation, value string) string {
+	switch {
+	case field.Map, field.Repeated, field.Type == "bytes":
+		return fmt.Sprintf("len(%s) == 0", value)
+	case field.Message:
+		return value + " == nil"
+	case field.Type == "string":
+		return value + ` == ""`
+	case field.Type == "bool":
+		return "!" + value
+	default:
+		return value + " == 0"
+	}
+}
+
+func ruleName(rule parser.Rule) string {
+	if len(rule.Type) == 0 {
+		return rule.Name
+	}
+	return rule.Type + "." + rule.Name
+}
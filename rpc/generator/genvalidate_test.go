@@ -0,0 +1,102 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	conf "github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/zeromicro/go-zero/core/collection"
+)
+
+func TestValidateBuilder(t *testing.T) {
+	b := &validateBuilder{
+		message: parser.MessageValidation{
+			Name:   "Req",
+			GoName: "Req",
+			Fields: []parser.FieldValidation{
+				{Name: "name", GoName: "Name", Type: "string", Rules: []parser.Rule{
+					{Type: "string", Name: "min_len", Values: []string{"1"}},
+					{Type: "string", Name: "pattern", Values: []string{`"^\\w+$"`}},
+				}},
+				{Name: "page", GoName: "Page", Type: "Page", Message: true, Rules: []parser.Rule{
+					{Name: "required", Values: []string{"true"}},
+				}},
+				{Name: "pages", GoName: "Pages", Type: "Page", Repeated: true, Message: true},
+				{Name: "phone", GoName: "Phone", Type: "string", OneOf: "contact", Rules: []parser.Rule{
+					{Type: "string", Name: "in", Values: []string{`"a"`, `"b"`}},
+				}},
+			},
+		},
+		imports: collection.NewSet(),
+	}
+	assert.Nil(t, b.build())
+
+	body := b.body.String()
+	assert.Contains(t, body, "utf8.RuneCountInString(m.GetName()) < 1")
+	assert.Contains(t, body, "!_Req_Name_Pattern.MatchString(m.GetName())")
+	assert.Contains(t, body, "m.GetPage() == nil")
+	assert.Contains(t, body, `fmt.Sprintf("pages[%v]", idx)`)
+	assert.Contains(t, body, "if _, ok := m.GetContact().(*Req_Phone); ok {")
+	assert.Contains(t, body, `case "a", "b":`)
+	assert.ElementsMatch(t, []string{`"unicode/utf8"`, `"regexp"`}, b.imports.KeysStr())
+	assert.Equal(t, []string{`_Req_Name_Pattern = regexp.MustCompile("^\\w+$")`}, b.vars)
+}
+
+func TestValidateBuilder_Error(t *testing.T) {
+	tests := []parser.FieldValidation{
+		{Name: "name", GoName: "Name", Type: "string", Rules: []parser.Rule{
+			{Type: "string", Name: "hostname", Values: []string{"true"}},
+		}},
+		{Name: "age", GoName: "Age", Type: "int32", Rules: []parser.Rule{
+			{Type: "string", Name: "min_len", Values: []string{"1"}},
+		}},
+		{Name: "tags", GoName: "Tags", Type: "string", Repeated: true, Rules: []parser.Rule{
+			{Type: "repeated", Name: "items.string.min_len", Values: []string{"1"}},
+		}},
+	}
+	for _, field := range tests {
+		b := &validateBuilder{
+			message: parser.MessageValidation{Name: "Req", GoName: "Req", Fields: []parser.FieldValidation{field}},
+			imports: collection.NewSet(),
+		}
+		assert.NotNil(t, b.build())
+	}
+}
+
+func TestGenValidate(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/demo"), 0o644))
+	pbDir := filepath.Join(dir, "pb")
+	assert.Nil(t, os.MkdirAll(pbDir, os.ModePerm))
+	ctx := &defaultDirContext{inner: map[string]Dir{
+		pb:       {Filename: pbDir, Package: "example.com/demo/pb"},
+		internal: {Filename: filepath.Join(dir, "internal"), Package: "example.com/demo/internal"},
+	}}
+	proto := parser.Proto{
+		Name:      "greet.proto",
+		PbPackage: "pb",
+		Validation: []parser.MessageValidation{
+			{Name: "Req", GoName: "Req", Fields: []parser.FieldValidation{
+				{Name: "name", GoName: "Name", Type: "string", Rules: []parser.Rule{
+					{Type: "string", Name: "max_len", Values: []string{"10"}},
+				}},
+			}},
+		},
+	}
+
+	g := NewGenerator("gozero", false)
+	cfg := &conf.Config{NamingFormat: "gozero"}
+	assert.Nil(t, g.GenValidate(ctx, proto, cfg))
+	data, err := os.ReadFile(filepath.Join(pbDir, "greet_validate.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "func (m *Req) Validate() error {")
+	assert.FileExists(t, filepath.Join(dir, "internal", "interceptor", "validateinterceptor.go"))
+
+	// the methods of protoc-gen-validate are reused
+	assert.Nil(t, os.WriteFile(filepath.Join(pbDir, "greet.pb.validate.go"), []byte("package pb"), 0o644))
+	assert.Nil(t, g.GenValidate(ctx, proto, cfg))
+	assert.NoFileExists(t, filepath.Join(pbDir, "greet_validate.go"))
+}
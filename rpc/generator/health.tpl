@@ -0,0 +1,123 @@
+{{.head}}
+
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	{{.imports}}
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/proc"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	checkMethod = "/grpc.health.v1.Health/Check"
+	watchMethod = "/grpc.health.v1.Health/Watch"
+)
+
+type (
+	// Server serves the grpc health checks by the readiness of the service context. The health
+	// service registered by zrpc only follows the lifecycle of the server, so the checks are
+	// answered by the interceptors of Server instead.
+	Server struct {
+		*grpchealth.Server
+		svcCtx   *svc.ServiceContext
+		interval time.Duration
+		services []string
+		done     chan struct{}
+		once     sync.Once
+	}
+
+	watchServer struct {
+		grpc.ServerStream
+	}
+)
+
+// NewServer returns a Server which checks the readiness of the services in every interval
+func NewServer(svcCtx *svc.ServiceContext, interval time.Duration, services ...string) *Server {
+	return &Server{
+		Server:   grpchealth.NewServer(),
+		svcCtx:   svcCtx,
+		interval: interval,
+		services: services,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start checks the readiness until Stop is called, the services turn NOT_SERVING on SIGTERM
+// along with the graceful stop of the server, not ahead of it
+func (s *Server) Start() {
+	s.update()
+	proc.AddWrapUpListener(s.Shutdown)
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.update()
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops checking the readiness
+func (s *Server) Stop() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
+
+// UnaryInterceptor answers the health checks
+func (s *Server) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if in, ok := req.(*grpc_health_v1.HealthCheckRequest); ok && info.FullMethod == checkMethod {
+		return s.Check(ctx, in)
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor answers the health watches
+func (s *Server) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if info.FullMethod != watchMethod {
+		return handler(srv, ss)
+	}
+
+	in := new(grpc_health_v1.HealthCheckRequest)
+	if err := ss.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return s.Watch(in, watchServer{ServerStream: ss})
+}
+
+// update sets the status of each service, the overall status is SERVING if all services are ready
+func (s *Server) update() {
+	overall := grpc_health_v1.HealthCheckResponse_SERVING
+	for _, service := range s.services {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		if err := s.svcCtx.Ready(service); err != nil {
+			logx.Errorf("service %s is not ready: %v", service, err)
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			overall = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+		s.SetServingStatus(service, status)
+	}
+	s.SetServingStatus("", overall)
+}
+
+func (w watchServer) Send(resp *grpc_health_v1.HealthCheckResponse) error {
+	return w.SendMsg(resp)
+}
@@ -0,0 +1,109 @@
+package {{.packageName}}_test
+
+import (
+	"context"
+	{{if .stream}}"errors"
+	"io"
+	{{end}}"testing"
+
+	{{.imports}}
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+{{with .data}}
+func Test{{.Method}}(t *testing.T) {
+	client := testkit.New{{.Service}}Client(t, svc.NewServiceContext(config.Config{}))
+
+	tests := []struct {
+		name string
+		in   {{if .StreamsRequest}}[]{{end}}*{{.Request}}
+		want {{if .StreamsReturns}}[]{{end}}*{{.Response}}
+		code codes.Code
+	}{
+		{
+			name: "ok",
+			in:   {{if .StreamsRequest}}[]*{{.Request}}{{"{{}}"}}{{else}}&{{.Request}}{{"{}"}}{{end}},
+			{{if not (or .StreamsRequest .StreamsReturns)}}want: &{{.Response}}{{"{}"}},
+			{{end}}code: codes.OK,
+		},
+		// todo: add your test cases here
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+{{if .Validated}}			// todo: fill the valid fields of the request and delete this line
+			t.Skip("the request has validation rules")
+
+{{end}}{{if .StreamsRequest}}			got, err := func() ({{if .StreamsReturns}}[]{{end}}*{{.Response}}, error) {
+				stream, err := client.{{.Method}}(context.Background())
+				if err != nil {
+					return nil, err
+				}
+
+				for _, in := range test.in {
+					// the status is received after sending if the server stops receiving
+					if err := stream.Send(in); err != nil {
+						break
+					}
+				}
+{{if .StreamsReturns}}				if err := stream.CloseSend(); err != nil {
+					return nil, err
+				}
+
+				var got []*{{.Response}}
+				for {
+					resp, err := stream.Recv()
+					if errors.Is(err, io.EOF) {
+						return got, nil
+					}
+					if err != nil {
+						return got, err
+					}
+					got = append(got, resp)
+				}
+{{else}}				resp, err := stream.CloseAndRecv()
+				if errors.Is(err, io.EOF) {
+					// the server returns without a response
+					return nil, nil
+				}
+				return resp, err
+{{end}}			}()
+{{else if .StreamsReturns}}			got, err := func() ([]*{{.Response}}, error) {
+				stream, err := client.{{.Method}}(context.Background(), test.in)
+				if err != nil {
+					return nil, err
+				}
+
+				var got []*{{.Response}}
+				for {
+					resp, err := stream.Recv()
+					if errors.Is(err, io.EOF) {
+						return got, nil
+					}
+					if err != nil {
+						return got, err
+					}
+					got = append(got, resp)
+				}
+			}()
+{{else}}			got, err := client.{{.Method}}(context.Background(), test.in)
+{{end}}			if status.Code(err) != test.code {
+				t.Fatalf("expected code %s, got %v", test.code, err)
+			}
+{{if .StreamsReturns}}			if len(got) != len(test.want) {
+				t.Fatalf("expected %d responses, got %d", len(test.want), len(got))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], test.want[i]) {
+					t.Fatalf("expected %v, got %v", test.want[i], got[i])
+				}
+			}
+{{else}}			if !proto.Equal(got, test.want) {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+{{end}}		})
+	}
+}
+{{end}}
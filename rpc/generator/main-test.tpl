@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	{{.imports}}
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+{{if .reflection}}	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+{{end}}	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestServer starts the server on a bufconn listener with the same services and interceptors as main
+func TestServer(t *testing.T) {
+	var c config.Config
+{{if .health}}	c.HealthInterval = time.Second
+{{end}}{{if .reflection}}	c.Reflection = true
+{{end}}	ctx := svc.NewServiceContext(c)
+{{if .health}}	healthServer := health.NewServer(ctx, c.HealthInterval{{range .serviceNames}},
+		{{.Pkg}}.{{.Service}}_ServiceDesc.ServiceName{{end}})
+	healthServer.Start()
+	defer healthServer.Stop()
+{{end}}
+	var (
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+{{if .health}}	unaryInterceptors = append(unaryInterceptors, healthServer.UnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, healthServer.StreamInterceptor)
+{{end}}{{if .validate}}	unaryInterceptors = append(unaryInterceptors, interceptor.ValidateInterceptor)
+{{end}}{{if .interceptors}}	unaryInterceptors = append(unaryInterceptors, ctx.UnaryInterceptors...)
+	streamInterceptors = append(streamInterceptors, ctx.StreamInterceptors...)
+{{end}}	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
+{{range .serviceNames}}	{{.Pkg}}.Register{{.Service}}Server(grpcServer, {{.ServerPkg}}.New{{.Service}}Server(ctx))
+{{end}}{{if .reflection}}	reflection.Register(grpcServer)
+{{end}}	// the health service is registered by zrpc
+	grpc_health_v1.RegisterHealthServer(grpcServer, grpchealth.NewServer())
+
+	listener := bufconn.Listen(1 << 20)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	dialCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, "bufnet", grpc.WithContextDialer(
+		func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := grpc_health_v1.NewHealthClient(conn)
+	services := []string{""{{if .health}}{{range .serviceNames}}, {{.Pkg}}.{{.Service}}_ServiceDesc.ServiceName{{end}}{{end}}}
+	for _, service := range services {
+		resp, err := client.Check(dialCtx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+			t.Fatalf("service %q is %s", service, resp.Status)
+		}
+	}
+{{if .health}}
+	// the services turn NOT_SERVING on SIGTERM
+	healthServer.Shutdown()
+	resp, err := client.Check(dialCtx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("server is %s after shutdown", resp.Status)
+	}
+{{end}}{{if .reflection}}
+	stream, err := grpc_reflection_v1alpha.NewServerReflectionClient(conn).ServerReflectionInfo(dialCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	registered := make(map[string]bool)
+	for _, each := range reply.GetListServicesResponse().GetService() {
+		registered[each.Name] = true
+	}
+	for _, service := range []string{ {{range .serviceNames}}{{.Pkg}}.{{.Service}}_ServiceDesc.ServiceName, {{end}} } {
+		if !registered[service] {
+			t.Fatalf("service %q is not reflected", service)
+		}
+	}
+{{end}}}
@@ -7,7 +7,8 @@ import (
 	{{.imports}}
 
 	"github.com/zeromicro/go-zero/core/conf"
-	"github.com/zeromicro/go-zero/core/service"
+{{if .drain}}	"github.com/zeromicro/go-zero/core/proc"
+{{end}}	"github.com/zeromicro/go-zero/core/service"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -21,15 +22,27 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
-
+{{if .health}}	healthServer := health.NewServer(ctx, c.HealthInterval{{range .serviceNames}},
+		{{.Pkg}}.{{.Service}}_ServiceDesc.ServiceName{{end}})
+	healthServer.Start()
+	defer healthServer.Stop()
+{{end}}
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 {{range .serviceNames}}       {{.Pkg}}.Register{{.Service}}Server(grpcServer, {{.ServerPkg}}.New{{.Service}}Server(ctx))
 {{end}}
-		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+		if {{if .reflection}}c.Reflection || {{end}}c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
+{{if .health}}	s.AddUnaryInterceptors(healthServer.UnaryInterceptor)
+	s.AddStreamInterceptors(healthServer.StreamInterceptor)
+{{end}}{{if .validate}}	s.AddUnaryInterceptors(interceptor.ValidateInterceptor)
+{{end}}{{if .interceptors}}	s.AddUnaryInterceptors(ctx.UnaryInterceptors...)
+	s.AddStreamInterceptors(ctx.StreamInterceptors...)
+{{end}}{{if .drain}}	// the graceful stop on SIGTERM waits for the pending requests until the process is force quit
+	// after DrainPeriod, the listener is closed at once and the health turns NOT_SERVING meanwhile
+	proc.SetTimeToForceQuit(c.DrainPeriod)
+{{end}}	defer s.Stop()
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+// pbType returns the go type of the message and the import of the go package which defines
+// it, the import is empty for the messages in the go package of the proto file.
+func pbType(ctx DirContext, proto parser.Proto, symbol parser.Symbol) (string, string) {
+	if proto.SameGoPackage(symbol.GoPackage) {
+		return fmt.Sprintf("%s.%s", proto.PbPackage, symbol.GoName), ""
+	}
+
+	return fmt.Sprintf("%s.%s", symbol.PbPackage, symbol.GoName),
+		quoteImport(symbol.PbPackage, goImport(ctx, proto, symbol.GoPackage))
+}
+
+// quotePbImport returns the import of the go package of the proto file
+func quotePbImport(ctx DirContext, proto parser.Proto) string {
+	return quoteImport(proto.PbPackage, ctx.GetPb().Package)
+}
+
+// quoteImport returns the quoted import path, it's named if the package name is not the last
+// element of the path, like the go_package github.com/foo/bar/v1;barv1.
+func quoteImport(name, importPath string) string {
+	if path.Base(importPath) == name {
+		return fmt.Sprintf(`"%s"`, importPath)
+	}
+	return fmt.Sprintf(`%s "%s"`, name, importPath)
+}
+
+// goImport returns the import path of the go_package, the relative go_package is regarded as
+// the directory in the output of the proto file, like ./pb/shared next to ./pb/user.
+func goImport(ctx DirContext, proto parser.Proto, goPackage string) string {
+	if !strings.HasPrefix(goPackage, ".") {
+		return goPackage
+	}
+
+	pbPackage := ctx.GetPb().Package
+	base := path.Dir(pbPackage)
+	if own := path.Clean(proto.GoPackage); own == "." {
+		base = pbPackage
+	} else if strings.HasPrefix(proto.GoPackage, ".") && strings.HasSuffix(pbPackage, "/"+own) {
+		base = strings.TrimSuffix(pbPackage, "/"+own)
+	}
+	return path.Join(base, goPackage)
+}
+
+// pbFiles returns the imported files in the go package of the proto file which are generated
+// with it, the files in the other go packages are expected to be generated already.
+func pbFiles(proto parser.Proto) []string {
+	var files []string
+	for _, each := range proto.Files {
+		if len(each.Src) > 0 && proto.SameGoPackage(each.GoPackage) {
+			files = append(files, each.Src)
+		}
+	}
+	return files
+}
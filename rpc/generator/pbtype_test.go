@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+)
+
+func TestPbType(t *testing.T) {
+	ctx := &defaultDirContext{inner: map[string]Dir{
+		pb: {Package: "example.com/demo/pb/user"},
+	}}
+	proto := parser.Proto{GoPackage: "./user", PbPackage: "user"}
+
+	tests := []struct {
+		symbol   parser.Symbol
+		goType   string
+		goImport string
+	}{
+		{
+			symbol: parser.Symbol{GoName: "GetReq", GoPackage: "./user", PbPackage: "user"},
+			goType: "user.GetReq",
+		},
+		{
+			symbol:   parser.Symbol{GoName: "Page", GoPackage: "./shared", PbPackage: "shared"},
+			goType:   "shared.Page",
+			goImport: `"example.com/demo/pb/shared"`,
+		},
+		{
+			symbol:   parser.Symbol{GoName: "Page", GoPackage: "example.com/demo/gen/common/v1", PbPackage: "commonv1"},
+			goType:   "commonv1.Page",
+			goImport: `commonv1 "example.com/demo/gen/common/v1"`,
+		},
+		{
+			symbol: parser.Symbol{GoName: "Empty", GoPackage: "google.golang.org/protobuf/types/known/emptypb",
+				PbPackage: "emptypb"},
+			goType:   "emptypb.Empty",
+			goImport: `"google.golang.org/protobuf/types/known/emptypb"`,
+		},
+	}
+	for _, test := range tests {
+		goType, goImport := pbType(ctx, proto, test.symbol)
+		assert.Equal(t, test.goType, goType)
+		assert.Equal(t, test.goImport, goImport)
+	}
+}
+
+func TestPbFiles(t *testing.T) {
+	proto := parser.Proto{
+		GoPackage: "./user",
+		Files: []parser.File{
+			{Name: "types.proto", Src: "proto/types.proto", GoPackage: "./user"},
+			{Name: "shared/page.proto", Src: "proto/shared/page.proto", GoPackage: "example.com/demo/shared"},
+			{Name: "google/protobuf/empty.proto", GoPackage: "google.golang.org/protobuf/types/known/emptypb"},
+		},
+	}
+	assert.Equal(t, []string{"proto/types.proto"}, pbFiles(proto))
+}
+
+func Test_findPbFileOfProto(t *testing.T) {
+	output := t.TempDir()
+	for _, each := range []string{"shared/page.pb.go", "user/types.pb.go", "user/user.pb.go",
+		"user/user_grpc.pb.go"} {
+		filename := filepath.Join(output, each)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filename), os.ModePerm))
+		assert.Nil(t, os.WriteFile(filename, nil, 0o644))
+	}
+
+	pbDir, err := findPbFile(output, false, "proto/user.proto")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(output, "user"), pbDir)
+
+	grpcDir, err := findPbFile(output, true, "proto/user.proto")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(output, "user"), grpcDir)
+}
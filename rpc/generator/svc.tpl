@@ -4,10 +4,22 @@ import {{.imports}}
 
 type ServiceContext struct {
 	Config config.Config
-}
+{{if .interceptors}}	// UnaryInterceptors and StreamInterceptors are chained in order after the builtin interceptors
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+{{end}}}
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	return &ServiceContext{
 		Config:c,
-	}
+{{if .interceptors}}		UnaryInterceptors:  []grpc.UnaryServerInterceptor{},
+		StreamInterceptors: []grpc.StreamServerInterceptor{},
+{{end}}	}
+}
+{{if .health}}
+// Ready reports whether the service is ready to serve, the service is NOT_SERVING in the health
+// checks if an error is returned, like the dependencies are not connected.
+func (s *ServiceContext) Ready(service string) error {
+	return nil
 }
+{{end}}
@@ -11,28 +11,44 @@ const (
 	callTemplateFile                  = "call.tpl"
 	callInterfaceFunctionTemplateFile = "call-interface-func.tpl"
 	callFunctionTemplateFile          = "call-func.tpl"
+	cliTemplateFile                   = "cli.tpl"
+	cliMainTemplateFile               = "cli-main.tpl"
 	configTemplateFileFile            = "config.tpl"
 	etcTemplateFileFile               = "etc.tpl"
 	logicTemplateFileFile             = "logic.tpl"
 	logicFuncTemplateFileFile         = "logic-func.tpl"
+	logicTestTemplateFile             = "logic-test.tpl"
+	healthTemplateFile                = "health.tpl"
 	mainTemplateFile                  = "main.tpl"
+	mainTestTemplateFile              = "main-test.tpl"
 	serverTemplateFile                = "server.tpl"
 	serverFuncTemplateFile            = "server-func.tpl"
 	svcTemplateFile                   = "svc.tpl"
+	testkitTemplateFile               = "testkit.tpl"
 	rpcTemplateFile                   = "template.tpl"
+	validateTemplateFile              = "validate.tpl"
+	validateInterceptorTemplateFile   = "validate-interceptor.tpl"
 )
 
 var templates = map[string]string{
-	callTemplateFile:          callTemplateText,
-	configTemplateFileFile:    configTemplate,
-	etcTemplateFileFile:       etcTemplate,
-	logicTemplateFileFile:     logicTemplate,
-	logicFuncTemplateFileFile: logicFunctionTemplate,
-	mainTemplateFile:          mainTemplate,
-	serverTemplateFile:        serverTemplate,
-	serverFuncTemplateFile:    functionTemplate,
-	svcTemplateFile:           svcTemplate,
-	rpcTemplateFile:           rpcTemplateText,
+	callTemplateFile:                callTemplateText,
+	cliTemplateFile:                 cliTemplate,
+	cliMainTemplateFile:             cliMainTemplate,
+	configTemplateFileFile:          configTemplate,
+	etcTemplateFileFile:             etcTemplate,
+	logicTemplateFileFile:           logicTemplate,
+	logicFuncTemplateFileFile:       logicFunctionTemplate,
+	logicTestTemplateFile:           logicTestTemplate,
+	healthTemplateFile:              healthTemplate,
+	mainTemplateFile:                mainTemplate,
+	mainTestTemplateFile:            mainTestTemplate,
+	serverTemplateFile:              serverTemplate,
+	serverFuncTemplateFile:          functionTemplate,
+	svcTemplateFile:                 svcTemplate,
+	testkitTemplateFile:             testkitTemplate,
+	rpcTemplateFile:                 rpcTemplateText,
+	validateTemplateFile:            validateTemplate,
+	validateInterceptorTemplateFile: validateInterceptorTemplate,
 }
 
 // GenTemplates is the entry for command goctl template,
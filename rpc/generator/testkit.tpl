@@ -0,0 +1,60 @@
+{{.head}}
+
+package testkit
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	{{.imports}}
+
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1 << 20
+
+// Start starts the server with the service context over bufconn and returns the client connected
+// to it, the server is stopped when the test finishes.
+func Start(t testing.TB, svcCtx *svc.ServiceContext) zrpc.Client {
+	t.Helper()
+
+	var (
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+{{if .validate}}	unaryInterceptors = append(unaryInterceptors, interceptor.ValidateInterceptor)
+{{end}}{{if .interceptors}}	unaryInterceptors = append(unaryInterceptors, svcCtx.UnaryInterceptors...)
+	streamInterceptors = append(streamInterceptors, svcCtx.StreamInterceptors...)
+{{end}}	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
+{{range .services}}	{{.Pkg}}.Register{{.Service}}Server(grpcServer, {{.ServerPkg}}.New{{.Service}}Server(svcCtx))
+{{end}}
+	listener := bufconn.Listen(bufSize)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	cli, err := zrpc.NewClientWithTarget("bufnet", zrpc.WithDialOption(grpc.WithContextDialer(
+		func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cli.Conn().Close()
+	})
+
+	return cli
+}
+{{range .services}}
+// New{{.Service}}Client starts the server with the service context over bufconn and returns the
+// client of {{.Service}}.
+func New{{.Service}}Client(t testing.TB, svcCtx *svc.ServiceContext) {{.CallPkg}}.{{.Service}} {
+	t.Helper()
+
+	return {{.CallPkg}}.New{{.Service}}(Start(t, svcCtx))
+}
+{{end}}
@@ -0,0 +1,71 @@
+{{.head}}
+
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	validator interface {
+		Validate() error
+	}
+
+	// fieldError is the validation error of a field, like the errors of protoc-gen-validate
+	fieldError interface {
+		Field() string
+		Reason() string
+	}
+
+	causer interface {
+		Cause() error
+	}
+)
+
+// ValidateInterceptor rejects the invalid requests with codes.InvalidArgument, the field
+// violation is described in the status details as errdetails.BadRequest.
+func ValidateInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if v, ok := req.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func invalidArgument(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	violation := &errdetails.BadRequest_FieldViolation{Description: err.Error()}
+	var path []string
+	for err != nil {
+		fe, ok := err.(fieldError)
+		if !ok {
+			break
+		}
+
+		path = append(path, fe.Field())
+		violation.Description = fe.Reason()
+		c, ok := err.(causer)
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	violation.Field = strings.Join(path, ".")
+
+	detailed, e := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{violation},
+	})
+	if e != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,46 @@
+{{.head}}
+
+package {{.pkg}}
+
+import (
+	{{.imports}}
+)
+
+{{if .vars}}var (
+	{{.vars}}
+)
+{{end}}
+{{range .messages}}
+// Validate checks the field values on {{.Name}} with the rules defined in the proto definition,
+// the first violation is returned as {{.Name}}ValidationError.
+func (m *{{.Name}}) Validate() error {
+	if m == nil {
+		return nil
+	}
+{{.Body}}
+	return nil
+}
+
+// {{.Name}}ValidationError is the validation error returned by {{.Name}}.Validate.
+type {{.Name}}ValidationError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+// Field returns the name of the invalid field.
+func (e {{.Name}}ValidationError) Field() string { return e.field }
+
+// Reason returns the reason of the violation.
+func (e {{.Name}}ValidationError) Reason() string { return e.reason }
+
+// Cause returns the validation error of the embedded message.
+func (e {{.Name}}ValidationError) Cause() error { return e.cause }
+
+func (e {{.Name}}ValidationError) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("invalid {{.Name}}.%s: %s | caused by: %v", e.field, e.reason, e.cause)
+	}
+	return fmt.Sprintf("invalid {{.Name}}.%s: %s", e.field, e.reason)
+}
+{{end}}
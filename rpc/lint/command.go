@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"github.com/yeyudekuangxiang/goctl/api/validate"
+)
+
+var (
+	// VarStringFormat describes the output format of diagnostics, text, json or sarif.
+	VarStringFormat string
+	// VarStringSliceProtoPath describes the paths to search the imports.
+	VarStringSliceProtoPath []string
+)
+
+// LintCommand checks the proto files or the proto files in the directories given by the
+// arguments, the current directory is checked if no argument is given, only the report is written
+// to the output.
+func LintCommand(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	var files []string
+	for _, each := range args {
+		list, err := protoFiles(each)
+		if err != nil {
+			return err
+		}
+		files = append(files, list...)
+	}
+
+	var diagnostics spec.Diagnostics
+	for _, each := range files {
+		diagnostics = append(diagnostics, Lint(each, VarStringSliceProtoPath...)...)
+	}
+
+	if err := validate.Print(cmd.OutOrStdout(), VarStringFormat, diagnostics); err != nil {
+		return err
+	}
+
+	if diagnostics.HasError() {
+		return fmt.Errorf("%d problem(s) found", len(diagnostics))
+	}
+
+	if VarStringFormat == "" || VarStringFormat == "text" {
+		fmt.Fprintln(cmd.OutOrStdout(), aurora.Green("proto lint ok"))
+	}
+	return nil
+}
+
+func protoFiles(source string) ([]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{source}, nil
+	}
+
+	var files []string
+	err = filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && filepath.Ext(path) == ".proto" {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
@@ -0,0 +1,394 @@
+// Package lint checks the style and the consistency of the proto files.
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// the well known types are resolved from the registry
+	_ "google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Diagnostic codes reported by the linter.
+const (
+	CodeSyntax        = "syntax"
+	CodeServiceName   = "service-name"
+	CodeRPCName       = "rpc-name"
+	CodeMessageName   = "message-name"
+	CodeFieldName     = "field-name"
+	CodeEnumName      = "enum-name"
+	CodeEnumValueName = "enum-value-name"
+	CodeGoPackage     = "go-package"
+	CodeFieldNumber   = "field-number"
+	CodeUnusedImport  = "unused-import"
+)
+
+const (
+	wellKnownPrefix = "google/protobuf/"
+	maxFieldNumber  = 536870911
+	// the field numbers 19000 to 19999 are reserved for the protobuf implementation
+	minImplementationNumber = 19000
+	maxImplementationNumber = 19999
+)
+
+var (
+	upperCamelCase  = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+	lowerSnakeCase  = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+	upperSnakeCase  = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+	optionReference = regexp.MustCompile(`^\(\.?([^)]+)\)`)
+	// the errors of the parser are prefixed by the positions like file:line:column
+	errorPosition = regexp.MustCompile(`^.*?:(\d+):(\d+): `)
+)
+
+type linter struct {
+	file        string
+	protoPaths  []string
+	pkg         string
+	references  []string
+	diagnostics spec.Diagnostics
+}
+
+// Lint checks the proto file, the imports are searched in the proto paths and the directory
+// of the file.
+func Lint(filename string, protoPaths ...string) spec.Diagnostics {
+	l := &linter{
+		file:       filename,
+		protoPaths: append(append([]string(nil), protoPaths...), filepath.Dir(filename)),
+	}
+
+	set, err := parse(filename)
+	if err != nil {
+		line, column, message := syntaxError(err)
+		l.add(line, column, spec.SeverityError, CodeSyntax, "%s", message)
+		return l.diagnostics
+	}
+
+	l.lint(set)
+	l.diagnostics.Sort()
+	return l.diagnostics
+}
+
+func (l *linter) lint(set *proto.Proto) {
+	var (
+		imports   []*proto.Import
+		goPackage bool
+	)
+	for _, element := range set.Elements {
+		switch e := element.(type) {
+		case *proto.Package:
+			l.pkg = e.Name
+		case *proto.Import:
+			imports = append(imports, e)
+		case *proto.Option:
+			if e.Name == "go_package" {
+				goPackage = true
+			}
+		}
+	}
+	if !goPackage {
+		l.add(1, 1, spec.SeverityError, CodeGoPackage, "missing option go_package")
+	}
+
+	l.elements(set.Elements)
+	for _, each := range imports {
+		l.checkImport(each)
+	}
+}
+
+func (l *linter) elements(elements []proto.Visitee) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *proto.Service:
+			l.checkName(e.Position, upperCamelCase, CodeServiceName, "service", e.Name, "UpperCamelCase")
+			l.elements(e.Elements)
+		case *proto.RPC:
+			l.checkName(e.Position, upperCamelCase, CodeRPCName, "rpc", e.Name, "UpperCamelCase")
+			l.references = append(l.references, e.RequestType, e.ReturnsType)
+			l.elements(e.Elements)
+		case *proto.Message:
+			if e.IsExtend {
+				l.references = append(l.references, e.Name)
+			} else {
+				l.checkName(e.Position, upperCamelCase, CodeMessageName, "message", e.Name,
+					"UpperCamelCase")
+				l.checkNumbers(e)
+			}
+			l.elements(e.Elements)
+		case *proto.Oneof:
+			l.elements(e.Elements)
+		case *proto.NormalField:
+			l.field(e.Field)
+		case *proto.MapField:
+			l.field(e.Field)
+		case *proto.OneOfField:
+			l.field(e.Field)
+		case *proto.Enum:
+			l.checkName(e.Position, upperCamelCase, CodeEnumName, "enum", e.Name, "UpperCamelCase")
+			l.elements(e.Elements)
+		case *proto.EnumField:
+			l.checkName(e.Position, upperSnakeCase, CodeEnumValueName, "enum value", e.Name,
+				"UPPER_SNAKE_CASE")
+			l.elements(e.Elements)
+		case *proto.Option:
+			l.option(e.Name)
+		}
+	}
+}
+
+func (l *linter) field(field *proto.Field) {
+	l.checkName(field.Position, lowerSnakeCase, CodeFieldName, "field", field.Name, "lower_snake_case")
+	l.references = append(l.references, field.Type)
+	for _, each := range field.Options {
+		l.option(each.Name)
+	}
+}
+
+func (l *linter) option(name string) {
+	if match := optionReference.FindStringSubmatch(name); len(match) > 1 {
+		l.references = append(l.references, match[1])
+	}
+}
+
+func (l *linter) checkName(pos scanner.Position, pattern *regexp.Regexp, code, kind, name, style string) {
+	if !pattern.MatchString(name) {
+		l.add(pos.Line, pos.Column, spec.SeverityWarning, code, "%s %s should be %s", kind, name, style)
+	}
+}
+
+// checkNumbers checks the field numbers of the message are unique, valid and not reserved
+func (l *linter) checkNumbers(message *proto.Message) {
+	var (
+		ranges []proto.Range
+		names  = make(map[string]bool)
+	)
+	for _, element := range message.Elements {
+		if reserved, ok := element.(*proto.Reserved); ok {
+			ranges = append(ranges, reserved.Ranges...)
+			for _, each := range reserved.FieldNames {
+				names[each] = true
+			}
+		}
+	}
+
+	used := make(map[int]string)
+	var check func(elements []proto.Visitee)
+	check = func(elements []proto.Visitee) {
+		for _, element := range elements {
+			var field *proto.Field
+			switch e := element.(type) {
+			case *proto.NormalField:
+				field = e.Field
+			case *proto.MapField:
+				field = e.Field
+			case *proto.OneOfField:
+				field = e.Field
+			case *proto.Oneof:
+				check(e.Elements)
+				continue
+			default:
+				continue
+			}
+
+			pos, number := field.Position, field.Sequence
+			if other, ok := used[number]; ok {
+				l.add(pos.Line, pos.Column, spec.SeverityError, CodeFieldNumber,
+					"field %s reuses the number %d of field %s", field.Name, number, other)
+			} else {
+				used[number] = field.Name
+			}
+
+			switch {
+			case number < 1 || number > maxFieldNumber:
+				l.add(pos.Line, pos.Column, spec.SeverityError, CodeFieldNumber,
+					"field %s has the invalid number %d", field.Name, number)
+			case number >= minImplementationNumber && number <= maxImplementationNumber:
+				l.add(pos.Line, pos.Column, spec.SeverityError, CodeFieldNumber,
+					"field %s uses the number %d reserved for the protobuf implementation", field.Name, number)
+			case reservedNumber(ranges, number):
+				l.add(pos.Line, pos.Column, spec.SeverityError, CodeFieldNumber,
+					"field %s uses the reserved number %d", field.Name, number)
+			}
+			if names[field.Name] {
+				l.add(pos.Line, pos.Column, spec.SeverityError, CodeFieldNumber,
+					"field %s uses a reserved name", field.Name)
+			}
+		}
+	}
+	check(message.Elements)
+}
+
+// checkImport reports the import if none of the types and the extensions it defines is referred,
+// the imports which are not found are ignored.
+func (l *linter) checkImport(imp *proto.Import) {
+	if imp.Kind == "public" {
+		return
+	}
+
+	symbols, ok := l.load(imp.Filename)
+	if !ok {
+		return
+	}
+
+	for _, reference := range l.references {
+		for _, each := range l.candidates(reference) {
+			if symbols[each] {
+				return
+			}
+		}
+	}
+
+	l.add(imp.Position.Line, imp.Position.Column, spec.SeverityWarning, CodeUnusedImport,
+		"import %q is not used", imp.Filename)
+}
+
+// candidates returns the fully qualified names which the reference may be resolved to in the
+// package of the file.
+func (l *linter) candidates(reference string) []string {
+	if strings.HasPrefix(reference, ".") {
+		return []string{reference[1:]}
+	}
+
+	ret := []string{reference}
+	scope := l.pkg
+	for len(scope) > 0 {
+		ret = append(ret, scope+"."+reference)
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+	return ret
+}
+
+// load returns the fully qualified names of the types and the extensions defined in the file
+func (l *linter) load(name string) (map[string]bool, bool) {
+	symbols := make(map[string]bool)
+	if strings.HasPrefix(name, wellKnownPrefix) {
+		if desc, err := protoregistry.GlobalFiles.FindFileByPath(name); err == nil {
+			addDescriptors(symbols, desc.Messages(), desc.Enums())
+			for i := 0; i < desc.Extensions().Len(); i++ {
+				symbols[string(desc.Extensions().Get(i).FullName())] = true
+			}
+			return symbols, true
+		}
+	}
+
+	for _, each := range l.protoPaths {
+		set, err := parse(filepath.Join(each, filepath.FromSlash(name)))
+		if err != nil {
+			continue
+		}
+
+		var pkg string
+		proto.Walk(set,
+			proto.WithPackage(func(p *proto.Package) {
+				pkg = p.Name
+			}),
+		)
+		prefix := ""
+		if len(pkg) > 0 {
+			prefix = pkg + "."
+		}
+		proto.Walk(set,
+			proto.WithMessage(func(message *proto.Message) {
+				if !message.IsExtend {
+					symbols[prefix+scopeName(message.Parent, message.Name)] = true
+					return
+				}
+				for _, element := range message.Elements {
+					if field, ok := element.(*proto.NormalField); ok {
+						symbols[prefix+field.Name] = true
+					}
+				}
+			}),
+			proto.WithEnum(func(enum *proto.Enum) {
+				symbols[prefix+scopeName(enum.Parent, enum.Name)] = true
+			}),
+		)
+		return symbols, true
+	}
+
+	return nil, false
+}
+
+func (l *linter) add(line, column int, severity spec.Severity, code, format string, args ...interface{}) {
+	diagnostic := spec.NewDiagnostic(l.file, line, column, code, format, args...)
+	diagnostic.Severity = severity
+	l.diagnostics = append(l.diagnostics, diagnostic)
+}
+
+func addDescriptors(symbols map[string]bool, messages protoreflect.MessageDescriptors,
+	enums protoreflect.EnumDescriptors) {
+	for i := 0; i < enums.Len(); i++ {
+		symbols[string(enums.Get(i).FullName())] = true
+	}
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		symbols[string(md.FullName())] = true
+		addDescriptors(symbols, md.Messages(), md.Enums())
+	}
+}
+
+func reservedNumber(ranges []proto.Range, number int) bool {
+	for _, each := range ranges {
+		if number >= each.From && (each.Max || number <= each.To) {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeName returns the name qualified by the enclosing messages
+func scopeName(parent proto.Visitee, name string) string {
+	for {
+		message, ok := parent.(*proto.Message)
+		if !ok {
+			return name
+		}
+
+		name = message.Name + "." + name
+		parent = message.Parent
+	}
+}
+
+// syntaxError splits the error of the parser into the position and the message
+func syntaxError(err error) (int, int, string) {
+	message := err.Error()
+	match := errorPosition.FindStringSubmatch(message)
+	if len(match) == 0 {
+		return 0, 0, message
+	}
+
+	line, _ := strconv.Atoi(match[1])
+	column, _ := strconv.Atoi(match[2])
+	return line, column, message[len(match[0]):]
+}
+
+func parse(filename string) (*proto.Proto, error) {
+	r, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	p := proto.NewParser(r)
+	p.Filename(filename)
+	return p.Parse()
+}
@@ -0,0 +1,142 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/api/spec"
+)
+
+const badProto = `syntax = "proto3";
+
+package demo;
+
+import "google/protobuf/timestamp.proto";
+import "google/protobuf/empty.proto";
+
+message user_info {
+  reserved 5, 8 to 10;
+  reserved "old";
+  string Name = 1;
+  int64 age = 2;
+  string email = 2;
+  string nick = 9;
+  string old = 3;
+  google.protobuf.Timestamp created_at = 4;
+  oneof contact {
+    string phone = 1;
+  }
+}
+
+enum Kind {
+  unknown = 0;
+}
+
+service greet {
+  rpc say_hello(user_info) returns (user_info);
+}
+`
+
+const goodProto = `syntax = "proto3";
+
+package demo;
+
+option go_package = "./demo";
+
+import "options.proto";
+import "google/protobuf/empty.proto";
+
+message UserInfo {
+  option (demo.options.table) = "users";
+
+  string name = 1;
+}
+
+service Greet {
+  rpc SayHello(UserInfo) returns (google.protobuf.Empty);
+}
+`
+
+const optionsProto = `syntax = "proto2";
+
+package demo.options;
+
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.MessageOptions {
+  optional string table = 51000;
+}
+`
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	filename := filepath.Join(dir, name)
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), os.ModePerm))
+	return filename
+}
+
+func TestLint(t *testing.T) {
+	filename := writeFile(t, t.TempDir(), "demo.proto", badProto)
+	diagnostics := Lint(filename)
+
+	type result struct {
+		line     int
+		severity spec.Severity
+		code     string
+	}
+	var results []result
+	for _, each := range diagnostics {
+		assert.Equal(t, filename, each.File)
+		results = append(results, result{line: each.Line, severity: each.Severity, code: each.Code})
+	}
+	assert.Equal(t, []result{
+		{1, spec.SeverityError, CodeGoPackage},
+		{6, spec.SeverityWarning, CodeUnusedImport},
+		{8, spec.SeverityWarning, CodeMessageName},
+		{11, spec.SeverityWarning, CodeFieldName},
+		{13, spec.SeverityError, CodeFieldNumber},
+		{14, spec.SeverityError, CodeFieldNumber},
+		{15, spec.SeverityError, CodeFieldNumber},
+		{18, spec.SeverityError, CodeFieldNumber},
+		{23, spec.SeverityWarning, CodeEnumValueName},
+		{26, spec.SeverityWarning, CodeServiceName},
+		{27, spec.SeverityWarning, CodeRPCName},
+	}, results)
+	assert.True(t, diagnostics.HasError())
+}
+
+func TestLintCommand(t *testing.T) {
+	filename := writeFile(t, t.TempDir(), "demo.proto", badProto)
+	VarStringFormat = "json"
+	defer func() {
+		VarStringFormat = ""
+	}()
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	assert.NotNil(t, LintCommand(cmd, []string{filename}))
+	// the error of the problems is not mixed with the report
+	assert.True(t, json.Valid(out.Bytes()))
+}
+
+func TestLintGood(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "options.proto", optionsProto)
+	diagnostics := Lint(writeFile(t, dir, "demo.proto", goodProto))
+	assert.Empty(t, diagnostics)
+}
+
+func TestLintSyntax(t *testing.T) {
+	filename := writeFile(t, t.TempDir(), "demo.proto", "syntax = \"proto3\";\n\nmessage A {\n  string a = ;\n}\n")
+	diagnostics := Lint(filename)
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, CodeSyntax, diagnostics[0].Code)
+	assert.Equal(t, 4, diagnostics[0].Line)
+	assert.Contains(t, diagnostics[0].Message, "expected")
+}
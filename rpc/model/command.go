@@ -0,0 +1,167 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yeyudekuangxiang/goctl/config"
+	"github.com/yeyudekuangxiang/goctl/model/sql/gen"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/console"
+	"github.com/yeyudekuangxiang/goctl/util/ctx"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+)
+
+const dialectMysql = "mysql"
+
+var (
+	// VarStringProto describes the proto file.
+	VarStringProto string
+	// VarStringSliceMessage describes the messages which the tables are derived from.
+	VarStringSliceMessage []string
+	// VarStringDialect describes the sql dialect.
+	VarStringDialect string
+	// VarStringDir describes the output directory of the models.
+	VarStringDir string
+	// VarStringPbPackage describes the import path of the go package of the proto file.
+	VarStringPbPackage string
+	// VarBoolCache describes whether the cache is enabled.
+	VarBoolCache bool
+	// VarStringStyle describes the style.
+	VarStringStyle string
+	// VarStringDatabase describes the database.
+	VarStringDatabase string
+	// VarStringApp describes the name of the application.
+	VarStringApp string
+	// VarStringHome describes the goctl home.
+	VarStringHome string
+	// VarStringRemote describes the remote git repository.
+	VarStringRemote string
+	// VarStringBranch describes the git branch of the repository.
+	VarStringBranch string
+)
+
+// ModelCommand derives the tables from the messages of the proto file, generates the models of
+// the tables and the converters between the models and the messages.
+func ModelCommand(_ *cobra.Command, _ []string) error {
+	if len(VarStringProto) == 0 {
+		return errors.New("missing --proto")
+	}
+	if len(VarStringSliceMessage) == 0 {
+		return errors.New("missing --message")
+	}
+	if VarStringDialect != dialectMysql {
+		return fmt.Errorf("unsupported dialect %q, expected %s", VarStringDialect, dialectMysql)
+	}
+	if len(VarStringApp) == 0 {
+		return errors.New("missing --app")
+	}
+
+	home := VarStringHome
+	if len(VarStringRemote) > 0 {
+		repo, _ := util.CloneIntoGitHome(VarStringRemote, VarStringBranch)
+		if len(repo) > 0 {
+			home = repo
+		}
+	}
+	if len(home) > 0 {
+		pathx.RegisterGoctlHome(home)
+	}
+
+	cfg, err := config.NewConfig(VarStringStyle)
+	if err != nil {
+		return err
+	}
+
+	file, err := LoadFile(VarStringProto)
+	if err != nil {
+		return err
+	}
+
+	pbPath := VarStringPbPackage
+	if len(pbPath) == 0 {
+		pbPath, err = pbImportPath(file)
+		if err != nil {
+			return err
+		}
+	}
+
+	dir, err := filepath.Abs(VarStringDir)
+	if err != nil {
+		return err
+	}
+	if err = pathx.MkdirIfNotExist(dir); err != nil {
+		return err
+	}
+
+	log := console.NewColorConsole()
+	generator, err := gen.NewDefaultGenerator(VarStringApp, dir, cfg, gen.WithConsoleOption(log))
+	if err != nil {
+		return err
+	}
+
+	converter := &Converter{
+		Dir:    dir,
+		Cfg:    cfg,
+		File:   file,
+		PbPath: pbPath,
+	}
+	for _, message := range VarStringSliceMessage {
+		table, err := file.Table(message)
+		if err != nil {
+			return err
+		}
+
+		ddl := filepath.Join(dir, table.Name+".sql")
+		if err = os.WriteFile(ddl, []byte(table.DDL()), 0o644); err != nil {
+			return err
+		}
+
+		if err = generator.StartFromDDL(ddl, VarBoolCache, VarStringDatabase); err != nil {
+			return err
+		}
+
+		if err = converter.Generate(table, ddl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pbImportPath returns the import path of the go package of the proto file, the relative go
+// package is resolved from the directory of the proto file.
+func pbImportPath(file *File) (string, error) {
+	goPackage := file.GoPackage
+	if i := strings.Index(goPackage, ";"); i >= 0 {
+		goPackage = goPackage[:i]
+	}
+	if len(goPackage) == 0 {
+		return "", errors.New("missing option go_package in the proto file, or set --pb_package")
+	}
+	if !strings.HasPrefix(goPackage, ".") {
+		return goPackage, nil
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(file.Name))
+	if err != nil {
+		return "", err
+	}
+
+	projectCtx, err := ctx.Prepare(dir)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(projectCtx.Dir, filepath.Join(dir, goPackage))
+	if err != nil {
+		return "", err
+	}
+
+	return path.Join(projectCtx.Path, filepath.ToSlash(rel)), nil
+}
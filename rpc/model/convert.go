@@ -0,0 +1,386 @@
+package model
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/yeyudekuangxiang/goctl/config"
+	sqlparser "github.com/yeyudekuangxiang/goctl/model/sql/parser"
+	"github.com/yeyudekuangxiang/goctl/util"
+	"github.com/yeyudekuangxiang/goctl/util/format"
+	"github.com/yeyudekuangxiang/goctl/util/pathx"
+	"github.com/yeyudekuangxiang/goctl/util/stringx"
+)
+
+const (
+	category            = "rpc"
+	convertTemplateFile = "model-convert.tpl"
+
+	importSql         = "database/sql"
+	importJson        = "encoding/json"
+	importTime        = "time"
+	importProto       = "google.golang.org/protobuf/proto"
+	importProtojson   = "google.golang.org/protobuf/encoding/protojson"
+	importTimestamppb = "google.golang.org/protobuf/types/known/timestamppb"
+	importDurationpb  = "google.golang.org/protobuf/types/known/durationpb"
+	importWrapperspb  = "google.golang.org/protobuf/types/known/wrapperspb"
+
+	returnErr = "if err != nil {\nreturn nil, err\n}\n"
+)
+
+//go:embed convert.tpl
+var convertTemplate string
+
+var (
+	pbGoTypes = map[string]string{
+		"double":   "float64",
+		"float":    "float32",
+		"int32":    "int32",
+		"sint32":   "int32",
+		"sfixed32": "int32",
+		"uint32":   "uint32",
+		"fixed32":  "uint32",
+		"int64":    "int64",
+		"sint64":   "int64",
+		"sfixed64": "int64",
+		"uint64":   "uint64",
+		"fixed64":  "uint64",
+		"bool":     "bool",
+		"string":   "string",
+		"bytes":    "[]byte",
+	}
+
+	// nullTypes are the value types and the value fields of the sql null types
+	nullTypes = map[string][2]string{
+		"sql.NullInt64":   {"int64", "Int64"},
+		"sql.NullInt32":   {"int32", "Int32"},
+		"sql.NullFloat64": {"float64", "Float64"},
+		"sql.NullBool":    {"bool", "Bool"},
+		"sql.NullString":  {"string", "String"},
+		"sql.NullTime":    {"time.Time", "Time"},
+	}
+
+	// pointerFuncs are the functions of the proto package which return the pointers of the values
+	pointerFuncs = map[string]string{
+		"float64": "Float64",
+		"float32": "Float32",
+		"int32":   "Int32",
+		"uint32":  "Uint32",
+		"int64":   "Int64",
+		"uint64":  "Uint64",
+		"bool":    "Bool",
+		"string":  "String",
+	}
+
+	wrapperFuncs = map[string]string{
+		"double": "Double",
+		"float":  "Float",
+		"int64":  "Int64",
+		"uint64": "UInt64",
+		"int32":  "Int32",
+		"uint32": "UInt32",
+		"bool":   "Bool",
+		"string": "String",
+		"bytes":  "Bytes",
+	}
+)
+
+type (
+	// Converter generates the functions which convert the models from and to the proto messages
+	Converter struct {
+		Dir    string
+		Cfg    *config.Config
+		File   *File
+		PbPath string
+		pbName string
+	}
+
+	converter struct {
+		pb      string
+		imports map[string]bool
+	}
+
+	// modelField is the accessor of the field of the model
+	modelField struct {
+		name string
+		// tp is the type of the value, it's the wrapped type of the sql null types
+		tp string
+		// null is the field name of the value in the sql null types
+		null string
+	}
+)
+
+// Generate generates the converters of the table from the ddl file which the models are generated from
+func (c *Converter) Generate(table Table, ddl string) error {
+	tables, err := sqlparser.Parse(ddl, "")
+	if err != nil {
+		return err
+	}
+	if len(tables) != 1 {
+		return fmt.Errorf("expected 1 table in %s, got %d", ddl, len(tables))
+	}
+
+	fields := make(map[string]*sqlparser.Field)
+	for _, each := range tables[0].Fields {
+		fields[each.NameOriginal] = each
+	}
+
+	dirAbs, err := filepath.Abs(c.Dir)
+	if err != nil {
+		return err
+	}
+
+	pkg := util.SafeString(filepath.Base(dirAbs))
+	c.pbName = pbPackageName(c.PbPath, c.File.GoPackage)
+	if c.pbName == pkg {
+		c.pbName = "pb"
+	}
+
+	conv := &converter{
+		pb:      c.pbName,
+		imports: make(map[string]bool),
+	}
+	var toPb, fromPb strings.Builder
+	for _, column := range table.Columns {
+		field, ok := fields[column.Name]
+		if !ok {
+			return fmt.Errorf("column %s is not found in %s", column.Name, ddl)
+		}
+
+		to, from, err := conv.column(column, newModelField(field))
+		if err != nil {
+			return fmt.Errorf("column %s: %w", column.Name, err)
+		}
+
+		toPb.WriteString(to)
+		fromPb.WriteString(from)
+	}
+
+	filename, err := format.FileNamingFormat(c.Cfg.NamingFormat, table.Name+"_model_convert")
+	if err != nil {
+		return err
+	}
+
+	text, err := pathx.LoadTemplate(category, convertTemplateFile, convertTemplate)
+	if err != nil {
+		return err
+	}
+
+	return util.With("convert").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+		"head":    util.GetHead(filepath.Base(c.File.Name)),
+		"pkg":     pkg,
+		"imports": conv.importBlock(c.pbName, c.PbPath),
+		"model":   tables[0].Name.ToCamel(),
+		"pb":      c.pbName,
+		"message": table.GoName,
+		"toPb":    toPb.String(),
+		"fromPb":  fromPb.String(),
+	}, filepath.Join(dirAbs, filename+".go"), true)
+}
+
+func newModelField(field *sqlparser.Field) modelField {
+	ret := modelField{
+		name: "." + util.SafeString(field.Name.ToCamel()),
+		tp:   field.DataType,
+	}
+	if null, ok := nullTypes[field.DataType]; ok {
+		ret.tp = null[0]
+		ret.null = null[1]
+	}
+	return ret
+}
+
+// column returns the statements which set the field of the proto message from the model and the
+// statements which set the field of the model from the proto message.
+func (c *converter) column(column Column, field modelField) (string, string, error) {
+	var (
+		pbType = pbGoTypes[column.ProtoType]
+		value  = "m" + field.name
+		valid  string
+		to     string
+		set    string
+		stmts  string
+		expr   string
+		err    error
+	)
+	if len(field.null) > 0 {
+		c.imports[importSql] = true
+		value += "." + field.null
+		valid = "m" + field.name + ".Valid"
+	}
+
+	pbField := column.GoName
+	in := "in." + pbField
+	out := "out." + pbField
+	if column.Kind == kindEnum {
+		pbType = c.pb + "." + column.GoType
+	}
+
+	switch {
+	case column.Type == "json" || column.Repeated || column.Map || column.Kind == kindMessage:
+		if field.tp != "string" {
+			return "", "", fmt.Errorf("the json column is expected to be string, got %s", field.tp)
+		}
+		if len(valid) == 0 {
+			valid = "len(" + value + ") > 0"
+		}
+		to, set, stmts, expr = c.json(column, value, in, out)
+	case column.Kind == kindTimestamp:
+		if field.tp != "time.Time" {
+			return "", "", fmt.Errorf("the timestamp column is expected to be time.Time, got %s", field.tp)
+		}
+		c.imports[importTimestamppb] = true
+		to = fmt.Sprintf("%s = timestamppb.New(%s)\n", out, value)
+		set = in + " != nil"
+		expr = in + ".AsTime()"
+	case column.Kind == kindDuration:
+		c.imports[importTime] = true
+		c.imports[importDurationpb] = true
+		var d string
+		if d, err = convertValue(value, field.tp, "time.Duration"); err != nil {
+			return "", "", err
+		}
+		to = fmt.Sprintf("%s = durationpb.New(%s)\n", out, d)
+		set = in + " != nil"
+		expr, err = convertValue(in+".AsDuration()", "time.Duration", field.tp)
+	case column.Kind == kindWrapper:
+		c.imports[importWrapperspb] = true
+		var v string
+		if v, err = convertValue(value, field.tp, pbType); err != nil {
+			return "", "", err
+		}
+		to = fmt.Sprintf("%s = wrapperspb.%s(%s)\n", out, wrapperFuncs[column.ProtoType], v)
+		set = in + " != nil"
+		expr, err = convertValue(in+".Value", pbType, field.tp)
+	case column.Optional && column.ProtoType != "bytes":
+		var v string
+		if v, err = convertValue(value, field.tp, pbType); err != nil {
+			return "", "", err
+		}
+		if column.Kind == kindEnum {
+			to = fmt.Sprintf("%s = %s.Enum()\n", out, v)
+		} else {
+			c.imports[importProto] = true
+			to = fmt.Sprintf("%s = proto.%s(%s)\n", out, pointerFuncs[pbType], v)
+		}
+		set = in + " != nil"
+		expr, err = convertValue("*"+in, pbType, field.tp)
+	default:
+		var v string
+		if v, err = convertValue(value, field.tp, pbType); err != nil {
+			return "", "", err
+		}
+		to = fmt.Sprintf("%s = %s\n", out, v)
+		if column.Optional {
+			set = in + " != nil"
+		}
+		expr, err = convertValue(in, pbType, field.tp)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(valid) > 0 {
+		to = fmt.Sprintf("if %s {\n%s}\n", valid, to)
+	}
+	if len(field.null) > 0 {
+		expr = fmt.Sprintf("sql.Null%s{%s: %s, Valid: true}", field.null, field.null, expr)
+	}
+	from := fmt.Sprintf("%sout%s = %s\n", stmts, field.name, expr)
+	if len(set) > 0 {
+		from = fmt.Sprintf("if %s {\n%s}\n", set, from)
+	}
+
+	return to, from, nil
+}
+
+// json returns the statements which decode the json column to the field of the proto message, the
+// condition, the statements and the expression which encode the field to the json column.
+func (c *converter) json(column Column, value, in, out string) (to, set, stmts, expr string) {
+	name := stringx.From(column.GoName).Untitle()
+	data := name + "Data"
+	switch {
+	case column.Kind == kindMessage && !column.Repeated:
+		c.imports[importProtojson] = true
+		to = fmt.Sprintf("%s = new(%s.%s)\nif err := protojson.Unmarshal([]byte(%s), %s); err != nil "+
+			"{\nreturn nil, err\n}\n", out, c.pb, column.GoType, value, out)
+		set = in + " != nil"
+		stmts = fmt.Sprintf("%s, err := protojson.Marshal(%s)\n%s", data, in, returnErr)
+	case column.Kind == kindMessage:
+		c.imports[importJson] = true
+		c.imports[importProtojson] = true
+		raw := name + "Raw"
+		to = fmt.Sprintf("var %s []json.RawMessage\nif err := json.Unmarshal([]byte(%s), &%s); err != nil "+
+			"{\nreturn nil, err\n}\nfor _, item := range %s {\neach := new(%s.%s)\n"+
+			"if err := protojson.Unmarshal(item, each); err != nil {\nreturn nil, err\n}\n"+
+			"%s = append(%s, each)\n}\n", raw, value, raw, raw, c.pb, column.GoType, out, out)
+		stmts = fmt.Sprintf("%s := make([]json.RawMessage, 0, len(%s))\nfor _, item := range %s {\n"+
+			"data, err := protojson.Marshal(item)\n%s%s = append(%s, data)\n}\n"+
+			"%s, err := json.Marshal(%s)\n%s", raw, in, in, returnErr, raw, raw, data, raw, returnErr)
+	default:
+		c.imports[importJson] = true
+		to = fmt.Sprintf("if err := json.Unmarshal([]byte(%s), &%s); err != nil {\nreturn nil, err\n}\n",
+			value, out)
+		stmts = fmt.Sprintf("%s, err := json.Marshal(%s)\n%s", data, in, returnErr)
+	}
+
+	return to, set, stmts, "string(" + data + ")"
+}
+
+func (c *converter) importBlock(pbName, pbPath string) string {
+	var std, third []string
+	for each := range c.imports {
+		if strings.Contains(each, ".") {
+			third = append(third, fmt.Sprintf("%q", each))
+		} else {
+			std = append(std, fmt.Sprintf("%q", each))
+		}
+	}
+
+	if pbName != filepath.Base(pbPath) {
+		third = append(third, fmt.Sprintf("%s %q", pbName, pbPath))
+	} else {
+		third = append(third, fmt.Sprintf("%q", pbPath))
+	}
+	sort.Strings(std)
+	sort.Strings(third)
+
+	if len(std) == 0 {
+		return strings.Join(third, "\n")
+	}
+	return strings.Join(std, "\n") + "\n\n" + strings.Join(third, "\n")
+}
+
+// convertValue converts the value between the go types of the model and the proto message
+func convertValue(value, from, to string) (string, error) {
+	if from == to {
+		return value, nil
+	}
+
+	kind := func(tp string) string {
+		switch tp {
+		case "string", "[]byte":
+			return "string"
+		case "bool", "time.Time":
+			return tp
+		default:
+			return "number"
+		}
+	}
+	if kind(from) != kind(to) {
+		return "", fmt.Errorf("cannot convert %s to %s", from, to)
+	}
+
+	return fmt.Sprintf("%s(%s)", to, value), nil
+}
+
+// pbPackageName returns the package name of the go package of the proto file
+func pbPackageName(pbPath, goPackage string) string {
+	if i := strings.Index(goPackage, ";"); i >= 0 {
+		return goPackage[i+1:]
+	}
+	return util.SafeString(strings.ReplaceAll(filepath.Base(pbPath), "-", "_"))
+}
@@ -0,0 +1,29 @@
+{{.head}}
+
+package {{.pkg}}
+
+import (
+	{{.imports}}
+)
+
+// {{.model}}ToPb converts the {{.model}} to {{.pb}}.{{.message}}
+func {{.model}}ToPb(m *{{.model}}) (*{{.pb}}.{{.message}}, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	out := new({{.pb}}.{{.message}})
+	{{.toPb}}
+	return out, nil
+}
+
+// {{.model}}FromPb converts the {{.pb}}.{{.message}} to {{.model}}
+func {{.model}}FromPb(in *{{.pb}}.{{.message}}) (*{{.model}}, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := new({{.model}})
+	{{.fromPb}}
+	return out, nil
+}
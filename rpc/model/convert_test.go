@@ -0,0 +1,68 @@
+package model
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yeyudekuangxiang/goctl/config"
+)
+
+func TestConvert(t *testing.T) {
+	file, err := LoadFile(writeProto(t, userProto))
+	assert.Nil(t, err)
+
+	table, err := file.Table("User")
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/demo"), 0o644))
+	ddl := filepath.Join(dir, "users.sql")
+	assert.Nil(t, ioutil.WriteFile(ddl, []byte(table.DDL()), 0o644))
+
+	cfg, err := config.NewConfig("")
+	assert.Nil(t, err)
+
+	converter := &Converter{
+		Dir:    dir,
+		Cfg:    cfg,
+		File:   file,
+		PbPath: "example.com/demo/pb",
+	}
+	assert.Nil(t, converter.Generate(table, ddl))
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "usersmodelconvert.go"))
+	assert.Nil(t, err)
+	code := string(data)
+	for _, each := range []string{
+		`"example.com/demo/pb"`,
+		"func UsersToPb(m *Users) (*pb.User, error) {",
+		"func UsersFromPb(in *pb.User) (*Users, error) {",
+		"out.Status = pb.User_Status(m.Status)",
+		"out.CreatedAt = timestamppb.New(m.CreatedAt.Time)",
+		"out.CreatedAt = sql.NullTime{Time: in.CreatedAt.AsTime(), Valid: true}",
+		"out.Nickname = wrapperspb.String(m.Nickname.String)",
+		"out.InvitedBy = proto.Int64(m.InvitedBy.Int64)",
+		"out.InvitedBy = sql.NullInt64{Int64: *in.InvitedBy, Valid: true}",
+		"json.Unmarshal([]byte(m.Tags), &out.Tags)",
+		"protojson.Unmarshal([]byte(m.Address.String), out.Address)",
+		"out.Remark = m.Note",
+		"out.Note = in.Remark",
+	} {
+		assert.Contains(t, code, each)
+	}
+}
+
+func TestConvertValue(t *testing.T) {
+	value, err := convertValue("m.Age", "int64", "int32")
+	assert.Nil(t, err)
+	assert.Equal(t, "int32(m.Age)", value)
+
+	value, err = convertValue("m.Name", "string", "string")
+	assert.Nil(t, err)
+	assert.Equal(t, "m.Name", value)
+
+	_, err = convertValue("m.Admin", "int64", "bool")
+	assert.NotNil(t, err)
+}
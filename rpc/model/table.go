@@ -0,0 +1,514 @@
+// Package model derives the tables of mysql from the messages of a proto file, the models are
+// generated from the tables by model/sql/gen with the converters between the messages and them.
+package model
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/yeyudekuangxiang/goctl/rpc/parser"
+	"github.com/yeyudekuangxiang/goctl/util/stringx"
+)
+
+const (
+	optionPrefix = "(goctl.model."
+
+	optionTable      = "table"
+	optionPrimaryKey = "primary_key"
+	optionIndex      = "index"
+	optionUnique     = "unique"
+
+	optionColumn        = "column"
+	optionType          = "type"
+	optionSize          = "size"
+	optionAutoIncrement = "auto_increment"
+	optionNullable      = "nullable"
+	optionIgnore        = "ignore"
+
+	defaultPrimaryKey = "id"
+	defaultSize       = 255
+
+	timestampType = "google.protobuf.Timestamp"
+	durationType  = "google.protobuf.Duration"
+)
+
+const (
+	kindScalar fieldKind = iota
+	kindEnum
+	kindTimestamp
+	kindDuration
+	kindWrapper
+	kindMessage
+)
+
+// wrapperTypes are the wrappers of the well known types by the wrapped scalar types
+var wrapperTypes = map[string]string{
+	"google.protobuf.DoubleValue": "double",
+	"google.protobuf.FloatValue":  "float",
+	"google.protobuf.Int64Value":  "int64",
+	"google.protobuf.UInt64Value": "uint64",
+	"google.protobuf.Int32Value":  "int32",
+	"google.protobuf.UInt32Value": "uint32",
+	"google.protobuf.BoolValue":   "bool",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.BytesValue":  "bytes",
+}
+
+type (
+	fieldKind int
+
+	// Column is a column of the table which is derived from a field of the message
+	Column struct {
+		// Name is the name of the column
+		Name string
+		// Field is the name of the field in the proto file
+		Field string
+		// GoName is the name of the field in the generated go struct
+		GoName string
+		// Type is the column type, like bigint unsigned
+		Type string
+		// ProtoType is the type of the field, it's the wrapped scalar type for the wrappers
+		ProtoType string
+		// GoType is the go type of the enums and the messages in the pb package
+		GoType        string
+		Kind          fieldKind
+		Repeated      bool
+		Map           bool
+		Optional      bool
+		Nullable      bool
+		AutoIncrement bool
+		Comment       string
+	}
+
+	// Table is the table which is derived from a message
+	Table struct {
+		// Message is the name of the message, the names of the nested messages are joined by dots
+		Message string
+		// GoName is the name of the generated go type of the message
+		GoName     string
+		Name       string
+		Columns    []Column
+		PrimaryKey []string
+		Indexes    [][]string
+		Uniques    [][]string
+	}
+
+	// File is the proto file which defines the messages
+	File struct {
+		// Name is the name of the proto file
+		Name      string
+		Package   string
+		GoPackage string
+		messages  map[string]*proto.Message
+		// types are the go names of the messages and the enums by the names in the package
+		types map[string]string
+		enums map[string]bool
+	}
+)
+
+// LoadFile parses the proto file and collects the messages and the enums
+func LoadFile(filename string) (*File, error) {
+	r, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	set, err := proto.NewParser(r).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		Name:     filename,
+		messages: make(map[string]*proto.Message),
+		types:    make(map[string]string),
+		enums:    make(map[string]bool),
+	}
+	proto.Walk(set,
+		proto.WithPackage(func(p *proto.Package) {
+			file.Package = p.Name
+		}),
+		proto.WithOption(func(option *proto.Option) {
+			if option.Name == "go_package" {
+				file.GoPackage = option.Constant.Source
+			}
+		}),
+		proto.WithMessage(func(message *proto.Message) {
+			if message.IsExtend {
+				return
+			}
+
+			names := parentNames(message.Parent)
+			names = append(names, message.Name)
+			file.messages[strings.Join(names, ".")] = message
+			file.types[strings.Join(names, ".")] = goName(names)
+		}),
+		proto.WithEnum(func(enum *proto.Enum) {
+			names := append(parentNames(enum.Parent), enum.Name)
+			file.types[strings.Join(names, ".")] = goName(names)
+			file.enums[strings.Join(names, ".")] = true
+		}),
+	)
+
+	return file, nil
+}
+
+// Table derives the table from the message
+func (f *File) Table(name string) (Table, error) {
+	message, ok := f.messages[name]
+	if !ok {
+		return Table{}, fmt.Errorf("message %q is not found in %s", name, f.Name)
+	}
+
+	table := Table{
+		Message: name,
+		GoName:  f.types[name],
+		Name:    stringx.From(message.Name).ToSnake(),
+	}
+	var primaryKey string
+	for _, element := range message.Elements {
+		switch e := element.(type) {
+		case *proto.Option:
+			switch e.Name {
+			case optionPrefix + optionTable + ")":
+				table.Name = e.Constant.Source
+			case optionPrefix + optionPrimaryKey + ")":
+				primaryKey = e.Constant.Source
+			case optionPrefix + optionIndex + ")":
+				table.Indexes = append(table.Indexes, splitColumns(e.Constant.Source))
+			case optionPrefix + optionUnique + ")":
+				table.Uniques = append(table.Uniques, splitColumns(e.Constant.Source))
+			}
+		case *proto.NormalField:
+			column, ok, err := f.column(name, e.Field, e.Repeated, e.Optional)
+			if err != nil {
+				return Table{}, err
+			}
+			if ok {
+				table.Columns = append(table.Columns, column)
+			}
+		case *proto.MapField:
+			column, ok, err := f.column(name, e.Field, false, false)
+			if err != nil {
+				return Table{}, err
+			}
+			if ok {
+				column.Map = true
+				column.Kind = kindScalar
+				column.Type = "json"
+				if f.messageType(e.Type, name) {
+					return Table{}, fmt.Errorf("line %d: map field %s with message values is not "+
+						"supported", e.Position.Line, e.Name)
+				}
+				table.Columns = append(table.Columns, column)
+			}
+		case *proto.Oneof:
+			return Table{}, fmt.Errorf("line %d: oneof %s is not supported", e.Position.Line, e.Name)
+		}
+	}
+
+	if len(primaryKey) > 0 {
+		table.PrimaryKey = splitColumns(primaryKey)
+	} else if table.column(defaultPrimaryKey) != nil {
+		table.PrimaryKey = []string{defaultPrimaryKey}
+	}
+	if len(table.PrimaryKey) == 0 {
+		return Table{}, fmt.Errorf("message %s has no primary key, add the field id or the "+
+			"option (goctl.model.primary_key)", name)
+	}
+
+	for _, columns := range append(append([][]string{table.PrimaryKey}, table.Indexes...), table.Uniques...) {
+		for _, each := range columns {
+			if table.column(each) == nil {
+				return Table{}, fmt.Errorf("message %s: column %q of the keys is not found", name, each)
+			}
+		}
+	}
+
+	// the integer id is auto increment by default
+	if len(primaryKey) == 0 {
+		id := table.column(defaultPrimaryKey)
+		if id.Kind == kindScalar && strings.Contains(id.Type, "int") && !id.Optional {
+			id.AutoIncrement = true
+		}
+	}
+	for _, each := range table.PrimaryKey {
+		table.column(each).Nullable = false
+	}
+
+	return table, nil
+}
+
+func (f *File) column(scope string, field *proto.Field, repeated, optional bool) (Column, bool, error) {
+	column := Column{
+		Name:      field.Name,
+		Field:     field.Name,
+		GoName:    parser.CamelCase(field.Name),
+		ProtoType: field.Type,
+		Repeated:  repeated,
+		Optional:  optional,
+		Nullable:  optional,
+		Comment:   comment(field),
+	}
+
+	var (
+		size      uint32 = defaultSize
+		tp        string
+		ignore    bool
+		increment bool
+	)
+	for _, option := range field.Options {
+		if !strings.HasPrefix(option.Name, optionPrefix) {
+			continue
+		}
+
+		value := option.Constant.Source
+		switch strings.TrimSuffix(strings.TrimPrefix(option.Name, optionPrefix), ")") {
+		case optionColumn:
+			column.Name = value
+		case optionType:
+			tp = value
+		case optionSize:
+			n, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return Column{}, false, fmt.Errorf("line %d: invalid size %q", option.Position.Line, value)
+			}
+			size = uint32(n)
+		case optionAutoIncrement:
+			increment = value == "true"
+		case optionNullable:
+			column.Nullable = column.Nullable || value == "true"
+		case optionIgnore:
+			ignore = value == "true"
+		}
+	}
+	if ignore {
+		return Column{}, false, nil
+	}
+
+	switch {
+	case parser.IsScalar(field.Type):
+		column.Type = scalarColumnType(field.Type, size)
+	case field.Type == timestampType:
+		column.Kind = kindTimestamp
+		column.Type = "datetime"
+		column.Nullable = true
+	case field.Type == durationType:
+		column.Kind = kindDuration
+		column.Type = "bigint"
+		column.Nullable = true
+	case len(wrapperTypes[field.Type]) > 0:
+		column.Kind = kindWrapper
+		column.ProtoType = wrapperTypes[field.Type]
+		column.Type = scalarColumnType(column.ProtoType, size)
+		column.Nullable = true
+	default:
+		name, ok := f.lookup(field.Type, scope)
+		if !ok {
+			return Column{}, false, fmt.Errorf("line %d: type %s of field %s is not defined in the "+
+				"file, ignore it by (goctl.model.ignore)", field.Position.Line, field.Type, field.Name)
+		}
+
+		column.GoType = f.types[name]
+		if f.enums[name] {
+			column.Kind = kindEnum
+			column.Type = "int"
+		} else {
+			column.Kind = kindMessage
+			column.Type = "json"
+			column.Nullable = !repeated
+		}
+	}
+
+	if repeated && column.Kind != kindMessage {
+		if column.Kind != kindScalar && column.Kind != kindEnum {
+			return Column{}, false, fmt.Errorf("line %d: repeated field %s of %s is not supported",
+				field.Position.Line, field.Name, field.Type)
+		}
+		column.Type = "json"
+	}
+	if len(tp) > 0 {
+		column.Type = tp
+	}
+	column.AutoIncrement = increment
+
+	return column, true, nil
+}
+
+// lookup resolves the type referred in the scope like protoc, the types of the other packages
+// are not resolved.
+func (f *File) lookup(name, scope string) (string, bool) {
+	if len(f.Package) > 0 {
+		name = strings.TrimPrefix(name, "."+f.Package+".")
+		name = strings.TrimPrefix(name, f.Package+".")
+	}
+	for {
+		candidate := name
+		if len(scope) > 0 {
+			candidate = scope + "." + name
+		}
+		if _, ok := f.types[candidate]; ok {
+			return candidate, true
+		}
+		if len(scope) == 0 {
+			return "", false
+		}
+
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+func (f *File) messageType(name, scope string) bool {
+	if parser.IsScalar(name) {
+		return false
+	}
+
+	full, ok := f.lookup(name, scope)
+	return !ok || !f.enums[full]
+}
+
+// DDL returns the create table statement of mysql
+func (t Table) DDL() string {
+	var lines []string
+	for _, column := range t.Columns {
+		line := fmt.Sprintf("  `%s` %s", column.Name, column.Type)
+		switch {
+		case column.Nullable:
+			line += " NULL DEFAULT NULL"
+		case column.AutoIncrement:
+			line += " NOT NULL AUTO_INCREMENT"
+		default:
+			line += " NOT NULL"
+			if value, ok := defaultValue(column.Type); ok {
+				line += " DEFAULT " + value
+			}
+		}
+		if len(column.Comment) > 0 {
+			line += " COMMENT '" + strings.ReplaceAll(column.Comment, "'", "''") + "'"
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, fmt.Sprintf("  PRIMARY KEY (%s)", quoteColumns(t.PrimaryKey)))
+	for _, columns := range t.Uniques {
+		lines = append(lines, fmt.Sprintf("  UNIQUE KEY `uk_%s` (%s)", strings.Join(columns, "_"),
+			quoteColumns(columns)))
+	}
+	for _, columns := range t.Indexes {
+		lines = append(lines, fmt.Sprintf("  KEY `idx_%s` (%s)", strings.Join(columns, "_"),
+			quoteColumns(columns)))
+	}
+
+	return fmt.Sprintf("CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n", t.Name,
+		strings.Join(lines, ",\n"))
+}
+
+func (t Table) column(name string) *Column {
+	for i, each := range t.Columns {
+		if each.Name == name {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+func scalarColumnType(tp string, size uint32) string {
+	switch tp {
+	case "double":
+		return "double"
+	case "float":
+		return "float"
+	case "int32", "sint32", "sfixed32":
+		return "int"
+	case "uint32", "fixed32":
+		return "int unsigned"
+	case "int64", "sint64", "sfixed64":
+		return "bigint"
+	case "uint64", "fixed64":
+		return "bigint unsigned"
+	case "bool":
+		return "bool"
+	case "string":
+		return fmt.Sprintf("varchar(%d)", size)
+	default:
+		return "blob"
+	}
+}
+
+// defaultValue returns the default value of the column type, the blob, text and json columns
+// have no default value in mysql.
+func defaultValue(tp string) (string, bool) {
+	tp = strings.ToLower(tp)
+	switch {
+	case strings.Contains(tp, "blob"), strings.Contains(tp, "text"), tp == "json":
+		return "", false
+	case strings.HasPrefix(tp, "varchar"), strings.HasPrefix(tp, "char"):
+		return "''", true
+	case strings.HasPrefix(tp, "datetime"), strings.HasPrefix(tp, "timestamp"):
+		return "CURRENT_TIMESTAMP", true
+	default:
+		return "0", true
+	}
+}
+
+func splitColumns(s string) []string {
+	var columns []string
+	for _, each := range strings.Split(s, ",") {
+		if each = strings.TrimSpace(each); len(each) > 0 {
+			columns = append(columns, each)
+		}
+	}
+	return columns
+}
+
+func quoteColumns(columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, each := range columns {
+		quoted = append(quoted, "`"+each+"`")
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func comment(field *proto.Field) string {
+	var lines []string
+	for _, c := range []*proto.Comment{field.Comment, field.InlineComment} {
+		if c == nil {
+			continue
+		}
+		for _, line := range c.Lines {
+			if line = strings.TrimSpace(line); len(line) > 0 {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return strings.Join(lines, " ")
+}
+
+func parentNames(parent proto.Visitee) []string {
+	var names []string
+	for {
+		message, ok := parent.(*proto.Message)
+		if !ok {
+			return names
+		}
+
+		names = append([]string{message.Name}, names...)
+		parent = message.Parent
+	}
+}
+
+func goName(names []string) string {
+	var ret []string
+	for _, each := range names {
+		ret = append(ret, parser.CamelCase(each))
+	}
+	return strings.Join(ret, "_")
+}
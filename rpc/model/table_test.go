@@ -0,0 +1,146 @@
+package model
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const userProto = `syntax = "proto3";
+
+package user;
+option go_package = "example.com/demo/pb";
+
+import "goctl/model.proto";
+import "google/protobuf/timestamp.proto";
+import "google/protobuf/wrappers.proto";
+
+message Address {
+  string city = 1;
+}
+
+message User {
+  option (goctl.model.table) = "users";
+  option (goctl.model.unique) = "email";
+  option (goctl.model.index) = "name,status";
+
+  enum Status {
+    UNKNOWN = 0;
+    ACTIVE = 1;
+  }
+
+  uint64 id = 1;
+  // the name of the user
+  string name = 2 [(goctl.model.size) = 64];
+  string email = 3;
+  Status status = 4;
+  google.protobuf.Timestamp created_at = 5;
+  google.protobuf.StringValue nickname = 6;
+  optional int64 invited_by = 7;
+  repeated string tags = 8;
+  Address address = 9;
+  string password = 10 [(goctl.model.ignore) = true];
+  string remark = 11 [(goctl.model.column) = "note", (goctl.model.type) = "text"];
+}
+`
+
+const userDDL = "CREATE TABLE `users` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL DEFAULT '' COMMENT 'the name of the user',\n" +
+	"  `email` varchar(255) NOT NULL DEFAULT '',\n" +
+	"  `status` int NOT NULL DEFAULT 0,\n" +
+	"  `created_at` datetime NULL DEFAULT NULL,\n" +
+	"  `nickname` varchar(255) NULL DEFAULT NULL,\n" +
+	"  `invited_by` bigint NULL DEFAULT NULL,\n" +
+	"  `tags` json NOT NULL,\n" +
+	"  `address` json NULL DEFAULT NULL,\n" +
+	"  `note` text NOT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `uk_email` (`email`),\n" +
+	"  KEY `idx_name_status` (`name`, `status`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n"
+
+func writeProto(t *testing.T, content string) string {
+	filename := filepath.Join(t.TempDir(), "user.proto")
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), 0o644))
+	return filename
+}
+
+func TestTable(t *testing.T) {
+	file, err := LoadFile(writeProto(t, userProto))
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com/demo/pb", file.GoPackage)
+
+	table, err := file.Table("User")
+	assert.Nil(t, err)
+	assert.Equal(t, "User", table.GoName)
+	assert.Equal(t, userDDL, table.DDL())
+
+	status := table.column("status")
+	assert.Equal(t, kindEnum, status.Kind)
+	assert.Equal(t, "User_Status", status.GoType)
+
+	_, err = file.Table("Order")
+	assert.NotNil(t, err)
+}
+
+func TestTableErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+	}{
+		{
+			name:    "no primary key",
+			message: "message User {\n  string name = 1;\n}",
+		},
+		{
+			name: "unknown key column",
+			message: "message User {\n  option (goctl.model.index) = \"age\";\n" +
+				"  int64 id = 1;\n}",
+		},
+		{
+			name:    "oneof",
+			message: "message User {\n  int64 id = 1;\n  oneof contact {\n    string email = 2;\n  }\n}",
+		},
+		{
+			name:    "imported message",
+			message: "message User {\n  int64 id = 1;\n  other.Profile profile = 2;\n}",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := LoadFile(writeProto(t, "syntax = \"proto3\";\n\npackage user;\n\n"+test.message))
+			assert.Nil(t, err)
+
+			_, err = file.Table("User")
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestNestedTable(t *testing.T) {
+	file, err := LoadFile(writeProto(t, `syntax = "proto3";
+
+package user;
+
+message Outer {
+  message Inner {
+    enum Kind {
+      A = 0;
+    }
+    string id = 1;
+    Kind kind = 2;
+  }
+}
+`))
+	assert.Nil(t, err)
+
+	table, err := file.Table("Outer.Inner")
+	assert.Nil(t, err)
+	assert.Equal(t, "inner", table.Name)
+	assert.Equal(t, "Outer_Inner", table.GoName)
+	assert.Equal(t, "Outer_Inner_Kind", table.column("kind").GoType)
+	assert.False(t, table.column("id").AutoIncrement)
+}
@@ -12,13 +12,19 @@ import (
 )
 
 type (
-	// DefaultProtoParser types an empty struct
-	DefaultProtoParser struct{}
+	// DefaultProtoParser parses the proto file and resolves its imports in the proto paths
+	DefaultProtoParser struct {
+		protoPaths []string
+	}
 )
 
-// NewDefaultProtoParser creates a new instance
-func NewDefaultProtoParser() *DefaultProtoParser {
-	return &DefaultProtoParser{}
+// NewDefaultProtoParser creates a new instance, the imports are searched in the proto paths
+// like --proto_path of protoc, the current directory is used if no proto path is given.
+func NewDefaultProtoParser(protoPaths ...string) *DefaultProtoParser {
+	if len(protoPaths) == 0 {
+		protoPaths = []string{"."}
+	}
+	return &DefaultProtoParser{protoPaths: protoPaths}
 }
 
 // Parse provides to parse the proto file into a golang structure,
@@ -74,7 +80,7 @@ func (p *DefaultProtoParser) Parse(src string, multiple ...bool) (Proto, error)
 			}
 		}),
 	)
-	if err = serviceList.validate(abs, multiple...); err != nil {
+	if err = serviceList.validate(multiple...); err != nil {
 		return ret, err
 	}
 
@@ -82,10 +88,20 @@ func (p *DefaultProtoParser) Parse(src string, multiple ...bool) (Proto, error)
 		ret.GoPackage = ret.Package.Name
 	}
 
-	ret.PbPackage = GoSanitized(filepath.Base(ret.GoPackage))
+	// the go_package may be like github.com/foo/bar;baz
+	_, ret.PbPackage = splitGoPackage(ret.GoPackage, ret.Package.Name)
 	ret.Src = abs
 	ret.Name = filepath.Base(abs)
 	ret.Service = serviceList
+	ret.Files, ret.Symbols = loadSymbols(p.protoPaths, &ret, set)
+	if err = ret.resolve(); err != nil {
+		return ret, err
+	}
+
+	ret.Validation, err = parseFilesValidation(ret, set)
+	if err != nil {
+		return ret, err
+	}
 
 	return ret, nil
 }
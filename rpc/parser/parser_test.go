@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -81,3 +83,159 @@ func TestDefaultProtoParse_Option2(t *testing.T) {
 	assert.Equal(t, "stream", data.GoPackage)
 	assert.Equal(t, "stream", data.PbPackage)
 }
+
+func TestDefaultProtoParse_GoPackageName(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "user.proto")
+	err := os.WriteFile(filename, []byte(`syntax = "proto3";
+package user.v1;
+option go_package = "github.com/zeromicro/gen/user/v1;userv1";
+message Req {}
+service User {
+  rpc Get(Req) returns (Req);
+}`), 0o644)
+	assert.Nil(t, err)
+
+	data, err := NewDefaultProtoParser().Parse(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "userv1", data.PbPackage)
+	assert.Equal(t, "userv1", data.Service[0].RPC[0].Request.PbPackage)
+}
+
+func TestDefaultProtoParse_Import(t *testing.T) {
+	p := NewDefaultProtoParser(".")
+	data, err := p.Parse("./test_import.proto")
+	assert.Nil(t, err)
+	assert.Equal(t, []File{
+		{
+			Name:      "test_types.proto",
+			Src:       "test_types.proto",
+			Package:   "test",
+			GoPackage: "go",
+			PbPackage: "_go",
+		},
+		{
+			Name:      "shared/shared.proto",
+			Src:       "shared/shared.proto",
+			Package:   "shared",
+			GoPackage: "github.com/zeromicro/shared",
+			PbPackage: "sharedpb",
+		},
+		{
+			Name:      "google/protobuf/empty.proto",
+			Package:   "google.protobuf",
+			GoPackage: "google.golang.org/protobuf/types/known/emptypb",
+			PbPackage: "emptypb",
+		},
+	}, data.Files)
+
+	rpc := data.Service[0].RPC
+	assert.Equal(t, Symbol{
+		FullName:  "test.TypesReq",
+		GoName:    "TypesReq",
+		File:      "test_types.proto",
+		GoPackage: "go",
+		PbPackage: "_go",
+	}, rpc[0].Request)
+	assert.True(t, data.SameGoPackage(rpc[0].Request.GoPackage))
+	assert.Equal(t, "TypesReply_Item", rpc[1].Returns.GoName)
+	assert.Equal(t, "sharedpb", rpc[2].Request.PbPackage)
+	assert.Equal(t, "Page", rpc[2].Request.GoName)
+	assert.False(t, data.SameGoPackage(rpc[2].Request.GoPackage))
+	assert.Equal(t, "emptypb", rpc[2].Returns.PbPackage)
+	assert.Equal(t, "Empty", rpc[2].Returns.GoName)
+}
+
+func TestSymbolTable_Lookup(t *testing.T) {
+	symbols := SymbolTable{
+		"a.b.Foo":     {FullName: "a.b.Foo"},
+		"a.Foo":       {FullName: "a.Foo"},
+		"a.Foo.Inner": {FullName: "a.Foo.Inner"},
+		"Bar":         {FullName: "Bar"},
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		expected string
+	}{
+		{name: "Foo", scope: "a.b", expected: "a.b.Foo"},
+		{name: "Foo", scope: "a", expected: "a.Foo"},
+		{name: ".a.Foo", scope: "a.b", expected: "a.Foo"},
+		{name: "Foo.Inner", scope: "a", expected: "a.Foo.Inner"},
+		{name: "Bar", scope: "a.b", expected: "Bar"},
+		{name: "Baz", scope: "a.b"},
+	}
+	for _, test := range tests {
+		symbol, ok := symbols.Lookup(test.name, test.scope)
+		assert.Equal(t, len(test.expected) > 0, ok)
+		assert.Equal(t, test.expected, symbol.FullName)
+	}
+}
+
+func TestDefaultProtoParse_Validation(t *testing.T) {
+	p := NewDefaultProtoParser()
+	data, err := p.Parse("./test_validate.proto")
+	assert.Nil(t, err)
+	assert.True(t, data.HasValidation())
+	assert.Equal(t, []MessageValidation{
+		{
+			Name:     "Page",
+			GoName:   "Page",
+			Disabled: true,
+			Fields: []FieldValidation{
+				{Name: "num", GoName: "Num", Type: "int32", Rules: []Rule{
+					{Type: "int32", Name: "gte", Values: []string{"1"}},
+				}},
+			},
+		},
+		{
+			Name:   "Req",
+			GoName: "Req",
+			Fields: []FieldValidation{
+				{Name: "name", GoName: "Name", Type: "string", Rules: []Rule{
+					{Type: "string", Name: "min_len", Values: []string{"1"}},
+					{Type: "string", Name: "pattern", Values: []string{`"^\\w+$"`}},
+					{Type: "string", Name: "in", Values: []string{`"a"`, `"b"`}},
+				}},
+				{Name: "page", GoName: "Page", Type: "Page", Message: true, Rules: []Rule{
+					{Name: "required", Values: []string{"true"}},
+				}},
+				{Name: "pages", GoName: "Pages", Type: "Page", Repeated: true, Message: true},
+				{Name: "age", GoName: "Age", Type: "int64", Optional: true, Rules: []Rule{
+					{Type: "int64", Name: "gt", Values: []string{"0"}},
+					{Type: "int64", Name: "not_in", Values: []string{"1", "2"}},
+				}},
+				{Name: "phone", GoName: "Phone", Type: "string", OneOf: "contact", Rules: []Rule{
+					{Type: "string", Name: "prefix", Values: []string{`"+"`}},
+					{Type: "string", Name: "in", Values: []string{`"c"`}},
+				}},
+			},
+		},
+	}, data.Validation)
+
+	data, err = p.Parse("./test.proto")
+	assert.Nil(t, err)
+	assert.False(t, data.HasValidation())
+}
+
+func TestDefaultProtoParse_ImportedValidation(t *testing.T) {
+	p := NewDefaultProtoParser(".")
+	data, err := p.Parse("./test_validate_import.proto")
+	assert.Nil(t, err)
+	assert.True(t, data.HasValidation())
+	assert.Equal(t, []MessageValidation{
+		{
+			Name:   "TypesRuleReq",
+			GoName: "TypesRuleReq",
+			Fields: []FieldValidation{
+				{Name: "name", GoName: "Name", Type: "string", Rules: []Rule{
+					{Type: "string", Name: "min_len", Values: []string{"1"}},
+				}},
+			},
+		},
+	}, data.Validation)
+
+	// the request in another go package can't be validated
+	_, err = p.Parse("./test_validate_shared.proto")
+	assert.Error(t, err)
+}
@@ -10,4 +10,10 @@ type Proto struct {
 	Import    []Import
 	Message   []Message
 	Service   Services
+	// Files are the imported files which are found in the proto paths
+	Files []File
+	// Symbols are the messages of the proto file and the files it imports
+	Symbols SymbolTable
+	// Validation are the validation rules of the messages in the proto file
+	Validation []MessageValidation
 }
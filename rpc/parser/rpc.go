@@ -5,4 +5,8 @@ import "github.com/emicklei/proto"
 // RPC embeds proto.RPC
 type RPC struct {
 	*proto.RPC
+	// Request is the message of the request type
+	Request Symbol
+	// Returns is the message of the returns type
+	Returns Symbol
 }
@@ -3,7 +3,6 @@ package parser
 import (
 	"errors"
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	"github.com/emicklei/proto"
@@ -21,7 +20,7 @@ type (
 	}
 )
 
-func (s Services) validate(filename string, multipleOpt ...bool) error {
+func (s Services) validate(multipleOpt ...bool) error {
 	if len(s) == 0 {
 		return errors.New("rpc service not found")
 	}
@@ -35,20 +34,56 @@ func (s Services) validate(filename string, multipleOpt ...bool) error {
 		return errors.New("only one service expected")
 	}
 
-	name := filepath.Base(filename)
-	for _, service := range s {
+	return nil
+}
+
+// resolve resolves the request and returns types of the rpc in the symbols, the types
+// without the package which are not found are regarded as the messages of the file.
+func (p *Proto) resolve() error {
+	var scope string
+	if p.Package.Package != nil {
+		scope = p.Package.Name
+	}
+
+	for _, service := range p.Service {
 		for _, rpc := range service.RPC {
-			if strings.Contains(rpc.RequestType, ".") {
-				return fmt.Errorf("line %v:%v, request type must defined in %s",
-					rpc.Position.Line,
-					rpc.Position.Column, name)
+			request, ok := p.lookup(rpc.RequestType, scope)
+			if !ok {
+				return fmt.Errorf("line %v:%v, request type must defined in %s or its imports, "+
+					"%q is not found", rpc.Position.Line, rpc.Position.Column, p.Name, rpc.RequestType)
 			}
-			if strings.Contains(rpc.ReturnsType, ".") {
-				return fmt.Errorf("line %v:%v, returns type must defined in %s",
-					rpc.Position.Line,
-					rpc.Position.Column, name)
+			returns, ok := p.lookup(rpc.ReturnsType, scope)
+			if !ok {
+				return fmt.Errorf("line %v:%v, returns type must defined in %s or its imports, "+
+					"%q is not found", rpc.Position.Line, rpc.Position.Column, p.Name, rpc.ReturnsType)
 			}
+			rpc.Request = request
+			rpc.Returns = returns
 		}
 	}
 	return nil
 }
+
+func (p *Proto) lookup(name, scope string) (Symbol, bool) {
+	if symbol, ok := p.Symbols.Lookup(name, scope); ok {
+		return symbol, true
+	}
+	if strings.Contains(name, ".") {
+		return Symbol{}, false
+	}
+
+	goPackage, _ := splitGoPackage(p.GoPackage, scope)
+	return Symbol{
+		FullName:  strings.TrimPrefix(scope+"."+name, "."),
+		GoName:    CamelCase(name),
+		File:      p.Name,
+		GoPackage: goPackage,
+		PbPackage: p.PbPackage,
+	}, true
+}
+
+// SameGoPackage reports whether the go_package is the go package of the proto file
+func (p Proto) SameGoPackage(goPackage string) bool {
+	own, _ := splitGoPackage(p.GoPackage, "")
+	return goPackage == own
+}
@@ -0,0 +1,263 @@
+package parser
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	// the well known types are resolved from the registry
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const wellKnownPrefix = "google/protobuf/"
+
+type (
+	// File describes a proto file imported by the parsed proto file
+	File struct {
+		// Name is the name of the file in the import statement
+		Name string
+		// Src is the path of the file, it's empty for the well known types
+		Src string
+		// Package is the proto package of the file
+		Package string
+		// GoPackage is the go_package of the file
+		GoPackage string
+		// PbPackage is the name of the go package
+		PbPackage string
+	}
+
+	// Symbol describes a message which can be referred by the rpc
+	Symbol struct {
+		// FullName is the fully qualified name of the message without the leading dot
+		FullName string
+		// GoName is the name of the generated go type, the nested names are joined by _
+		GoName string
+		// File is the name of the file which defines the message
+		File string
+		// GoPackage is the go_package of the file which defines the message
+		GoPackage string
+		// PbPackage is the name of the go package which defines the message
+		PbPackage string
+	}
+
+	// SymbolTable are the messages visible to the proto file by the fully qualified names
+	SymbolTable map[string]Symbol
+
+	symbolLoader struct {
+		protoPaths []string
+		files      []File
+		symbols    SymbolTable
+		visited    map[string]bool
+	}
+)
+
+// Lookup resolves the name of the message in the scope like protoc does, the name is looked
+// up from the innermost scope outwards, the name starts with a dot is fully qualified.
+func (s SymbolTable) Lookup(name, scope string) (Symbol, bool) {
+	if strings.HasPrefix(name, ".") {
+		symbol, ok := s[name[1:]]
+		return symbol, ok
+	}
+
+	for {
+		candidate := name
+		if len(scope) > 0 {
+			candidate = scope + "." + name
+		}
+		if symbol, ok := s[candidate]; ok {
+			return symbol, true
+		}
+		if len(scope) == 0 {
+			return Symbol{}, false
+		}
+
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+// GoPackageOf returns the messages in the go package sorted by the names
+func (s SymbolTable) GoPackageOf(goPackage string) []Symbol {
+	var ret []Symbol
+	for _, each := range s {
+		if each.GoPackage == goPackage {
+			ret = append(ret, each)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].FullName < ret[j].FullName
+	})
+	return ret
+}
+
+// loadSymbols builds the symbols of the proto file and the files it imports, the imports
+// which are not found in the proto paths are ignored.
+func loadSymbols(protoPaths []string, ret *Proto, set *proto.Proto) ([]File, SymbolTable) {
+	l := &symbolLoader{
+		protoPaths: protoPaths,
+		symbols:    make(SymbolTable),
+		visited:    map[string]bool{ret.Name: true},
+	}
+	file := File{
+		Name:      ret.Name,
+		Src:       ret.Src,
+		PbPackage: ret.PbPackage,
+	}
+	if ret.Package.Package != nil {
+		file.Package = ret.Package.Name
+	}
+	file.GoPackage, _ = splitGoPackage(ret.GoPackage, file.Package)
+	l.addMessages(file, set)
+	l.loadImports(set, false)
+	return l.files, l.symbols
+}
+
+// loadImports loads the files imported by the file, only the public imports of the imported
+// files are visible, publicOnly reports whether the file is imported by another import.
+func (l *symbolLoader) loadImports(set *proto.Proto, publicOnly bool) {
+	for _, element := range set.Elements {
+		imp, ok := element.(*proto.Import)
+		if !ok || publicOnly && imp.Kind != "public" || l.visited[imp.Filename] {
+			continue
+		}
+
+		l.visited[imp.Filename] = true
+		if strings.HasPrefix(imp.Filename, wellKnownPrefix) && l.loadWellKnown(imp.Filename) {
+			continue
+		}
+
+		src, imported, ok := l.parse(imp.Filename)
+		if !ok {
+			continue
+		}
+
+		file := File{Name: imp.Filename, Src: src}
+		for _, each := range imported.Elements {
+			switch e := each.(type) {
+			case *proto.Package:
+				file.Package = e.Name
+			case *proto.Option:
+				if e.Name == "go_package" {
+					file.GoPackage = e.Constant.Source
+				}
+			}
+		}
+		file.GoPackage, file.PbPackage = splitGoPackage(file.GoPackage, file.Package)
+		l.files = append(l.files, file)
+		l.addMessages(file, imported)
+		l.loadImports(imported, true)
+	}
+}
+
+func (l *symbolLoader) parse(name string) (string, *proto.Proto, bool) {
+	for _, each := range l.protoPaths {
+		src := filepath.Join(each, filepath.FromSlash(name))
+		r, err := os.Open(src)
+		if err != nil {
+			continue
+		}
+
+		set, err := proto.NewParser(r).Parse()
+		r.Close()
+		if err != nil {
+			return "", nil, false
+		}
+		return src, set, true
+	}
+
+	return "", nil, false
+}
+
+func (l *symbolLoader) loadWellKnown(name string) bool {
+	desc, err := protoregistry.GlobalFiles.FindFileByPath(name)
+	if err != nil {
+		return false
+	}
+
+	file := File{Name: name, Package: string(desc.Package())}
+	goPackage := desc.Options().(*descriptorpb.FileOptions).GetGoPackage()
+	file.GoPackage, file.PbPackage = splitGoPackage(goPackage, file.Package)
+	l.files = append(l.files, file)
+
+	var add func(messages protoreflect.MessageDescriptors)
+	add = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			md := messages.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			l.add(file, string(md.FullName()))
+			add(md.Messages())
+		}
+	}
+	add(desc.Messages())
+	return true
+}
+
+func (l *symbolLoader) addMessages(file File, set *proto.Proto) {
+	proto.Walk(set, proto.WithMessage(func(message *proto.Message) {
+		if message.IsExtend {
+			return
+		}
+
+		name := getMessageName(message)
+		if len(file.Package) > 0 {
+			name = file.Package + "." + name
+		}
+		l.add(file, name)
+	}))
+}
+
+func (l *symbolLoader) add(file File, fullName string) {
+	name := strings.TrimPrefix(fullName, file.Package+".")
+	var goName []string
+	for _, each := range strings.Split(name, ".") {
+		goName = append(goName, CamelCase(each))
+	}
+
+	l.symbols[fullName] = Symbol{
+		FullName:  fullName,
+		GoName:    strings.Join(goName, "_"),
+		File:      file.Name,
+		GoPackage: file.GoPackage,
+		PbPackage: file.PbPackage,
+	}
+}
+
+// getMessageName returns the name of the message in the file, the names of the nested
+// messages are joined by dots.
+func getMessageName(message *proto.Message) string {
+	list := []string{message.Name}
+	for parent, ok := message.Parent.(*proto.Message); ok; parent, ok = parent.Parent.(*proto.Message) {
+		list = append([]string{parent.Name}, list...)
+	}
+	return strings.Join(list, ".")
+}
+
+// splitGoPackage returns the go_package without the package name and the package name, the
+// go_package may be like github.com/foo/bar;baz.
+func splitGoPackage(goPackage, pkg string) (string, string) {
+	if i := strings.Index(goPackage, ";"); i >= 0 {
+		return goPackage[:i], goPackage[i+1:]
+	}
+	if len(goPackage) == 0 {
+		goPackage = pkg
+	}
+	return goPackage, GoSanitized(path.Base(goPackage))
+}
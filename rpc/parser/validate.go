@@ -0,0 +1,328 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/proto"
+)
+
+const (
+	pgvFieldRules      = "(validate.rules)"
+	pgvDisabled        = "(validate.disabled)"
+	pgvIgnored         = "(validate.ignored)"
+	bufFieldRules      = "(buf.validate.field)"
+	bufMessageRules    = "(buf.validate.message)"
+	bufMessageDisabled = "disabled"
+)
+
+var scalarTypes = map[string]bool{
+	"double":   true,
+	"float":    true,
+	"int32":    true,
+	"int64":    true,
+	"uint32":   true,
+	"uint64":   true,
+	"sint32":   true,
+	"sint64":   true,
+	"fixed32":  true,
+	"fixed64":  true,
+	"sfixed32": true,
+	"sfixed64": true,
+	"bool":     true,
+	"string":   true,
+	"bytes":    true,
+}
+
+type (
+	// Rule is a validation rule of a field, like min_len of (validate.rules).string, the rules
+	// of protoc-gen-validate and buf.validate are in the same form.
+	Rule struct {
+		// Type is the type which the rule applies to, like string, int64, repeated and message,
+		// it's empty for the rules of the field itself like required of buf.validate.
+		Type string
+		// Name is the name of the rule, the names of the nested rules are joined by dots
+		Name string
+		// Values are the go literals of the values, the strings are quoted, the rules like in
+		// have more than one value.
+		Values []string
+	}
+
+	// FieldValidation is the validation rules of a field
+	FieldValidation struct {
+		// Name is the name of the field in the proto file
+		Name string
+		// GoName is the name of the field in the generated go struct
+		GoName string
+		// Type is the type of the field, it's the type of the values for a map
+		Type     string
+		Repeated bool
+		Map      bool
+		// Optional reports whether the field has the optional label of proto3, it's validated if set
+		Optional bool
+		// OneOf is the name of the oneof which the field belongs to, it's validated if set
+		OneOf string
+		// Message reports whether the field is a message, the field which is neither a scalar
+		// nor a message is an enum.
+		Message bool
+		Rules   []Rule
+	}
+
+	// MessageValidation is the validation of a message in the proto file, the fields with rules
+	// and the fields of messages which are validated recursively are listed.
+	MessageValidation struct {
+		// Name is the name of the message, the names of the nested messages are joined by dots
+		Name string
+		// GoName is the name of the generated go type
+		GoName string
+		// Disabled reports whether the validation of the message is disabled
+		Disabled bool
+		Fields   []FieldValidation
+	}
+)
+
+// HasValidation reports whether any field of the proto file has validation rules
+func (p Proto) HasValidation() bool {
+	for _, message := range p.Validation {
+		for _, field := range message.Fields {
+			if len(field.Rules) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsScalar reports whether the type is a scalar type of proto
+func IsScalar(tp string) bool {
+	return scalarTypes[tp]
+}
+
+// parseFilesValidation collects the validation rules of the proto file and the imported files in
+// the same go package, whose Validate methods are generated along with the proto file. The
+// requests in the other go packages can't be validated, it fails if they have rules.
+func parseFilesValidation(ret Proto, set *proto.Proto) ([]MessageValidation, error) {
+	var pkg string
+	if ret.Package.Package != nil {
+		pkg = ret.Package.Name
+	}
+	validation := parseValidation(set, pkg, ret.Symbols)
+
+	for _, file := range ret.Files {
+		// the well known types have no rules
+		if len(file.Src) == 0 {
+			continue
+		}
+
+		r, err := os.Open(file.Src)
+		if err != nil {
+			return nil, err
+		}
+		imported, err := proto.NewParser(r).Parse()
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		messages := parseValidation(imported, file.Package, ret.Symbols)
+		if ret.SameGoPackage(file.GoPackage) {
+			validation = append(validation, messages...)
+			continue
+		}
+
+		for _, message := range messages {
+			fullName := message.Name
+			if len(file.Package) > 0 {
+				fullName = file.Package + "." + message.Name
+			}
+			if !message.hasRules() || !ret.isRequest(fullName) {
+				continue
+			}
+
+			return nil, fmt.Errorf("%s: the rules of request %s are not validated, it's in the go "+
+				"package %s instead of %s", file.Name, fullName, file.GoPackage, ret.GoPackage)
+		}
+	}
+
+	return validation, nil
+}
+
+// isRequest reports whether the message is the request of any rpc
+func (p Proto) isRequest(fullName string) bool {
+	for _, service := range p.Service {
+		for _, rpc := range service.RPC {
+			if rpc.Request.FullName == fullName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m MessageValidation) hasRules() bool {
+	if m.Disabled {
+		return false
+	}
+	for _, field := range m.Fields {
+		if len(field.Rules) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseValidation collects the validation rules of the messages in the proto file
+func parseValidation(set *proto.Proto, pkg string, symbols SymbolTable) []MessageValidation {
+	var ret []MessageValidation
+	proto.Walk(set, proto.WithMessage(func(message *proto.Message) {
+		if message.IsExtend {
+			return
+		}
+
+		name := getMessageName(message)
+		scope := name
+		if len(pkg) > 0 {
+			scope = pkg + "." + name
+		}
+		validation := MessageValidation{
+			Name:   name,
+			GoName: symbols[scope].GoName,
+		}
+
+		var addField func(oneOf string, elements []proto.Visitee)
+		addField = func(oneOf string, elements []proto.Visitee) {
+			for _, element := range elements {
+				var field FieldValidation
+				var options []*proto.Option
+				switch e := element.(type) {
+				case *proto.NormalField:
+					field = FieldValidation{Name: e.Name, Type: e.Type, Repeated: e.Repeated,
+						Optional: e.Optional}
+					options = e.Options
+				case *proto.MapField:
+					field = FieldValidation{Name: e.Name, Type: e.Type, Map: true}
+					options = e.Options
+				case *proto.OneOfField:
+					field = FieldValidation{Name: e.Name, Type: e.Type, OneOf: oneOf}
+					options = e.Options
+				case *proto.Oneof:
+					addField(e.Name, e.Elements)
+					continue
+				case *proto.Option:
+					if isDisabled(e) {
+						validation.Disabled = true
+					}
+					continue
+				default:
+					continue
+				}
+
+				field.GoName = CamelCase(field.Name)
+				if !IsScalar(field.Type) {
+					_, field.Message = symbols.Lookup(field.Type, scope)
+				}
+				field.Rules = parseRules(options)
+				if len(field.Rules) > 0 || field.Message {
+					validation.Fields = append(validation.Fields, field)
+				}
+			}
+		}
+		addField("", message.Elements)
+		ret = append(ret, validation)
+	}))
+
+	return ret
+}
+
+func isDisabled(option *proto.Option) bool {
+	switch option.Name {
+	case pgvDisabled, pgvIgnored:
+		return option.Constant.Source == "true"
+	case bufMessageRules + "." + bufMessageDisabled:
+		return option.Constant.Source == "true"
+	case bufMessageRules:
+		for _, each := range option.Constant.OrderedMap {
+			if each.Name == bufMessageDisabled && each.Source == "true" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseRules flattens the options of the field into the rules, like
+// (validate.rules).string = {min_len: 1, in: ["a", "b"]}.
+func parseRules(options []*proto.Option) []Rule {
+	var rules []Rule
+	add := func(path []string, value string) {
+		var rule Rule
+		switch len(path) {
+		case 0:
+			return
+		case 1:
+			rule.Name = path[0]
+		default:
+			rule.Type = path[0]
+			rule.Name = strings.Join(path[1:], ".")
+		}
+
+		for i, each := range rules {
+			if each.Type == rule.Type && each.Name == rule.Name {
+				rules[i].Values = append(rules[i].Values, value)
+				return
+			}
+		}
+		rule.Values = []string{value}
+		rules = append(rules, rule)
+	}
+
+	var walk func(path []string, literal *proto.Literal)
+	walk = func(path []string, literal *proto.Literal) {
+		switch {
+		case len(literal.OrderedMap) > 0:
+			for _, each := range literal.OrderedMap {
+				walk(append(path[:len(path):len(path)], each.Name), each.Literal)
+			}
+		case len(literal.Array) > 0:
+			for _, each := range literal.Array {
+				walk(path, each)
+			}
+		default:
+			add(path, goLiteral(literal))
+		}
+	}
+
+	for _, option := range options {
+		var name string
+		switch {
+		case strings.HasPrefix(option.Name, pgvFieldRules):
+			name = strings.TrimPrefix(option.Name, pgvFieldRules)
+		case strings.HasPrefix(option.Name, bufFieldRules):
+			name = strings.TrimPrefix(option.Name, bufFieldRules)
+		default:
+			continue
+		}
+
+		var path []string
+		if name = strings.TrimPrefix(name, "."); len(name) > 0 {
+			path = strings.Split(name, ".")
+		}
+		walk(path, &option.Constant)
+	}
+
+	return rules
+}
+
+// goLiteral returns the go literal of the value, the escapes in the strings are kept
+func goLiteral(literal *proto.Literal) string {
+	if !literal.IsString {
+		return literal.Source
+	}
+
+	if s, err := strconv.Unquote(`"` + literal.Source + `"`); err == nil {
+		return strconv.Quote(s)
+	}
+	return strconv.Quote(literal.Source)
+}
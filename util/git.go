@@ -1,7 +1,11 @@
 package util
 
 import (
+	"archive/tar"
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -43,3 +47,68 @@ func CloneIntoGitHome(url, branch string) (dir string, err error) {
 	err = cmd.Run()
 	return
 }
+
+// ExportGitRef writes the tree of the git ref, such as a branch, a tag or a commit,
+// of the repository which contains the working directory into dst.
+func ExportGitRef(ref, dst string) error {
+	path, err := env.LookPath("git")
+	if err != nil {
+		return err
+	}
+	if !env.CanExec() {
+		return fmt.Errorf("os %q can not call 'exec' command", runtime.GOOS)
+	}
+
+	top, err := exec.Command(path, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return fmt.Errorf("not a git repository: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(path, "archive", "--format=tar", ref)
+	cmd.Dir = strings.TrimSpace(string(top))
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			return errors.New(strings.TrimSpace(stderr.String()))
+		}
+		return err
+	}
+
+	reader := tar.NewReader(&stdout)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dst)+string(filepath.Separator)) {
+			return fmt.Errorf("invalid file path in archive: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, reader)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -23,6 +23,7 @@ const (
 	gitDir          = ".git"
 	autoCompleteDir = ".auto_complete"
 	cacheDir        = "cache"
+	pluginDir       = "plugins"
 )
 
 var goctlHome string
@@ -135,6 +136,16 @@ func GetCacheDir() (string, error) {
 	return filepath.Join(goctlH, cacheDir), nil
 }
 
+// GetPluginDir returns the directory in goctl home where the plugins are discovered.
+func GetPluginDir() (string, error) {
+	goctlH, err := GetGoctlHome()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(goctlH, pluginDir), nil
+}
+
 // GetTemplateDir returns the category path value in GoctlHome where could get it by GetGoctlHome.
 func GetTemplateDir(category string) (string, error) {
 	home, err := GetGoctlHome()